@@ -10,19 +10,29 @@ import (
 
 	"github.com/evgeniy-dammer/marketplace-api/internal/config"
 	deliveryHttp "github.com/evgeniy-dammer/marketplace-api/internal/delivery/http"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	oidcIdentity "github.com/evgeniy-dammer/marketplace-api/internal/repository/identity/oidc"
+	casbinPolicy "github.com/evgeniy-dammer/marketplace-api/internal/repository/policy"
+	localSMS "github.com/evgeniy-dammer/marketplace-api/internal/repository/sms/local"
 	postgresStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/postgres"
 	redisStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/redis"
+	useCaseAPIKey "github.com/evgeniy-dammer/marketplace-api/internal/usecase/apikey"
+	useCaseAudit "github.com/evgeniy-dammer/marketplace-api/internal/usecase/audit"
 	useCaseAuthentication "github.com/evgeniy-dammer/marketplace-api/internal/usecase/authentication"
 	useCaseAuthorization "github.com/evgeniy-dammer/marketplace-api/internal/usecase/authorization"
 	useCaseCategory "github.com/evgeniy-dammer/marketplace-api/internal/usecase/category"
 	useCaseComment "github.com/evgeniy-dammer/marketplace-api/internal/usecase/comment"
 	useCaseFavorite "github.com/evgeniy-dammer/marketplace-api/internal/usecase/favorite"
 	useCaseImage "github.com/evgeniy-dammer/marketplace-api/internal/usecase/image"
+	useCaseInvitation "github.com/evgeniy-dammer/marketplace-api/internal/usecase/invitation"
 	useCaseItem "github.com/evgeniy-dammer/marketplace-api/internal/usecase/item"
+	useCaseMember "github.com/evgeniy-dammer/marketplace-api/internal/usecase/member"
 	useCaseOrder "github.com/evgeniy-dammer/marketplace-api/internal/usecase/order"
 	useCaseOrganization "github.com/evgeniy-dammer/marketplace-api/internal/usecase/organization"
 	useCaseRule "github.com/evgeniy-dammer/marketplace-api/internal/usecase/rule"
+	useCaseSetting "github.com/evgeniy-dammer/marketplace-api/internal/usecase/setting"
 	useCaseSpecification "github.com/evgeniy-dammer/marketplace-api/internal/usecase/specification"
+	useCaseStorefront "github.com/evgeniy-dammer/marketplace-api/internal/usecase/storefront"
 	useCaseTable "github.com/evgeniy-dammer/marketplace-api/internal/usecase/table"
 	useCaseUser "github.com/evgeniy-dammer/marketplace-api/internal/usecase/user"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
@@ -70,6 +80,16 @@ func main() {
 
 	viper.Set("JWT_KEY", marketplace.Data["JWT_KEY"])
 
+	// private signing keys are stored encrypted with the key held in vault
+	signingKeyEncryptionKey, ok := marketplace.Data["SIGNING_KEY_ENCRYPTION_KEY"].(string)
+	algorithm := viper.GetString("authentication.signing_algorithm")
+
+	if !ok && algorithm != "" && algorithm != token.AlgorithmHS256 {
+		logger.Logger.Fatal("unable to get signing key encryption key")
+	}
+
+	viper.Set("SIGNING_KEY_ENCRYPTION_KEY", signingKeyEncryptionKey)
+
 	dbPassword, ok := marketplace.Data["DB_PASSWORD"].(string)
 	if !ok {
 		logger.Logger.Fatal("unable to get database password")
@@ -100,28 +120,31 @@ func main() {
 		}
 	}(database)
 
-	var redisClient *redis.Client
+	// cache is always required for one-time codes, isCacheOn only toggles entities caching
+	redisClient := redis.NewClient(&redis.Options{
+		Addr:     net.JoinHostPort(viper.GetString("cache.host"), viper.GetString("cache.port")),
+		Password: "", // marketplace.Data["REDIS_PASSWORD"].(string)
+		DB:       viper.GetInt("cache.database"),
+	})
 
-	if isCacheOn {
-		redisClient = redis.NewClient(&redis.Options{
-			Addr:     net.JoinHostPort(viper.GetString("cache.host"), viper.GetString("cache.port")),
-			Password: "", // marketplace.Data["REDIS_PASSWORD"].(string)
-			DB:       viper.GetInt("cache.database"),
-		})
+	defer func(redisClient *redis.Client) {
+		err = redisClient.Close()
+		if err != nil {
+			logger.Logger.Fatal("unable to close redis client", zap.String("error", err.Error()))
+		}
+	}(redisClient)
 
-		defer func(redisClient *redis.Client) {
-			err = redisClient.Close()
-			if err != nil {
-				logger.Logger.Fatal("unable to close redis client", zap.String("error", err.Error()))
-			}
-		}(redisClient)
+	_, err = redisClient.Ping(context.TODO()).Result()
 
-		_, err = redisClient.Ping(context.TODO()).Result()
+	if err != nil {
+		logger.Logger.Fatal(
+			"cache initialization failed, redis is required for one-time codes and sign in protection "+
+				"even if cache is turned off",
+			zap.String("error", err.Error()),
+		)
+	}
 
-		if err != nil {
-			logger.Logger.Fatal("cache initialization failed", zap.String("error", err.Error()))
-		}
-	} else {
+	if !isCacheOn {
 		logger.Logger.Info("cache is turned off")
 	}
 
@@ -155,20 +178,88 @@ func main() {
 		isTracingOn,
 	)
 
+	smsSender := localSMS.New(viper.GetString("sms.file"))
+
+	var oidcProviders []oidcIdentity.ProviderConfig
+	if err = viper.UnmarshalKey("oidc.providers", &oidcProviders); err != nil {
+		logger.Logger.Fatal("oidc providers configuration failed", zap.String("error", err.Error()))
+	}
+
+	identityProvider := oidcIdentity.New(oidcProviders, time.Duration(viper.GetInt("oidc.timeout"))*time.Second)
+
+	policyWatcher, err := casbinPolicy.NewWatcher(
+		context.Background(),
+		redisClient,
+		viper.GetString("authorization.channel"),
+		time.Duration(viper.GetInt("cache.timeout"))*time.Second,
+	)
+	if err != nil {
+		logger.Logger.Fatal("policy watcher initialization failed", zap.String("error", err.Error()))
+	}
+
+	policyEnforcer, err := casbinPolicy.New(
+		viper.GetString("authorization.model"),
+		casbinPolicy.NewDomainAdapter(adapter, repoStorage),
+		policyWatcher,
+		isTracingOn,
+	)
+	if err != nil {
+		logger.Logger.Fatal("policy enforcer initialization failed", zap.String("error", err.Error()))
+	}
+
+	defer policyEnforcer.Close()
+
+	policyCtx, stopPolicyReload := context.WithCancel(context.Background())
+	defer stopPolicyReload()
+
+	go policyEnforcer.Run(policyCtx, time.Duration(viper.GetInt("authorization.reload_interval"))*time.Second)
+
 	// use cases
-	ucAuthentication := useCaseAuthentication.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucAuthorization := useCaseAuthorization.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucUser := useCaseUser.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucOrganization := useCaseOrganization.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucCategory := useCaseCategory.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucItem := useCaseItem.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucTable := useCaseTable.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucOrder := useCaseOrder.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucImage := useCaseImage.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucComment := useCaseComment.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucSpecification := useCaseSpecification.New(repoStorage, repoCache, isTracingOn, isCacheOn)
-	ucFavorite := useCaseFavorite.New(repoStorage, repoCache, isTracingOn)
-	ucRule := useCaseRule.New(repoStorage, repoCache, isTracingOn, isCacheOn)
+	ucAuthentication := useCaseAuthentication.New(
+		repoStorage,
+		repoCache,
+		smsSender,
+		identityProvider,
+		isTracingOn,
+		isCacheOn,
+	)
+
+	rotationCtx, stopRotation := context.WithCancel(context.Background())
+	defer stopRotation()
+
+	go ucAuthentication.AuthenticationRunKeyRotation(rotationCtx)
+
+	ucAuthorization := useCaseAuthorization.New(repoStorage, repoCache, policyEnforcer, repoStorage, isTracingOn, isCacheOn)
+	ucUser := useCaseUser.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucOrganization := useCaseOrganization.New(repoStorage, repoCache, policyEnforcer, repoStorage, isTracingOn, isCacheOn)
+	ucCategory := useCaseCategory.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucItem := useCaseItem.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucTable := useCaseTable.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucOrder := useCaseOrder.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucImage := useCaseImage.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucComment := useCaseComment.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucSpecification := useCaseSpecification.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucFavorite := useCaseFavorite.New(repoStorage, repoCache, repoStorage, isTracingOn)
+	ucRule := useCaseRule.New(
+		repoStorage,
+		repoCache,
+		policyEnforcer,
+		repoStorage,
+		deliveryHttp.Resources(),
+		isTracingOn,
+		isCacheOn,
+	)
+	ucAPIKey := useCaseAPIKey.New(repoStorage, repoStorage, isTracingOn)
+	ucInvitation := useCaseInvitation.New(repoStorage, policyEnforcer, smsSender, repoStorage, isTracingOn)
+	ucAudit := useCaseAudit.New(repoStorage, isTracingOn)
+	ucMember := useCaseMember.New(repoStorage, repoCache, policyEnforcer, repoStorage, isTracingOn, isCacheOn)
+	ucSetting := useCaseSetting.New(repoStorage, repoCache, repoStorage, isTracingOn, isCacheOn)
+	ucStorefront := useCaseStorefront.New(repoStorage, repoStorage, repoCache, isTracingOn, isCacheOn)
+
+	retentionCtx, stopRetention := context.WithCancel(context.Background())
+	defer stopRetention()
+
+	go ucAudit.AuditRunRetention(retentionCtx)
 
 	// deliveries
 	deliveryHTTP := deliveryHttp.New(
@@ -185,16 +276,38 @@ func main() {
 		ucSpecification,
 		ucFavorite,
 		ucRule,
-		adapter,
+		ucAPIKey,
+		ucInvitation,
+		ucAudit,
+		ucMember,
+		ucSetting,
+		ucStorefront,
+		policyEnforcer,
 		isTracingOn,
 	)
 
+	for _, route := range deliveryHTTP.AuditPolicies() {
+		logger.Logger.Warn(
+			"route has no policy rules, generate them with cmd/policygen",
+			zap.String("method", route.Method),
+			zap.String("path", route.Path),
+			zap.String("object", route.Object),
+			zap.String("action", route.Action),
+		)
+	}
+
+	// forwarding headers are trusted only from the configured proxies
+	router, err := deliveryHTTP.InitRoutes(routerMode, viper.GetStringSlice("server.trusted_proxies"))
+	if err != nil {
+		logger.Logger.Fatal("router initialization failed", zap.String("error", err.Error()))
+	}
+
 	// create new server
 	srv := new(server.Server)
 
 	srvConfig := server.Config{
 		Port:           viper.GetString("server.port"),
-		Handler:        deliveryHTTP.InitRoutes(routerMode),
+		Handler:        router,
 		ReadTimeout:    viper.GetInt("server.read_timeout"),
 		WriteTimeout:   viper.GetInt("server.write_timeout"),
 		IdleTimeout:    viper.GetInt("server.idle_timeout"),
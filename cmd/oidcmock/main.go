@@ -0,0 +1,54 @@
+package main
+
+import (
+	"flag"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/oidcmock"
+	"go.uber.org/zap"
+)
+
+const readHeaderTimeout = 10 * time.Second
+
+func main() {
+	addr := flag.String("addr", ":9000", "listen address")
+	issuer := flag.String("issuer", "http://localhost:9000", "issuer URL")
+	clientID := flag.String("client-id", "marketplace", "accepted client id")
+	subject := flag.String("subject", "mock-user", "subject of the signed in user")
+	phone := flag.String("phone", "+10000000000", "verified phone number of the signed in user")
+	firstName := flag.String("first-name", "Mock", "first name of the signed in user")
+	lastName := flag.String("last-name", "User", "last name of the signed in user")
+	groups := flag.String("groups", "", "comma separated groups claim of the signed in user")
+	flag.Parse()
+
+	if err := logger.InitLogger(); err != nil {
+		panic(err)
+	}
+
+	claims := map[string]interface{}{
+		"phone_number":          *phone,
+		"phone_number_verified": true,
+		"given_name":            *firstName,
+		"family_name":           *lastName,
+	}
+
+	if *groups != "" {
+		claims["groups"] = strings.Split(*groups, ",")
+	}
+
+	provider, err := oidcmock.New(strings.TrimSuffix(*issuer, "/"), *clientID, *subject, claims)
+	if err != nil {
+		logger.Logger.Fatal("mock provider initialization failed", zap.String("error", err.Error()))
+	}
+
+	logger.Logger.Info("mock oidc provider started", zap.String("addr", *addr), zap.String("issuer", provider.Issuer))
+
+	srv := &http.Server{Addr: *addr, Handler: provider, ReadHeaderTimeout: readHeaderTimeout}
+
+	if err = srv.ListenAndServe(); err != nil {
+		logger.Logger.Fatal("mock provider stopped", zap.String("error", err.Error()))
+	}
+}
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	deliveryHttp "github.com/evgeniy-dammer/marketplace-api/internal/delivery/http"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/jmoiron/sqlx"
+	_ "github.com/lib/pq"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// policygen prints a goose migration with deny rules for routes which have no rules yet.
+// Deny rules are inherited by parent roles, so allowing a route to a role means replacing
+// its deny rule and the deny rules of the roles it inherits.
+func main() {
+	dsn := flag.String("dsn", "", "postgres connection string")
+	roles := flag.String("roles", "", "comma separated roles, all roles if empty")
+	out := flag.String("out", "", "migration file, stdout if empty")
+	flag.Parse()
+
+	if err := logger.InitLogger(); err != nil {
+		panic(err)
+	}
+
+	if *dsn == "" {
+		logger.Logger.Fatal("dsn is required")
+	}
+
+	database, err := sqlx.Connect("postgres", *dsn)
+	if err != nil {
+		logger.Logger.Fatal("database connection failed", zap.String("error", err.Error()))
+	}
+
+	defer database.Close()
+
+	policies, err := loadPolicies(database)
+	if err != nil {
+		logger.Logger.Fatal("unable to load policies", zap.String("error", err.Error()))
+	}
+
+	roleNames, err := loadRoles(database, *roles)
+	if err != nil {
+		logger.Logger.Fatal("unable to load roles", zap.String("error", err.Error()))
+	}
+
+	missing := deliveryHttp.MissingPolicies(deliveryHttp.Routes(), policies)
+	if len(missing) == 0 {
+		logger.Logger.Info("all routes have policy rules")
+
+		return
+	}
+
+	var writer io.Writer = os.Stdout
+
+	if *out != "" {
+		file, err := os.Create(*out)
+		if err != nil {
+			logger.Logger.Fatal("unable to create migration file", zap.String("error", err.Error()))
+		}
+
+		defer file.Close()
+
+		writer = file
+	}
+
+	if err = writeMigration(writer, missing, roleNames); err != nil {
+		logger.Logger.Fatal("unable to write migration", zap.String("error", err.Error()))
+	}
+}
+
+// loadPolicies returns policy rules as casbin loads them.
+func loadPolicies(database *sqlx.DB) ([][]string, error) {
+	var rows []struct {
+		V0 string `db:"v0"`
+		V1 string `db:"v1"`
+		V2 string `db:"v2"`
+		V3 string `db:"v3"`
+	}
+
+	if err := database.Select(&rows, "SELECT v0, v1, v2, v3 FROM casbin_rule WHERE ptype = 'p'"); err != nil {
+		return nil, errors.Wrap(err, "rules select error")
+	}
+
+	policies := make([][]string, 0, len(rows))
+
+	for _, row := range rows {
+		policies = append(policies, []string{row.V0, row.V1, row.V2, row.V3})
+	}
+
+	return policies, nil
+}
+
+// loadRoles returns the given roles or all roles from database.
+func loadRoles(database *sqlx.DB, roles string) ([]string, error) {
+	if roles != "" {
+		return strings.Split(roles, ","), nil
+	}
+
+	var names []string
+
+	err := database.Select(&names, "SELECT name FROM roles ORDER BY id")
+
+	return names, errors.Wrap(err, "roles select error")
+}
+
+// writeMigration writes goose migration inserting deny rules of the roles for the routes.
+func writeMigration(writer io.Writer, routes []deliveryHttp.Route, roles []string) error {
+	var values, keys []string
+
+	seen := make(map[string]bool)
+
+	for _, route := range routes {
+		key := fmt.Sprintf("(%s, %s)", quote(route.Object), quote(route.Action))
+		if seen[key] {
+			continue
+		}
+
+		seen[key] = true
+		keys = append(keys, key)
+
+		for _, role := range roles {
+			values = append(values, fmt.Sprintf("   (%s, %s, %s, 'deny')", quote(role), quote(route.Object), quote(route.Action)))
+		}
+	}
+
+	_, err := fmt.Fprintf(
+		writer,
+		"-- +goose Up\n-- +goose StatementBegin\n\n"+
+			"INSERT INTO casbin_rule (v0, v1, v2, v3)\nVALUES\n%s;\n\n"+
+			"-- +goose StatementEnd\n\n\n"+
+			"-- +goose Down\n-- +goose StatementBegin\n\n"+
+			"DELETE FROM casbin_rule WHERE v3 = 'deny' AND (v1, v2) IN (%s);\n\n"+
+			"-- +goose StatementEnd\n",
+		strings.Join(values, ",\n"),
+		strings.Join(keys, ", "),
+	)
+
+	return errors.Wrap(err, "write error")
+}
+
+// quote returns sql string literal.
+func quote(value string) string {
+	return "'" + strings.ReplaceAll(value, "'", "''") + "'"
+}
@@ -0,0 +1,189 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/apikey"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getAPIKeys
+// @Summary Get all API keys of the organization method.
+// @Description Get all not revoked API keys of the organization method.
+// @Tags apikeys
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   org_id	query 		string 		   	true  "Organization ID"
+// @Success 200		{array}  	apikey.APIKey	true  "API key List"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/apikeys/ [get].
+func (d *Delivery) getAPIKeys(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getAPIKeys")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if meta.OrganizationID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyOrganizationID)
+
+		return
+	}
+
+	results, err := d.ucAPIKey.APIKeyGetAll(ctx, meta, meta.OrganizationID)
+	if err != nil {
+		NewErrorResponse(ginCtx, apiKeyErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, results)
+}
+
+// createAPIKey
+// @Summary Create API key method.
+// @Description Creates organization-bound API key, scopes can not exceed rights of the current user.
+// @Tags apikeys
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   input 	body 		apikey.CreateAPIKeyInput 	true  "Organization ID, name and scopes"
+// @Success 200		{object}  	apikey.CreatedAPIKey		true  "API key with the secret shown only once"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/apikeys/ [post].
+func (d *Delivery) createAPIKey(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.createAPIKey")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	var input apikey.CreateAPIKeyInput
+	if err = ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	userRole, err := d.getUserRole(ginCtx)
+	if err != nil {
+		return
+	}
+
+	subject := d.getSubject(meta.UserID, userRole, input.OrganizationID)
+
+	for _, scope := range input.Scopes {
+		obj, act, ok := apikey.ParseScope(scope)
+		if !ok {
+			NewErrorResponse(ginCtx, http.StatusBadRequest, usecase.ErrInvalidScope)
+
+			return
+		}
+
+		enforced, err := d.enforcer.Enforce(ctx, subject, input.OrganizationID, obj, act)
+		if err != nil {
+			NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+			return
+		}
+
+		if !enforced {
+			NewErrorResponse(ginCtx, http.StatusForbidden, ErrScopeNotAllowed)
+
+			return
+		}
+	}
+
+	created, err := d.ucAPIKey.APIKeyCreate(ctx, meta, input)
+	if err != nil {
+		NewErrorResponse(ginCtx, apiKeyErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, created)
+}
+
+// deleteAPIKey
+// @Summary Revoke API key method.
+// @Description Revokes API key by id method.
+// @Tags apikeys
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   	true  "API key ID"
+// @Success 200		{object}  	StatusResponse	true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/apikeys/{id} [delete].
+func (d *Delivery) deleteAPIKey(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.deleteAPIKey")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	keyID := ginCtx.Param("id")
+	if keyID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	if err = d.ucAPIKey.APIKeyRevoke(ctx, meta, keyID); err != nil {
+		NewErrorResponse(ginCtx, apiKeyErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// apiKeyErrorStatus returns http status for API key error.
+func apiKeyErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidScope):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrNotOrganizationOwner):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
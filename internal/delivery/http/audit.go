@@ -0,0 +1,108 @@
+package http
+
+import (
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/queryparameter"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getAuditEntries
+// @Summary Get audit log method.
+// @Description Returns changes made in the system, the newest first.
+// @Tags audit
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   actor_id		query 		string 		   	false  "ID of the user who made the change"
+// @Param   organization_id	query 		string 		   	false  "Organization ID"
+// @Param   entity			query 		string 		   	false  "Entity name"
+// @Param   entity_id		query 		string 		   	false  "Entity ID"
+// @Param   action			query 		string 		   	false  "create, update or delete"
+// @Param   request_id		query 		string 		   	false  "Request ID"
+// @Param   from			query 		string 		   	false  "Start datetime in RFC3339"
+// @Param   to				query 		string 		   	false  "End datetime in RFC3339"
+// @Param   limit			query 		int 		   	false  "Number of entries, 100 at most"
+// @Param   offset			query 		int 		   	false  "Number of skipped entries"
+// @Success 200				{array}  	audit.Entry		true  "Audit Entries"
+// @Failure 400 			{object}    ErrorResponse
+// @Failure 401	 			{object}	ErrorResponse
+// @Failure 403	 			{object}	ErrorResponse
+// @Failure 500 			{object} 	ErrorResponse
+// @Router /api/v1/audit [get].
+func (d *Delivery) getAuditEntries(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getAuditEntries")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var filter audit.Filter
+	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	params, err := getAuditParameters(ginCtx)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	results, err := d.ucAudit.AuditGetAll(ctx, filter, params)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, results)
+}
+
+// getAuditParameters returns period and pagination of the audit log request.
+func getAuditParameters(ginCtx *gin.Context) (queryparameter.QueryParameter, error) {
+	var (
+		params queryparameter.QueryParameter
+		err    error
+	)
+
+	if from := ginCtx.Query("from"); from != "" {
+		if params.StartDate, err = time.Parse(time.RFC3339, from); err != nil {
+			return params, ErrInvalidPeriod
+		}
+	}
+
+	if to := ginCtx.Query("to"); to != "" {
+		if params.EndDate, err = time.Parse(time.RFC3339, to); err != nil {
+			return params, ErrInvalidPeriod
+		}
+	}
+
+	if limit := ginCtx.Query("limit"); limit != "" {
+		if params.Pagination.Limit, err = strconv.ParseUint(limit, 10, 64); err != nil {
+			return params, ErrInvalidPagination
+		}
+	}
+
+	if params.Pagination.Limit > maxAuditLimit {
+		params.Pagination.Limit = maxAuditLimit
+	}
+
+	if offset := ginCtx.Query("offset"); offset != "" {
+		if params.Pagination.Offset, err = strconv.ParseUint(offset, 10, 64); err != nil {
+			return params, ErrInvalidPagination
+		}
+	}
+
+	return params, nil
+}
@@ -1,11 +1,14 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
 	"github.com/gin-gonic/gin"
@@ -13,14 +16,17 @@ import (
 
 // signIn
 // @Summary SignIn user method.
-// @Description SignIn user method.
+// @Description SignIn user method. Returns challenge instead of tokens if two-factor authentication is needed.
 // @Tags authentication
 // @Accept  json
 // @Produce json
-// @Param   input 	body 		user.SignInInput 	true  "Username and Password"
+// @Param   input 	body 		user.SignInInput 	true  "Phone, password, client type and device name"
 // @Success 200		{object}  	AuthResponse		true  "User data and Tokens"
 // @Failure 400 	{object}    ErrorResponse
+// @Failure 401 	{object}    ErrorResponse
+// @Failure 403 	{object}    ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /signin/ [post].
 func (d *Delivery) signIn(ginCtx *gin.Context) {
@@ -45,14 +51,36 @@ func (d *Delivery) signIn(ginCtx *gin.Context) {
 		return
 	}
 
-	usr, tokens, err := d.ucAuthentication.AuthenticationGenerateToken(ctx, "", input.Phone, input.Password)
+	usr, tokens, challenge, err := d.ucAuthentication.AuthenticationSignIn(ctx, input.Phone, input.Password, ginCtx.ClientIP())
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		switch {
+		case errors.Is(err, usecase.ErrInvalidPassword):
+			NewErrorResponse(ginCtx, http.StatusUnauthorized, err)
+		case errors.Is(err, usecase.ErrUserNotVerified), isAccountLocked(err):
+			NewErrorResponse(ginCtx, http.StatusForbidden, err)
+		case errors.Is(err, usecase.ErrLoginLocked):
+			NewErrorResponse(ginCtx, http.StatusTooManyRequests, err)
+		default:
+			NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		}
+
+		return
+	}
+
+	if challenge != nil {
+		ginCtx.JSON(http.StatusOK, ChallengeResponse{Challenge: *challenge})
 
 		return
 	}
 
-	err = d.ucAuthentication.AuthenticationCreateTokenHash(ctx, usr.ID, tokens.RefreshTokenHash)
+	session := token.SessionInput{
+		ClientType: input.ClientType,
+		DeviceName: input.DeviceName,
+		IP:         ginCtx.ClientIP(),
+		ExpiresAt:  time.Unix(tokens.RefreshTokenExpires, 0),
+	}
+
+	err = d.ucAuthentication.AuthenticationCreateTokenHash(ctx, usr.ID, tokens.RefreshTokenHash, session)
 	if err != nil {
 		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
 
@@ -66,11 +94,11 @@ func (d *Delivery) signIn(ginCtx *gin.Context) {
 
 // signUp
 // @Summary SignUp user method.
-// @Description SignUp user method.
+// @Description SignUp user method, new users always get the customer role.
 // @Tags authentication
 // @Accept  json
 // @Produce json
-// @Param   input 	body 		user.CreateUserInput 	true  "User data"
+// @Param   input 	body 		user.SignUpInput 		true  "User data"
 // @Success 200		{string}  	string					true  "User ID"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
@@ -86,7 +114,7 @@ func (d *Delivery) signUp(ginCtx *gin.Context) {
 		ctx = context.New(ctxt)
 	}
 
-	var input user.CreateUserInput
+	var input user.SignUpInput
 	if err := ginCtx.BindJSON(&input); err != nil {
 		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
 
@@ -153,21 +181,32 @@ func (d *Delivery) refresh(ginCtx *gin.Context) {
 
 	tokenID, err := d.ucAuthentication.AuthenticationGetTokenHash(ctx, userID, hash)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusUnauthorized, err)
+		NewErrorResponse(ginCtx, refreshErrorStatus(err), err)
 
 		return
 	}
 
 	usr, tokens, err = d.ucAuthentication.AuthenticationGenerateToken(ctx, userID, "", "")
 	if err != nil {
+		if isAccountLocked(err) {
+			NewErrorResponse(ginCtx, http.StatusForbidden, err)
+
+			return
+		}
+
 		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
 
 		return
 	}
 
-	err = d.ucAuthentication.AuthenticationUpdateTokenHash(ctx, tokenID, tokens.RefreshTokenHash)
+	session := token.SessionInput{
+		IP:        ginCtx.ClientIP(),
+		ExpiresAt: time.Unix(tokens.RefreshTokenExpires, 0),
+	}
+
+	err = d.ucAuthentication.AuthenticationUpdateTokenHash(ctx, userID, tokenID, hash, tokens.RefreshTokenHash, session)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, refreshErrorStatus(err), err)
 
 		return
 	}
@@ -176,3 +215,32 @@ func (d *Delivery) refresh(ginCtx *gin.Context) {
 
 	ginCtx.JSON(http.StatusOK, AuthResponse{User: usr, Tokens: tokens})
 }
+
+// getJWKS
+// @Summary JSON Web Key Set method.
+// @Description Public keys for verifying tokens signature.
+// @Tags authentication
+// @Produce json
+// @Success 200		{object}  	token.JWKS		true  "Public keys"
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /.well-known/jwks.json [get].
+func (d *Delivery) getJWKS(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getJWKS")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	jwks, err := d.ucAuthentication.AuthenticationGetJWKS(ctx)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ginCtx.Header("Cache-Control", jwksCacheControl)
+	ginCtx.JSON(http.StatusOK, jwks)
+}
@@ -136,7 +136,7 @@ func (d *Delivery) createCategory(ginCtx *gin.Context) {
 
 	categoryID, err := d.ucCategory.CategoryCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -181,7 +181,7 @@ func (d *Delivery) updateCategory(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucCategory.CategoryUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -21,6 +21,7 @@ import (
 // @Success 200		{array}  	comment.Comment		true  "Comments List"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/comments/{org_id} [get].
@@ -43,7 +44,7 @@ func (d *Delivery) getComments(ginCtx *gin.Context) {
 
 	results, err := d.ucComment.CommentGetAll(ctx, meta, params)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -63,6 +64,7 @@ func (d *Delivery) getComments(ginCtx *gin.Context) {
 // @Success 200		{object}  	comment.Comment		true  "Comment data"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/comments/{org_id}/{id} [get].
@@ -90,7 +92,7 @@ func (d *Delivery) getComment(ginCtx *gin.Context) {
 
 	list, err := d.ucComment.CommentGetOne(ctx, meta, commentID)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -109,6 +111,7 @@ func (d *Delivery) getComment(ginCtx *gin.Context) {
 // @Success 200		{string}  	string						true  "Comment ID"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/comments/ [post].
@@ -136,7 +139,7 @@ func (d *Delivery) createComment(ginCtx *gin.Context) {
 
 	commentID, err := d.ucComment.CommentCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -155,6 +158,7 @@ func (d *Delivery) createComment(ginCtx *gin.Context) {
 // @Success 200		{object}  	StatusResponse				true  "OK"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/comments/ [patch].
@@ -181,7 +185,7 @@ func (d *Delivery) updateComment(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucComment.CommentUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -201,6 +205,7 @@ func (d *Delivery) updateComment(ginCtx *gin.Context) {
 // @Success 200		{object}  	StatusResponse	true  "OK"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/comments/{org_id}/{id} [delete].
@@ -228,7 +233,7 @@ func (d *Delivery) deleteComment(ginCtx *gin.Context) {
 
 	err = d.ucComment.CommentDelete(ctx, meta, commentID)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
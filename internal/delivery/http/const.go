@@ -2,8 +2,27 @@ package http
 
 const (
 	authorizationHeader  = "Authorization"
+	apiKeyHeader         = "X-API-Key"
+	apiKeyCtx            = "apiKey"
 	userCtx              = "userId"
 	roleCtx              = "userRole"
 	maxAge               = 30
 	organizationQueryKey = "org_id"
+	jwksCacheControl     = "public, max-age=300"
+	adminRole            = "admin"
+	policyFormatCSV      = "csv"
+	policyFormatJSON     = "json"
+	requestIDHeader      = "X-Request-ID"
+	requestIDCtx         = "requestId"
+	organizationCtx      = "organizationId"
+	// policyColumns is a number of values in a rule: type and v0..v5.
+	policyColumns = 7
+	// maxAuditLimit is a maximum number of audit entries in a response.
+	maxAuditLimit = 100
+	// maxRequestIDLength limits request ID taken from the client.
+	maxRequestIDLength = 64
+	// storefrontCacheControl lets browsers and CDNs serve public storefront responses without the server.
+	storefrontCacheControl = "public, max-age=300, s-maxage=600, stale-while-revalidate=3600, stale-if-error=86400"
+	// maxStorefrontLimit is a maximum number of storefront items in a response.
+	maxStorefrontLimit = 100
 )
@@ -1,8 +1,8 @@
 package http
 
 import (
-	"github.com/casbin/casbin-pg-adapter"
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/policy"
 )
 
 // @title marketplace API
@@ -14,6 +14,9 @@ import (
 // @securityDefinitions.apikey Bearer
 // @in header
 // @name Authorization
+// @securityDefinitions.apikey ApiKey
+// @in header
+// @name X-API-Key
 
 // Delivery delivery.
 type Delivery struct {
@@ -30,7 +33,13 @@ type Delivery struct {
 	ucSpecification  usecase.Specification
 	ucFavorite       usecase.Favorite
 	ucRule           usecase.Rule
-	adapter          *pgadapter.Adapter
+	ucAPIKey         usecase.APIKey
+	ucInvitation     usecase.Invitation
+	ucAudit          usecase.Audit
+	ucMember         usecase.Member
+	ucSetting        usecase.Setting
+	ucStorefront     usecase.Storefront
+	enforcer         policy.PolicyEnforcer
 	isTracingOn      bool
 }
 
@@ -49,7 +58,13 @@ func New(
 	ucSpecification usecase.Specification,
 	ucFavorite usecase.Favorite,
 	ucRule usecase.Rule,
-	adapter *pgadapter.Adapter,
+	ucAPIKey usecase.APIKey,
+	ucInvitation usecase.Invitation,
+	ucAudit usecase.Audit,
+	ucMember usecase.Member,
+	ucSetting usecase.Setting,
+	ucStorefront usecase.Storefront,
+	enforcer policy.PolicyEnforcer,
 	isTracingOn bool,
 ) *Delivery {
 	return &Delivery{
@@ -66,7 +81,13 @@ func New(
 		ucSpecification:  ucSpecification,
 		ucFavorite:       ucFavorite,
 		ucRule:           ucRule,
-		adapter:          adapter,
+		ucAPIKey:         ucAPIKey,
+		ucInvitation:     ucInvitation,
+		ucAudit:          ucAudit,
+		ucMember:         ucMember,
+		ucSetting:        ucSetting,
+		ucStorefront:     ucStorefront,
+		enforcer:         enforcer,
 		isTracingOn:      isTracingOn,
 	}
 }
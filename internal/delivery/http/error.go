@@ -1,20 +1,35 @@
 package http
 
 import (
+	"database/sql"
 	"errors"
+	"net/http"
 
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/gin-gonic/gin"
 )
 
 var (
-	ErrEmptyIDParam      = errors.New("empty id param")
-	ErrInvalidAuthHeader = errors.New("invalid auth header")
-	ErrEmptyAuthHeader   = errors.New("empty auth header")
-	ErrUserIsNotFound    = errors.New("user is not found")
-	ErrInvalidUserID     = errors.New("invalid user id")
-	ErrRoleIsNotFound    = errors.New("role is not found")
-	ErrAccessDenied      = errors.New("access denied")
+	ErrEmptyIDParam           = errors.New("empty id param")
+	ErrInvalidAuthHeader      = errors.New("invalid auth header")
+	ErrEmptyAuthHeader        = errors.New("empty auth header")
+	ErrUserIsNotFound         = errors.New("user is not found")
+	ErrInvalidUserID          = errors.New("invalid user id")
+	ErrRoleIsNotFound         = errors.New("role is not found")
+	ErrAccessDenied           = errors.New("access denied")
+	ErrEmptyOrganizationID    = errors.New("empty organization id")
+	ErrScopeNotAllowed        = errors.New("scope exceeds rights of the current user")
+	ErrIdentityProvider       = errors.New("identity provider returned an error")
+	ErrEmptyAuthorizationCode = errors.New("empty authorization code or state")
+	ErrUnknownPolicyFormat    = errors.New("policy format must be csv or json")
+	ErrInvalidPolicyLine      = errors.New("policy line has too many values")
+	ErrInvalidPeriod          = errors.New("from and to must be RFC3339 datetimes")
+	ErrInvalidPagination      = errors.New("limit and offset must be non-negative integers")
+	ErrOrganizationDenied     = errors.New("user does not belong to the organization")
+	ErrOrganizationRequired   = errors.New("org_id is required for users of several organizations")
+	ErrNoOrganization         = errors.New("user does not belong to any organization")
 )
 
 // ErrorResponse my custom error.
@@ -29,3 +44,47 @@ func NewErrorResponse(c *gin.Context, statusCode int, err error) {
 
 	c.AbortWithStatusJSON(statusCode, ErrorResponse{Message: err.Error()})
 }
+
+// accessErrorStatus returns http status for error of usecase checking ownership of the data.
+func accessErrorStatus(err error) int {
+	if errors.Is(err, usecase.ErrNotOwner) ||
+		errors.Is(err, usecase.ErrNotAuthor) ||
+		errors.Is(err, usecase.ErrForeignOrganization) {
+		return http.StatusForbidden
+	}
+
+	return http.StatusInternalServerError
+}
+
+// tenantErrorStatus returns http status for error of the organization resolution.
+func tenantErrorStatus(err error) int {
+	if errors.Is(err, ErrOrganizationRequired) {
+		return http.StatusBadRequest
+	}
+
+	return http.StatusForbidden
+}
+
+// refreshErrorStatus returns http status for error of usecase rotating refresh token.
+func refreshErrorStatus(err error) int {
+	if errors.Is(err, usecase.ErrRefreshTokenReused) || errors.Is(err, sql.ErrNoRows) {
+		return http.StatusUnauthorized
+	}
+
+	return http.StatusInternalServerError
+}
+
+// ruleErrorStatus returns http status for error of usecase changing rules.
+func ruleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidRule),
+		errors.Is(err, usecase.ErrRoleHierarchyCycle),
+		errors.Is(err, usecase.ErrPolicyLockout),
+		errors.Is(err, rule.ErrStructHasNoValues):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrRoleNotFound), errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
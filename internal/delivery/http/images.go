@@ -136,7 +136,7 @@ func (d *Delivery) createImage(ginCtx *gin.Context) {
 
 	imageID, err := d.ucImage.ImageCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -181,7 +181,7 @@ func (d *Delivery) updateImage(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucImage.ImageUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -0,0 +1,210 @@
+package http
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/invitation"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getInvitations
+// @Summary Get all invitations of the organization method.
+// @Description Get all invitations of the organization with their statuses method.
+// @Tags invitations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   org_id	query 		string 		   			true  "Organization ID"
+// @Success 200		{array}  	invitation.Invitation	true  "Invitation List"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/invitations/ [get].
+func (d *Delivery) getInvitations(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getInvitations")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if meta.OrganizationID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyOrganizationID)
+
+		return
+	}
+
+	results, err := d.ucInvitation.InvitationGetAll(ctx, meta, meta.OrganizationID)
+	if err != nil {
+		NewErrorResponse(ginCtx, invitationErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, results)
+}
+
+// createInvitation
+// @Summary Create invitation method.
+// @Description Invites the phone number to the organization with the role, the code is sent by SMS.
+// @Tags invitations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   input 	body 		invitation.CreateInvitationInput 	true  "Organization ID, phone number and role"
+// @Success 200		{object}  	invitation.Invitation				true  "Invitation"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/invitations/ [post].
+func (d *Delivery) createInvitation(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.createInvitation")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	var input invitation.CreateInvitationInput
+	if err = ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	invite, err := d.ucInvitation.InvitationCreate(ctx, meta, input)
+	if err != nil {
+		NewErrorResponse(ginCtx, invitationErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, invite)
+}
+
+// deleteInvitation
+// @Summary Revoke invitation method.
+// @Description Revokes pending invitation by id method.
+// @Tags invitations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   	true  "Invitation ID"
+// @Success 200		{object}  	StatusResponse	true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 409	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/invitations/{id} [delete].
+func (d *Delivery) deleteInvitation(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.deleteInvitation")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	invitationID := ginCtx.Param("id")
+	if invitationID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	if err = d.ucInvitation.InvitationRevoke(ctx, meta, invitationID); err != nil {
+		status := invitationErrorStatus(err)
+		if errors.Is(err, usecase.ErrInvalidInvitation) {
+			status = http.StatusConflict
+		}
+
+		NewErrorResponse(ginCtx, status, err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// acceptInvitation
+// @Summary Accept invitation method.
+// @Description Attaches the invited user to the organization, the user is created if there is no user with the invited phone number.
+// @Tags authentication
+// @Accept  json
+// @Produce json
+// @Param   input 	body 		invitation.AcceptInvitationInput 	true  "Invitation code and user data for a new user"
+// @Success 200		{string}  	string								true  "User ID"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/invitations/accept [post].
+func (d *Delivery) acceptInvitation(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.acceptInvitation")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input invitation.AcceptInvitationInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	userID, err := d.ucInvitation.InvitationAccept(ctx, input)
+	if err != nil {
+		NewErrorResponse(ginCtx, invitationErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, map[string]interface{}{"id": userID})
+}
+
+// invitationErrorStatus returns http status for invitation error.
+func invitationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidInvitation),
+		errors.Is(err, usecase.ErrInvitationRoleNotAllowed),
+		errors.Is(err, usecase.ErrSignUpDataRequired):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrNotOrganizationOwner):
+		return http.StatusForbidden
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
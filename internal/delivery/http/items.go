@@ -137,7 +137,7 @@ func (d *Delivery) createItem(ginCtx *gin.Context) {
 
 	itemID, err := d.ucItem.ItemCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -182,7 +182,7 @@ func (d *Delivery) updateItem(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucItem.ItemUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -0,0 +1,242 @@
+package http
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/member"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getMembers
+// @Summary Get organization members method.
+// @Description Get staff of the organization with their roles and statuses method.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   	true  "Organization ID"
+// @Success 200		{array}  	member.Member	true  "Member List"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/organizations/{id}/members [get].
+func (d *Delivery) getMembers(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getMembers")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	organizationID := ginCtx.Param("id")
+	if organizationID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	results, err := d.ucMember.MemberGetAll(ctx, meta, organizationID)
+	if err != nil {
+		NewErrorResponse(ginCtx, memberErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, results)
+}
+
+// addMember
+// @Summary Add organization member method.
+// @Description Adds the existing user to the staff of the organization with the role method.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   			true  "Organization ID"
+// @Param   input 	body 		member.AddMemberInput 	true  "User ID and role name"
+// @Success 200		{object}  	member.Member			true  "Member"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 409	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/organizations/{id}/members [post].
+func (d *Delivery) addMember(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.addMember")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	organizationID := ginCtx.Param("id")
+	if organizationID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	var input member.AddMemberInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	result, err := d.ucMember.MemberAdd(ctx, meta, organizationID, input)
+	if err != nil {
+		NewErrorResponse(ginCtx, memberErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, result)
+}
+
+// updateMember
+// @Summary Update organization member method.
+// @Description Changes role or status of the member, suspended members lose access to the organization.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 		path 		string 		   				true  "Organization ID"
+// @Param   user_id		path 		string 		   				true  "User ID"
+// @Param   input 		body 		member.UpdateMemberInput 	true  "Role name and status"
+// @Success 200			{object}  	StatusResponse				true  "OK"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401	 		{object}	ErrorResponse
+// @Failure 403	 		{object}	ErrorResponse
+// @Failure 404	 		{object}	ErrorResponse
+// @Failure 409	 		{object}	ErrorResponse
+// @Failure 500 		{object} 	ErrorResponse
+// @Router /api/v1/organizations/{id}/members/{user_id} [patch].
+func (d *Delivery) updateMember(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.updateMember")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	organizationID := ginCtx.Param("id")
+	userID := ginCtx.Param("user_id")
+
+	if organizationID == "" || userID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	var input member.UpdateMemberInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if err = d.ucMember.MemberUpdate(ctx, meta, organizationID, userID, input); err != nil {
+		NewErrorResponse(ginCtx, memberErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// removeMember
+// @Summary Remove organization member method.
+// @Description Removes the member from the staff of the organization method.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 		path 		string 		   	true  "Organization ID"
+// @Param   user_id		path 		string 		   	true  "User ID"
+// @Success 200			{object}  	StatusResponse	true  "OK"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401	 		{object}	ErrorResponse
+// @Failure 403	 		{object}	ErrorResponse
+// @Failure 404	 		{object}	ErrorResponse
+// @Failure 409	 		{object}	ErrorResponse
+// @Failure 500 		{object} 	ErrorResponse
+// @Router /api/v1/organizations/{id}/members/{user_id} [delete].
+func (d *Delivery) removeMember(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.removeMember")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	organizationID := ginCtx.Param("id")
+	userID := ginCtx.Param("user_id")
+
+	if organizationID == "" || userID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if err = d.ucMember.MemberRemove(ctx, meta, organizationID, userID); err != nil {
+		NewErrorResponse(ginCtx, memberErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// memberErrorStatus returns http status for organization member error.
+func memberErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrOrganizationRoleInvalid),
+		errors.Is(err, member.ErrStructHasNoValues):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrNotOrganizationOwner):
+		return http.StatusForbidden
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrMemberExists),
+		errors.Is(err, usecase.ErrOwnerMembership):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -1,21 +1,23 @@
 package http
 
 import (
-	"fmt"
+	"database/sql"
+	"errors"
 	"net/http"
 	"strings"
 	"time"
 
-	"github.com/casbin/casbin/v2"
-	"github.com/casbin/casbin/v2/persist"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/apikey"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
 	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
 	cors "github.com/itsjamie/gin-cors"
 )
 
-// userIdentity validate access token.
+// userIdentity validate access token or API key.
 func (d *Delivery) userIdentity(ginCtx *gin.Context) {
 	ctx := context.New(ginCtx)
 
@@ -26,6 +28,12 @@ func (d *Delivery) userIdentity(ginCtx *gin.Context) {
 		ctx = context.New(ctxt)
 	}
 
+	if key := ginCtx.GetHeader(apiKeyHeader); key != "" {
+		d.apiKeyIdentity(ctx, ginCtx, key)
+
+		return
+	}
+
 	header := ginCtx.GetHeader(authorizationHeader)
 	if header == "" {
 		NewErrorResponse(ginCtx, http.StatusUnauthorized, ErrEmptyAuthHeader)
@@ -47,6 +55,10 @@ func (d *Delivery) userIdentity(ginCtx *gin.Context) {
 		return
 	}
 
+	if !d.checkUserStatus(ctx, ginCtx, userID) {
+		return
+	}
+
 	role, err := d.ucAuthorization.AuthorizationGetUserRole(ctx, userID)
 	if err != nil {
 		NewErrorResponse(ginCtx, http.StatusInternalServerError, ErrRoleIsNotFound)
@@ -58,6 +70,62 @@ func (d *Delivery) userIdentity(ginCtx *gin.Context) {
 	ginCtx.Set(roleCtx, role)
 }
 
+// apiKeyIdentity validates API key, requests are made on behalf of the user who created the key.
+func (d *Delivery) apiKeyIdentity(ctx context.Context, ginCtx *gin.Context, key string) {
+	apiKey, err := d.ucAPIKey.APIKeyAuthenticate(ctx, key)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusUnauthorized, err)
+
+		return
+	}
+
+	if !d.checkUserStatus(ctx, ginCtx, apiKey.UserID) {
+		return
+	}
+
+	role, err := d.ucAuthorization.AuthorizationGetUserRole(ctx, apiKey.UserID)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, ErrRoleIsNotFound)
+
+		return
+	}
+
+	ginCtx.Set(userCtx, apiKey.UserID)
+	ginCtx.Set(roleCtx, role)
+	ginCtx.Set(apiKeyCtx, apiKey)
+}
+
+// checkUserStatus rejects requests of blocked and suspended users whose tokens have not expired yet.
+func (d *Delivery) checkUserStatus(ctx context.Context, ginCtx *gin.Context, userID string) bool {
+	err := d.ucAuthorization.AuthorizationCheckUserStatus(ctx, userID)
+	if err == nil {
+		return true
+	}
+
+	switch {
+	case isAccountLocked(err):
+		NewErrorResponse(ginCtx, http.StatusForbidden, err)
+	case errors.Is(err, sql.ErrNoRows):
+		NewErrorResponse(ginCtx, http.StatusUnauthorized, ErrUserIsNotFound)
+	default:
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+	}
+
+	return false
+}
+
+// getAPIKey returns API key from authorization context if the request is authorized with it.
+func (d *Delivery) getAPIKey(ginCtx *gin.Context) (apikey.APIKey, bool) {
+	value, exists := ginCtx.Get(apiKeyCtx)
+	if !exists {
+		return apikey.APIKey{}, false
+	}
+
+	apiKey, ok := value.(apikey.APIKey)
+
+	return apiKey, ok
+}
+
 // getUserID returns user id from authorization context.
 func (d *Delivery) getUserID(ginCtx *gin.Context) (string, error) {
 	userID, exists := ginCtx.Get(userCtx)
@@ -101,23 +169,50 @@ func (d *Delivery) corsMiddleware() gin.HandlerFunc {
 	return cors.Middleware(cors.Config{
 		Origins:         "*",
 		Methods:         "GET, PUT, POST, DELETE, OPTIONS, UPDATE, PATCH",
-		RequestHeaders:  "X-Requested-With, Content-Type, Origin, Authorization, Accept, Client-Security-Token, Accept-Encoding, x-access-token", //nolint:lll
-		ExposedHeaders:  "",
+		RequestHeaders:  "X-Requested-With, Content-Type, Origin, Authorization, Accept, Client-Security-Token, Accept-Encoding, x-access-token, X-API-Key, X-Request-ID", //nolint:lll
+		ExposedHeaders:  requestIDHeader,
 		MaxAge:          maxAge * time.Second,
 		Credentials:     false,
 		ValidateHeaders: true,
 	})
 }
 
+// requestID takes request ID from the client or generates new one and returns it in the response.
+func (d *Delivery) requestID(ginCtx *gin.Context) {
+	requestID := ginCtx.GetHeader(requestIDHeader)
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = uuid.NewString()
+	}
+
+	ginCtx.Set(requestIDCtx, requestID)
+	ginCtx.Header(requestIDHeader, requestID)
+
+	ginCtx.Next()
+}
+
 // Authorize determines if current subject has been authorized to take an action on an object.
-func (d *Delivery) Authorize(obj string, act string, adapter persist.Adapter) gin.HandlerFunc {
+func (d *Delivery) Authorize(obj string, act string) gin.HandlerFunc {
 	return func(ginCtx *gin.Context) {
+		userID, err := d.getUserID(ginCtx)
+		if err != nil {
+			return
+		}
+
 		userRole, err := d.getUserRole(ginCtx)
 		if err != nil {
 			return
 		}
 
-		enforced, err := enforce(userRole, obj, act, adapter)
+		if apiKey, ok := d.getAPIKey(ginCtx); ok && !apiKey.Allows(obj, act) {
+			NewErrorResponse(ginCtx, http.StatusUnauthorized, ErrAccessDenied)
+
+			return
+		}
+
+		organizationID := d.getOrganizationID(ginCtx)
+		subject := d.getSubject(userID, userRole, organizationID)
+
+		enforced, err := d.enforcer.Enforce(context.New(ginCtx), subject, organizationID, obj, act)
 		if err != nil {
 			NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
 
@@ -134,24 +229,6 @@ func (d *Delivery) Authorize(obj string, act string, adapter persist.Adapter) gi
 	}
 }
 
-func enforce(sub string, obj string, act string, adapter persist.Adapter) (bool, error) {
-	enforcer, err := casbin.NewEnforcer("configs/rbac_model.conf", adapter)
-	if err != nil {
-		return false, fmt.Errorf("failed to create enforcer: %w", err)
-	}
-
-	if err = enforcer.LoadPolicy(); err != nil {
-		return false, fmt.Errorf("failed to load policy: %w", err)
-	}
-
-	ok, err := enforcer.Enforce(sub, obj, act)
-	if err != nil {
-		return false, fmt.Errorf("failed enforcing: %w", err)
-	}
-
-	return ok, nil
-}
-
 func (d *Delivery) parseMetadata(ginCtx *gin.Context) (query.MetaData, error) {
 	metaUserID, err := d.getUserID(ginCtx)
 	if err != nil {
@@ -163,9 +240,125 @@ func (d *Delivery) parseMetadata(ginCtx *gin.Context) (query.MetaData, error) {
 		return query.MetaData{}, err
 	}
 
+	organizationID := d.getOrganizationID(ginCtx)
+
+	if organizationRole := d.getOrganizationRole(metaUserID, userRole, organizationID); organizationRole != "" {
+		userRole = organizationRole
+	}
+
 	return query.MetaData{
 		UserID:         metaUserID,
-		OrganizationID: ginCtx.Query(organizationQueryKey),
+		OrganizationID: organizationID,
 		RoleName:       userRole,
+		RequestID:      ginCtx.GetString(requestIDCtx),
+		IP:             ginCtx.ClientIP(),
 	}, nil
 }
+
+// tenant returns middleware resolving organization of the request, API keys are bound to their organization.
+func (d *Delivery) tenant(isPersonalRoute bool) gin.HandlerFunc {
+	return func(ginCtx *gin.Context) {
+		userID, err := d.getUserID(ginCtx)
+		if err != nil {
+			return
+		}
+
+		userRole, err := d.getUserRole(ginCtx)
+		if err != nil {
+			return
+		}
+
+		requested := ginCtx.Query(organizationQueryKey)
+
+		if apiKey, ok := d.getAPIKey(ginCtx); ok {
+			if requested != "" && requested != apiKey.OrganizationID {
+				NewErrorResponse(ginCtx, http.StatusForbidden, ErrOrganizationDenied)
+
+				return
+			}
+
+			requested = apiKey.OrganizationID
+		}
+
+		organizationID, err := resolveOrganization(requested, userRole, d.enforcer.GetUserDomains(userID), isPersonalRoute)
+		if err != nil {
+			NewErrorResponse(ginCtx, tenantErrorStatus(err), err)
+
+			return
+		}
+
+		ginCtx.Set(organizationCtx, organizationID)
+
+		ginCtx.Next()
+	}
+}
+
+// resolveOrganization returns organization of the request made by the user belonging to the organizations.
+// Admins work in any organization or in all of them, staff only in one of their organizations, the only one is
+// chosen by default. Staff may work outside of an organization on personal routes only. Customers belong to no
+// organization and browse any of them, their own data is restricted by ownership.
+func resolveOrganization(requested string, userRole string, organizations []string, isPersonalRoute bool) (string, error) { //nolint:lll
+	if userRole == adminRole {
+		return requested, nil
+	}
+
+	if len(organizations) == 0 {
+		if usecase.IsOwnerRestricted(query.MetaData{RoleName: userRole}) {
+			return requested, nil
+		}
+
+		if requested != "" || !isPersonalRoute {
+			return "", ErrNoOrganization
+		}
+
+		return "", nil
+	}
+
+	if requested == "" {
+		switch {
+		case len(organizations) == 1:
+			return organizations[0], nil
+		case isPersonalRoute:
+			return "", nil
+		default:
+			return "", ErrOrganizationRequired
+		}
+	}
+
+	for _, organizationID := range organizations {
+		if organizationID == requested {
+			return requested, nil
+		}
+	}
+
+	return "", ErrOrganizationDenied
+}
+
+// getOrganizationID returns organization of the request resolved by the tenant middleware.
+func (d *Delivery) getOrganizationID(ginCtx *gin.Context) string {
+	return ginCtx.GetString(organizationCtx)
+}
+
+// getSubject returns policy subject of the user in the organization, users having a role in the organization
+// are grouped into it by their id, others are checked by their global role.
+func (d *Delivery) getSubject(userID string, userRole string, organizationID string) string {
+	if d.getOrganizationRole(userID, userRole, organizationID) != "" {
+		return userID
+	}
+
+	return userRole
+}
+
+// getOrganizationRole returns role of the user in the organization, admins keep their global role.
+func (d *Delivery) getOrganizationRole(userID string, userRole string, organizationID string) string {
+	if organizationID == "" || userRole == adminRole {
+		return ""
+	}
+
+	roles := d.enforcer.GetDomainRoles(userID, organizationID)
+	if len(roles) == 0 {
+		return ""
+	}
+
+	return roles[0]
+}
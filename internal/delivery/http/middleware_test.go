@@ -0,0 +1,84 @@
+package http
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResolveOrganization(t *testing.T) {
+	one := []string{"first"}
+	several := []string{"first", "second"}
+
+	tests := []struct {
+		name            string
+		requested       string
+		role            string
+		organizations   []string
+		isPersonalRoute bool
+		expected        string
+		err             error
+	}{
+		{name: "admin in all organizations", role: adminRole},
+		{name: "admin in any organization", requested: "other", role: adminRole, expected: "other"},
+		{name: "customer in any organization", requested: "other", role: "customer", expected: "other"},
+		{name: "customer in no organization", role: "customer"},
+		{name: "staff without organizations", role: "operator", err: ErrNoOrganization},
+		{name: "staff without organizations on personal route", role: "operator", isPersonalRoute: true},
+		{
+			name:            "staff without organizations requesting one on personal route",
+			requested:       "other",
+			role:            "operator",
+			isPersonalRoute: true,
+			err:             ErrNoOrganization,
+		},
+		{name: "staff of one organization", role: "operator", organizations: one, expected: "first"},
+		{name: "staff of several organizations", role: "operator", organizations: several, err: ErrOrganizationRequired},
+		{
+			name:            "staff of several organizations on personal route",
+			role:            "operator",
+			organizations:   several,
+			isPersonalRoute: true,
+		},
+		{
+			name:          "staff choosing own organization",
+			requested:     "second",
+			role:          "operator",
+			organizations: several,
+			expected:      "second",
+		},
+		{
+			name:          "staff choosing foreign organization",
+			requested:     "other",
+			role:          "operator",
+			organizations: several,
+			err:           ErrOrganizationDenied,
+		},
+		{
+			name:            "staff choosing foreign organization on personal route",
+			requested:       "other",
+			role:            "operator",
+			organizations:   one,
+			isPersonalRoute: true,
+			err:             ErrOrganizationDenied,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			organizationID, err := resolveOrganization(test.requested, test.role, test.organizations, test.isPersonalRoute)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, test.expected, organizationID)
+		})
+	}
+}
@@ -0,0 +1,135 @@
+package http
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	// oidcClientType is a client type of sessions created by OpenID Connect login.
+	oidcClientType = "web"
+	// maxDeviceNameLength is a length of the session device name column.
+	maxDeviceNameLength = 255
+)
+
+// oidcLogin
+// @Summary OpenID Connect login method.
+// @Description Redirects to the identity provider to start authorization code flow with PKCE.
+// @Tags authentication
+// @Param   provider 	path 		string 		true  "Identity provider name"
+// @Success 302
+// @Failure 404 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/oidc/{provider}/login [get].
+func (d *Delivery) oidcLogin(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.oidcLogin")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	authURL, err := d.ucAuthentication.AuthenticationOIDCStart(ctx, ginCtx.Param("provider"))
+	if err != nil {
+		NewErrorResponse(ginCtx, oidcErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.Redirect(http.StatusFound, authURL)
+}
+
+// oidcCallback
+// @Summary OpenID Connect callback method.
+// @Description Exchanges authorization code, links the identity to the user and issues tokens.
+// @Tags authentication
+// @Produce json
+// @Param   provider 	path 		string 			true  "Identity provider name"
+// @Param   code 		query 		string 			true  "Authorization code"
+// @Param   state 		query 		string 			true  "Login state"
+// @Success 200		{object}  	AuthResponse	true  "User data and Tokens"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401 	{object}    ErrorResponse
+// @Failure 403 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/oidc/{provider}/callback [get].
+func (d *Delivery) oidcCallback(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.oidcCallback")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if providerError := ginCtx.Query("error"); providerError != "" {
+		NewErrorResponse(ginCtx, http.StatusUnauthorized, fmt.Errorf("%w: %s", ErrIdentityProvider, providerError))
+
+		return
+	}
+
+	code := ginCtx.Query("code")
+	state := ginCtx.Query("state")
+
+	if code == "" || state == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyAuthorizationCode)
+
+		return
+	}
+
+	usr, tokens, err := d.ucAuthentication.AuthenticationOIDCCallback(ctx, ginCtx.Param("provider"), code, state)
+	if err != nil {
+		NewErrorResponse(ginCtx, oidcErrorStatus(err), err)
+
+		return
+	}
+
+	deviceName := ginCtx.Request.UserAgent()
+	if len(deviceName) > maxDeviceNameLength {
+		deviceName = deviceName[:maxDeviceNameLength]
+	}
+
+	session := token.SessionInput{
+		ClientType: oidcClientType,
+		DeviceName: deviceName,
+		IP:         ginCtx.ClientIP(),
+		ExpiresAt:  time.Unix(tokens.RefreshTokenExpires, 0),
+	}
+
+	err = d.ucAuthentication.AuthenticationCreateTokenHash(ctx, usr.ID, tokens.RefreshTokenHash, session)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	tokens.RefreshTokenHash = ""
+
+	ginCtx.JSON(http.StatusOK, AuthResponse{User: usr, Tokens: tokens})
+}
+
+// oidcErrorStatus maps OpenID Connect login errors to HTTP status codes.
+func oidcErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrUnknownIdentityProvider):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrInvalidOIDCState):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrIdentityNotLinkable), errors.Is(err, usecase.ErrIdentityLinkRefused),
+		errors.Is(err, usecase.ErrUserNotVerified), isAccountLocked(err):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -21,6 +21,7 @@ import (
 // @Success 200		{array}  	order.Order		true  "Order List"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/orders/{org_id} [get].
@@ -43,7 +44,7 @@ func (d *Delivery) getOrders(ginCtx *gin.Context) {
 
 	results, err := d.ucOrder.OrderGetAll(ctx, meta, params)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -63,6 +64,7 @@ func (d *Delivery) getOrders(ginCtx *gin.Context) {
 // @Success 200		{object}  	order.Order		true  "Order data"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/orders/{org_id}/{id} [get].
@@ -90,7 +92,7 @@ func (d *Delivery) getOrder(ginCtx *gin.Context) {
 
 	list, err := d.ucOrder.OrderGetOne(ctx, meta, orderID)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -109,6 +111,7 @@ func (d *Delivery) getOrder(ginCtx *gin.Context) {
 // @Success 200		{string}  	string					true  "Order ID"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/orders/ [post].
@@ -136,7 +139,7 @@ func (d *Delivery) createOrder(ginCtx *gin.Context) {
 
 	orderID, err := d.ucOrder.OrderCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -155,6 +158,7 @@ func (d *Delivery) createOrder(ginCtx *gin.Context) {
 // @Success 200		{object}  	StatusResponse			true  "OK"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/orders/ [patch].
@@ -181,7 +185,7 @@ func (d *Delivery) updateOrder(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucOrder.OrderUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -201,6 +205,7 @@ func (d *Delivery) updateOrder(ginCtx *gin.Context) {
 // @Success 200		{object}  	StatusResponse	true  "OK"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/orders/{org_id}/{id} [delete].
@@ -228,7 +233,7 @@ func (d *Delivery) deleteOrder(ginCtx *gin.Context) {
 
 	err = d.ucOrder.OrderDelete(ctx, meta, orderID)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
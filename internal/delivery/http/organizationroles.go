@@ -0,0 +1,168 @@
+package http
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getOrganizationRoles
+// @Summary Get organization roles method.
+// @Description Get roles of users in the organization method.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   	true  "Organization ID"
+// @Success 200		{array}  	role.Assignment	true  "Role Assignment List"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/organizations/{id}/roles [get].
+func (d *Delivery) getOrganizationRoles(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getOrganizationRoles")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	organizationID := ginCtx.Param("id")
+	if organizationID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	results, err := d.ucAuthorization.AuthorizationGetOrganizationRoles(ctx, organizationID)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, results)
+}
+
+// setOrganizationRole
+// @Summary Set organization role method.
+// @Description Assigns role of the user in the organization, the previous role is replaced.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 		path 		string 		   		true  "Organization ID"
+// @Param   user_id		path 		string 		   		true  "User ID"
+// @Param   input 		body 		role.AssignRoleInput 	true  "Role name"
+// @Success 200			{object}  	StatusResponse		true  "OK"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401	 		{object}	ErrorResponse
+// @Failure 500 		{object} 	ErrorResponse
+// @Router /api/v1/organizations/{id}/roles/{user_id} [post].
+func (d *Delivery) setOrganizationRole(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.setOrganizationRole")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	organizationID := ginCtx.Param("id")
+	userID := ginCtx.Param("user_id")
+
+	if organizationID == "" || userID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	var input role.AssignRoleInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if err = d.ucAuthorization.AuthorizationSetOrganizationRole(ctx, meta, organizationID, userID, input); err != nil {
+		NewErrorResponse(ginCtx, organizationRoleErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// deleteOrganizationRole
+// @Summary Delete organization role method.
+// @Description Removes role of the user in the organization method.
+// @Tags organizations
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 		path 		string 		   	true  "Organization ID"
+// @Param   user_id		path 		string 		   	true  "User ID"
+// @Success 200			{object}  	StatusResponse	true  "OK"
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 401	 		{object}	ErrorResponse
+// @Failure 404	 		{object}	ErrorResponse
+// @Failure 500 		{object} 	ErrorResponse
+// @Router /api/v1/organizations/{id}/roles/{user_id} [delete].
+func (d *Delivery) deleteOrganizationRole(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.deleteOrganizationRole")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	organizationID := ginCtx.Param("id")
+	userID := ginCtx.Param("user_id")
+
+	if organizationID == "" || userID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if err = d.ucAuthorization.AuthorizationDeleteOrganizationRole(ctx, meta, organizationID, userID); err != nil {
+		NewErrorResponse(ginCtx, organizationRoleErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// organizationRoleErrorStatus returns http status for organization role error.
+func organizationRoleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrOrganizationRoleInvalid):
+		return http.StatusBadRequest
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
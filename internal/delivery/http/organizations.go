@@ -1,6 +1,7 @@
 package http
 
 import (
+	"errors"
 	"net/http"
 
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/organization"
@@ -108,6 +109,7 @@ func (d *Delivery) getOrganization(ginCtx *gin.Context) {
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
+// @Failure 409 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/organizations/ [post].
 func (d *Delivery) createOrganization(ginCtx *gin.Context) {
@@ -134,7 +136,7 @@ func (d *Delivery) createOrganization(ginCtx *gin.Context) {
 
 	organizationID, err := d.ucOrganization.OrganizationCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, organizationErrorStatus(err), err)
 
 		return
 	}
@@ -154,6 +156,7 @@ func (d *Delivery) createOrganization(ginCtx *gin.Context) {
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
+// @Failure 409 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/organizations/ [patch].
 func (d *Delivery) updateOrganization(ginCtx *gin.Context) {
@@ -185,7 +188,7 @@ func (d *Delivery) updateOrganization(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucOrganization.OrganizationUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, organizationErrorStatus(err), err)
 
 		return
 	}
@@ -238,3 +241,16 @@ func (d *Delivery) deleteOrganization(ginCtx *gin.Context) {
 
 	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
 }
+
+// organizationErrorStatus returns http status for organization error.
+func organizationErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, organization.ErrInvalidSlug),
+		errors.Is(err, organization.ErrStructHasNoValues):
+		return http.StatusBadRequest
+	case errors.Is(err, organization.ErrSlugTaken):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
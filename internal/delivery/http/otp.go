@@ -0,0 +1,100 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// requestOTP
+// @Summary Request phone verification code method.
+// @Description Sends new verification code to the phone number waiting for verification.
+// @Tags authentication
+// @Accept  json
+// @Produce json
+// @Param   input 	body 		user.RequestOTPInput 	true  "Phone number"
+// @Success 200		{object}  	StatusResponse			true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/otp/request [post].
+func (d *Delivery) requestOTP(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.requestOTP")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input user.RequestOTPInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if err := d.ucAuthentication.AuthenticationRequestOTP(ctx, input.Phone); err != nil {
+		NewErrorResponse(ginCtx, otpErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// verifyPhone
+// @Summary Verify phone number method.
+// @Description Checks verification code and activates the user.
+// @Tags authentication
+// @Accept  json
+// @Produce json
+// @Param   input 	body 		user.VerifyPhoneInput 	true  "Phone number and code"
+// @Success 200		{object}  	StatusResponse			true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/otp/verify [post].
+func (d *Delivery) verifyPhone(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.verifyPhone")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input user.VerifyPhoneInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if err := d.ucAuthentication.AuthenticationVerifyPhone(ctx, input.Phone, input.Code); err != nil {
+		NewErrorResponse(ginCtx, otpErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// otpErrorStatus returns http status for one-time code error.
+func otpErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidOTP):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrOTPAttemptsExceeded), errors.Is(err, usecase.ErrOTPResendTooEarly):
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -0,0 +1,140 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// forgotPassword
+// @Summary Forgot password method.
+// @Description Sends password reset code to the phone number.
+// @Tags authentication
+// @Accept  json
+// @Produce json
+// @Param   input 	body 		user.ForgotPasswordInput 	true  "Phone number"
+// @Success 200		{object}  	StatusResponse				true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/password/forgot [post].
+func (d *Delivery) forgotPassword(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.forgotPassword")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input user.ForgotPasswordInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if err := d.ucAuthentication.AuthenticationForgotPassword(ctx, input.Phone); err != nil {
+		NewErrorResponse(ginCtx, otpErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// resetPassword
+// @Summary Reset password method.
+// @Description Sets new password using password reset code and revokes all sessions.
+// @Tags authentication
+// @Accept  json
+// @Produce json
+// @Param   input 	body 		user.ResetPasswordInput 	true  "Phone number, code and new password"
+// @Success 200		{object}  	StatusResponse				true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/password/reset [post].
+func (d *Delivery) resetPassword(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.resetPassword")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input user.ResetPasswordInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if err := d.ucAuthentication.AuthenticationResetPassword(ctx, input); err != nil {
+		NewErrorResponse(ginCtx, otpErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// changePassword
+// @Summary Change password method.
+// @Description Changes password of the current user and revokes all sessions.
+// @Tags authentication
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   input 	body 		user.ChangePasswordInput 	true  "Current and new password"
+// @Success 200		{object}  	StatusResponse				true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 429	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/me/password [put].
+func (d *Delivery) changePassword(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.changePassword")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := d.getUserID(ginCtx)
+	if err != nil {
+		return
+	}
+
+	var input user.ChangePasswordInput
+	if err = ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if err = d.ucAuthentication.AuthenticationChangePassword(ctx, userID, ginCtx.ClientIP(), input); err != nil {
+		switch {
+		case errors.Is(err, usecase.ErrInvalidPassword):
+			NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+		case errors.Is(err, usecase.ErrLoginLocked):
+			NewErrorResponse(ginCtx, http.StatusTooManyRequests, err)
+		default:
+			NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		}
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
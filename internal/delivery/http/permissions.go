@@ -0,0 +1,84 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getMyPermissions
+// @Summary Get current user permissions method.
+// @Description Get actions allowed to the current user by object, in the organization if org_id is set, the scopes of an API key apply.
+// @Tags authorization
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   org_id	query 		string 		   			false  "Organization ID"
+// @Success 200		{object}  	rule.UserPermissions	true  "Permission Matrix"
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/me/permissions [get].
+func (d *Delivery) getMyPermissions(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getMyPermissions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	permissions, err := d.ucAuthorization.AuthorizationGetPermissions(ctx, meta)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	// Authorize rejects actions out of the API key scopes as well
+	if apiKey, ok := d.getAPIKey(ginCtx); ok {
+		for obj, acts := range permissions.Permissions {
+			allowed := acts[:0]
+
+			for _, act := range acts {
+				if apiKey.Allows(obj, act) {
+					allowed = append(allowed, act)
+				}
+			}
+
+			if len(allowed) == 0 {
+				delete(permissions.Permissions, obj)
+			} else {
+				permissions.Permissions[obj] = allowed
+			}
+		}
+	}
+
+	ginCtx.JSON(http.StatusOK, permissions)
+}
+
+// getResources
+// @Summary Get protected resources method.
+// @Description Get objects and actions of all endpoints protected by the policy, for editing rules.
+// @Tags rules
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Success 200		{array}  	rule.Resource	true  "Resource List"
+// @Failure 401	 	{object}	ErrorResponse
+// @Router /api/v1/resources [get].
+func (d *Delivery) getResources(ginCtx *gin.Context) {
+	if d.isTracingOn {
+		_, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getResources")
+		defer span.End()
+	}
+
+	ginCtx.JSON(http.StatusOK, d.resources())
+}
@@ -0,0 +1,1081 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/casbin/casbin/v2/util"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/gin-gonic/gin"
+)
+
+// Route is a declaration of an API endpoint.
+type Route struct {
+	// HTTP method
+	Method string
+	// Full path of the endpoint
+	Path string
+	// Recourse name checked by Authorize, routes without it are available to every authenticated user
+	Object string
+	// REST verb checked by Authorize
+	Action string
+	// Public routes do not require an access token or API key
+	Public bool
+	// Personal routes access only data of the current user, staff may call them outside of their organizations
+	Personal bool
+	// Swagger summary of the endpoint
+	Summary string
+	// Swagger tag of the endpoint
+	Tag string
+
+	handler gin.HandlerFunc
+}
+
+// IsProtected checks if access to the route is controlled by the policy.
+func (r Route) IsProtected() bool {
+	return !r.Public && r.Object != ""
+}
+
+// Routes returns declarations of all API endpoints, handlers are not bound to usecases.
+func Routes() []Route {
+	return (&Delivery{}).routes()
+}
+
+// Resources returns objects and actions of all endpoints protected by the policy.
+func Resources() []rule.Resource {
+	return (&Delivery{}).resources()
+}
+
+// resources returns objects and actions of all endpoints protected by the policy.
+func (d *Delivery) resources() []rule.Resource {
+	var resources []rule.Resource
+
+	for _, route := range d.routes() {
+		if !route.IsProtected() {
+			continue
+		}
+
+		resources = append(resources, rule.Resource{
+			Object:  route.Object,
+			Action:  route.Action,
+			Method:  route.Method,
+			Path:    route.Path,
+			Summary: route.Summary,
+			Tag:     route.Tag,
+		})
+	}
+
+	return resources
+}
+
+// MissingPolicies returns protected routes without any matching policy rule.
+func MissingPolicies(routes []Route, policies [][]string) []Route {
+	var missing []Route
+
+	for _, route := range routes {
+		if route.IsProtected() && !hasPolicy(route, policies) {
+			missing = append(missing, route)
+		}
+	}
+
+	return missing
+}
+
+// AuditPolicies returns protected routes without any matching policy rule, access to them is denied to everyone.
+func (d *Delivery) AuditPolicies() []Route {
+	return MissingPolicies(d.routes(), d.enforcer.GetPolicies())
+}
+
+// hasPolicy checks if any policy rule matches the object and the action of the route the way the model does.
+func hasPolicy(route Route, policies [][]string) bool {
+	for _, policy := range policies {
+		if len(policy) > 2 && util.KeyMatch(route.Object, policy[1]) && util.RegexMatch(route.Action, policy[2]) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// routes returns declarations of all API endpoints.
+func (d *Delivery) routes() []Route {
+	return []Route{
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/signin",
+			Public:  true,
+			Summary: "SignIn user method.",
+			Tag:     "authentication",
+			handler: d.signIn,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/signup",
+			Public:  true,
+			Summary: "SignUp user method.",
+			Tag:     "authentication",
+			handler: d.signUp,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/refresh",
+			Public:  true,
+			Summary: "Refresh token method.",
+			Tag:     "authentication",
+			handler: d.refresh,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/otp/request",
+			Public:  true,
+			Summary: "Request phone verification code method.",
+			Tag:     "authentication",
+			handler: d.requestOTP,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/otp/verify",
+			Public:  true,
+			Summary: "Verify phone number method.",
+			Tag:     "authentication",
+			handler: d.verifyPhone,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/password/forgot",
+			Public:  true,
+			Summary: "Forgot password method.",
+			Tag:     "authentication",
+			handler: d.forgotPassword,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/password/reset",
+			Public:  true,
+			Summary: "Reset password method.",
+			Tag:     "authentication",
+			handler: d.resetPassword,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/2fa/enroll",
+			Public:  true,
+			Summary: "Enrol two-factor authentication while signing in method.",
+			Tag:     "authentication",
+			handler: d.enrollTwoFactorByChallenge,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/2fa/verify",
+			Public:  true,
+			Summary: "Two-factor sign in step method.",
+			Tag:     "authentication",
+			handler: d.verifyTwoFactor,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/auth/oidc/:provider/login",
+			Public:  true,
+			Summary: "OpenID Connect login method.",
+			Tag:     "authentication",
+			handler: d.oidcLogin,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/auth/oidc/:provider/callback",
+			Public:  true,
+			Summary: "OpenID Connect callback method.",
+			Tag:     "authentication",
+			handler: d.oidcCallback,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/auth/invitations/accept",
+			Public:  true,
+			Summary: "Accept invitation method.",
+			Tag:     "authentication",
+			handler: d.acceptInvitation,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/.well-known/jwks.json",
+			Public:  true,
+			Summary: "JSON Web Key Set method.",
+			Tag:     "authentication",
+			handler: d.getJWKS,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/public/v1/organizations/:slug",
+			Public:  true,
+			Summary: "Get storefront organization method.",
+			Tag:     "storefront",
+			handler: d.getStorefront,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/public/v1/organizations/:slug/categories",
+			Public:  true,
+			Summary: "Get storefront categories method.",
+			Tag:     "storefront",
+			handler: d.getStorefrontCategories,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/public/v1/organizations/:slug/items",
+			Public:  true,
+			Summary: "Get storefront items method.",
+			Tag:     "storefront",
+			handler: d.getStorefrontItems,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/public/v1/organizations/:slug/items/:id",
+			Public:  true,
+			Summary: "Get storefront item method.",
+			Tag:     "storefront",
+			handler: d.getStorefrontItem,
+		},
+
+		{
+			Method:   http.MethodGet,
+			Path:     "/api/v1/me/sessions",
+			Object:   "sessions",
+			Action:   "get",
+			Personal: true,
+			Summary:  "Get current user sessions method.",
+			Tag:      "sessions",
+			handler:  d.getMySessions,
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/api/v1/me/sessions/:id",
+			Object:   "session",
+			Action:   "delete",
+			Personal: true,
+			Summary:  "Revoke current user session method.",
+			Tag:      "sessions",
+			handler:  d.deleteMySession,
+		},
+		{
+			Method:   http.MethodPut,
+			Path:     "/api/v1/me/password",
+			Object:   "password",
+			Action:   "update",
+			Personal: true,
+			Summary:  "Change password method.",
+			Tag:      "authentication",
+			handler:  d.changePassword,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/api/v1/me/2fa",
+			Object:   "twofactor",
+			Action:   "post",
+			Personal: true,
+			Summary:  "Enrol two-factor authentication method.",
+			Tag:      "twofactor",
+			handler:  d.enrollTwoFactor,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/api/v1/me/2fa/confirm",
+			Object:   "twofactor",
+			Action:   "post",
+			Personal: true,
+			Summary:  "Confirm two-factor authentication method.",
+			Tag:      "twofactor",
+			handler:  d.confirmTwoFactor,
+		},
+		{
+			Method:   http.MethodPost,
+			Path:     "/api/v1/me/2fa/backup-codes",
+			Object:   "twofactor",
+			Action:   "post",
+			Personal: true,
+			Summary:  "Regenerate backup codes method.",
+			Tag:      "twofactor",
+			handler:  d.regenerateBackupCodes,
+		},
+		{
+			Method:   http.MethodDelete,
+			Path:     "/api/v1/me/2fa",
+			Object:   "twofactor",
+			Action:   "delete",
+			Personal: true,
+			Summary:  "Disable two-factor authentication method.",
+			Tag:      "twofactor",
+			handler:  d.disableTwoFactor,
+		},
+		{
+			Method:   http.MethodGet,
+			Path:     "/api/v1/me/permissions",
+			Personal: true,
+			Summary:  "Get current user permissions method.",
+			Tag:      "authorization",
+			handler:  d.getMyPermissions,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/users",
+			Object:  "users",
+			Action:  "get",
+			Summary: "Get all users method.",
+			Tag:     "users",
+			handler: d.getAllUsers,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/users/:id",
+			Object:  "user",
+			Action:  "get",
+			Summary: "Get user by id method.",
+			Tag:     "users",
+			handler: d.getUser,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/users",
+			Object:  "user",
+			Action:  "post",
+			Summary: "Create user method.",
+			Tag:     "users",
+			handler: d.createUser,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/users",
+			Object:  "user",
+			Action:  "patch",
+			Summary: "Update user method.",
+			Tag:     "users",
+			handler: d.updateUser,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/users/:id",
+			Object:  "user",
+			Action:  "delete",
+			Summary: "Delete user method.",
+			Tag:     "users",
+			handler: d.deleteUser,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/users/roles",
+			Object:  "roles",
+			Action:  "get",
+			Summary: "Get all roles method.",
+			Tag:     "roles",
+			handler: d.getAllRoles,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/users/:id/sessions",
+			Object:  "usersessions",
+			Action:  "get",
+			Summary: "Get user sessions method.",
+			Tag:     "sessions",
+			handler: d.getUserSessions,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/users/:id/sessions",
+			Object:  "usersessions",
+			Action:  "delete",
+			Summary: "Revoke user sessions method.",
+			Tag:     "sessions",
+			handler: d.deleteUserSessions,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/users/:id/lock",
+			Object:  "userlock",
+			Action:  "delete",
+			Summary: "Unlock user sign in method.",
+			Tag:     "users",
+			handler: d.unlockUser,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/users/:id/block",
+			Object:  "userstatus",
+			Action:  "post",
+			Summary: "Block user method.",
+			Tag:     "users",
+			handler: d.blockUser,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/users/:id/suspend",
+			Object:  "userstatus",
+			Action:  "post",
+			Summary: "Suspend user method.",
+			Tag:     "users",
+			handler: d.suspendUser,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/users/:id/reactivate",
+			Object:  "userstatus",
+			Action:  "post",
+			Summary: "Reactivate user method.",
+			Tag:     "users",
+			handler: d.reactivateUser,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/apikeys",
+			Object:  "apikeys",
+			Action:  "get",
+			Summary: "Get all API keys of the organization method.",
+			Tag:     "apikeys",
+			handler: d.getAPIKeys,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/apikeys",
+			Object:  "apikey",
+			Action:  "post",
+			Summary: "Create API key method.",
+			Tag:     "apikeys",
+			handler: d.createAPIKey,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/apikeys/:id",
+			Object:  "apikey",
+			Action:  "delete",
+			Summary: "Revoke API key method.",
+			Tag:     "apikeys",
+			handler: d.deleteAPIKey,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/invitations",
+			Object:  "invitations",
+			Action:  "get",
+			Summary: "Get all invitations of the organization method.",
+			Tag:     "invitations",
+			handler: d.getInvitations,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/invitations",
+			Object:  "invitation",
+			Action:  "post",
+			Summary: "Create invitation method.",
+			Tag:     "invitations",
+			handler: d.createInvitation,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/invitations/:id",
+			Object:  "invitation",
+			Action:  "delete",
+			Summary: "Revoke invitation method.",
+			Tag:     "invitations",
+			handler: d.deleteInvitation,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/organizations",
+			Object:  "organizations",
+			Action:  "get",
+			Summary: "Get all organizations method.",
+			Tag:     "organizations",
+			handler: d.getOrganizations,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/organizations/:id",
+			Object:  "organization",
+			Action:  "get",
+			Summary: "Get organization by id method.",
+			Tag:     "organizations",
+			handler: d.getOrganization,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/organizations",
+			Object:  "organization",
+			Action:  "post",
+			Summary: "Create organization method.",
+			Tag:     "organizations",
+			handler: d.createOrganization,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/organizations",
+			Object:  "organization",
+			Action:  "patch",
+			Summary: "Update organization method.",
+			Tag:     "organizations",
+			handler: d.updateOrganization,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/organizations/:id",
+			Object:  "organization",
+			Action:  "delete",
+			Summary: "Delete organization method.",
+			Tag:     "organizations",
+			handler: d.deleteOrganization,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/organizations/:id/roles",
+			Object:  "organizationroles",
+			Action:  "get",
+			Summary: "Get organization roles method.",
+			Tag:     "organizations",
+			handler: d.getOrganizationRoles,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/organizations/:id/roles/:user_id",
+			Object:  "organizationrole",
+			Action:  "post",
+			Summary: "Set organization role method.",
+			Tag:     "organizations",
+			handler: d.setOrganizationRole,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/organizations/:id/roles/:user_id",
+			Object:  "organizationrole",
+			Action:  "delete",
+			Summary: "Delete organization role method.",
+			Tag:     "organizations",
+			handler: d.deleteOrganizationRole,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/organizations/:id/members",
+			Object:  "members",
+			Action:  "get",
+			Summary: "Get organization members method.",
+			Tag:     "organizations",
+			handler: d.getMembers,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/organizations/:id/members",
+			Object:  "member",
+			Action:  "post",
+			Summary: "Add organization member method.",
+			Tag:     "organizations",
+			handler: d.addMember,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/organizations/:id/members/:user_id",
+			Object:  "member",
+			Action:  "patch",
+			Summary: "Update organization member method.",
+			Tag:     "organizations",
+			handler: d.updateMember,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/organizations/:id/members/:user_id",
+			Object:  "member",
+			Action:  "delete",
+			Summary: "Remove organization member method.",
+			Tag:     "organizations",
+			handler: d.removeMember,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/settings",
+			Object:  "settings",
+			Action:  "get",
+			Summary: "Get organization settings method.",
+			Tag:     "settings",
+			handler: d.getSettings,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/settings",
+			Object:  "settings",
+			Action:  "patch",
+			Summary: "Update organization settings method.",
+			Tag:     "settings",
+			handler: d.updateSettings,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/categories",
+			Object:  "categories",
+			Action:  "get",
+			Summary: "Get all categories method.",
+			Tag:     "categories",
+			handler: d.getCategories,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/categories/:id",
+			Object:  "category",
+			Action:  "get",
+			Summary: "Get category by id method.",
+			Tag:     "categories",
+			handler: d.getCategory,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/categories",
+			Object:  "category",
+			Action:  "post",
+			Summary: "Create category method.",
+			Tag:     "categories",
+			handler: d.createCategory,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/categories",
+			Object:  "category",
+			Action:  "patch",
+			Summary: "Update category method.",
+			Tag:     "categories",
+			handler: d.updateCategory,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/categories/:id",
+			Object:  "category",
+			Action:  "delete",
+			Summary: "Delete category method.",
+			Tag:     "categories",
+			handler: d.deleteCategory,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/items",
+			Object:  "items",
+			Action:  "get",
+			Summary: "Get all items method.",
+			Tag:     "items",
+			handler: d.getItems,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/items/:id",
+			Object:  "item",
+			Action:  "get",
+			Summary: "Get item by id method.",
+			Tag:     "items",
+			handler: d.getItem,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/items",
+			Object:  "item",
+			Action:  "post",
+			Summary: "Create item method.",
+			Tag:     "items",
+			handler: d.createItem,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/items",
+			Object:  "item",
+			Action:  "patch",
+			Summary: "Update item method.",
+			Tag:     "items",
+			handler: d.updateItem,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/items/:id",
+			Object:  "item",
+			Action:  "delete",
+			Summary: "Delete item method.",
+			Tag:     "items",
+			handler: d.deleteItem,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/tables",
+			Object:  "tables",
+			Action:  "get",
+			Summary: "Get all tables method.",
+			Tag:     "tables",
+			handler: d.getTables,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/tables/:id",
+			Object:  "table",
+			Action:  "get",
+			Summary: "Get table by id method.",
+			Tag:     "tables",
+			handler: d.getTable,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/tables",
+			Object:  "table",
+			Action:  "post",
+			Summary: "Create table method.",
+			Tag:     "tables",
+			handler: d.createTable,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/tables",
+			Object:  "table",
+			Action:  "patch",
+			Summary: "Update table method.",
+			Tag:     "tables",
+			handler: d.updateTable,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/tables/:id",
+			Object:  "table",
+			Action:  "delete",
+			Summary: "Delete table method.",
+			Tag:     "tables",
+			handler: d.deleteTable,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/orders",
+			Object:  "orders",
+			Action:  "get",
+			Summary: "Get all orders method.",
+			Tag:     "orders",
+			handler: d.getOrders,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/orders/:id",
+			Object:  "order",
+			Action:  "get",
+			Summary: "Get order by id method.",
+			Tag:     "orders",
+			handler: d.getOrder,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/orders",
+			Object:  "order",
+			Action:  "post",
+			Summary: "Create order method.",
+			Tag:     "orders",
+			handler: d.createOrder,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/orders",
+			Object:  "order",
+			Action:  "patch",
+			Summary: "Update order method.",
+			Tag:     "orders",
+			handler: d.updateOrder,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/orders/:id",
+			Object:  "order",
+			Action:  "delete",
+			Summary: "Delete order method.",
+			Tag:     "orders",
+			handler: d.deleteOrder,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/images",
+			Object:  "images",
+			Action:  "get",
+			Summary: "Get all images method.",
+			Tag:     "images",
+			handler: d.getImages,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/images/:id",
+			Object:  "image",
+			Action:  "get",
+			Summary: "Get image by id method.",
+			Tag:     "images",
+			handler: d.getImage,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/images",
+			Object:  "image",
+			Action:  "post",
+			Summary: "Create image method.",
+			Tag:     "images",
+			handler: d.createImage,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/images",
+			Object:  "image",
+			Action:  "patch",
+			Summary: "Update image method.",
+			Tag:     "images",
+			handler: d.updateImage,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/images/:id",
+			Object:  "image",
+			Action:  "delete",
+			Summary: "Delete image method.",
+			Tag:     "images",
+			handler: d.deleteImage,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/comments",
+			Object:  "comments",
+			Action:  "get",
+			Summary: "Get all comments method.",
+			Tag:     "comments",
+			handler: d.getComments,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/comments/:id",
+			Object:  "comment",
+			Action:  "get",
+			Summary: "Get comment by id method.",
+			Tag:     "comments",
+			handler: d.getComment,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/comments",
+			Object:  "comment",
+			Action:  "post",
+			Summary: "Create comment method.",
+			Tag:     "comments",
+			handler: d.createComment,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/comments",
+			Object:  "comment",
+			Action:  "patch",
+			Summary: "Update comment method.",
+			Tag:     "comments",
+			handler: d.updateComment,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/comments/:id",
+			Object:  "comment",
+			Action:  "delete",
+			Summary: "Delete comment method.",
+			Tag:     "comments",
+			handler: d.deleteComment,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/specifications",
+			Object:  "specifications",
+			Action:  "get",
+			Summary: "Get all specifications method.",
+			Tag:     "specifications",
+			handler: d.getSpecifications,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/specifications/:id",
+			Object:  "specification",
+			Action:  "get",
+			Summary: "Get specification by id method.",
+			Tag:     "specifications",
+			handler: d.getSpecification,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/specifications",
+			Object:  "specification",
+			Action:  "post",
+			Summary: "Create specification method.",
+			Tag:     "specifications",
+			handler: d.createSpecification,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/specifications",
+			Object:  "specification",
+			Action:  "patch",
+			Summary: "Update specification method.",
+			Tag:     "specifications",
+			handler: d.updateSpecification,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/specifications/:id",
+			Object:  "specification",
+			Action:  "delete",
+			Summary: "Delete specification method.",
+			Tag:     "specifications",
+			handler: d.deleteSpecification,
+		},
+
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/favorites",
+			Object:  "favorite",
+			Action:  "post",
+			Summary: "Create favorite item method.",
+			Tag:     "favorites",
+			handler: d.createFavorite,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/favorites/:item_id",
+			Object:  "favorite",
+			Action:  "delete",
+			Summary: "Delete favorite item method.",
+			Tag:     "favorites",
+			handler: d.deleteFavorite,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/rules",
+			Object:  "rules",
+			Action:  "get",
+			Summary: "Get all rules method.",
+			Tag:     "rules",
+			handler: d.getRules,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/rules/export",
+			Object:  "policy",
+			Action:  "get",
+			Summary: "Export policy method.",
+			Tag:     "rules",
+			handler: d.exportPolicy,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/rules/import",
+			Object:  "policy",
+			Action:  "post",
+			Summary: "Import policy method.",
+			Tag:     "rules",
+			handler: d.importPolicy,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/rules/evaluate",
+			Object:  "policyevaluation",
+			Action:  "post",
+			Summary: "Evaluate policy method.",
+			Tag:     "rules",
+			handler: d.evaluatePolicy,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/rules/:id",
+			Object:  "rule",
+			Action:  "get",
+			Summary: "Get rule by id method.",
+			Tag:     "rules",
+			handler: d.getRule,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/rules",
+			Object:  "rule",
+			Action:  "post",
+			Summary: "Create rule method.",
+			Tag:     "rules",
+			handler: d.createRule,
+		},
+		{
+			Method:  http.MethodPatch,
+			Path:    "/api/v1/rules",
+			Object:  "rule",
+			Action:  "patch",
+			Summary: "Update rule method.",
+			Tag:     "rules",
+			handler: d.updateRule,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/rules/:id",
+			Object:  "rule",
+			Action:  "delete",
+			Summary: "Delete rule method.",
+			Tag:     "rules",
+			handler: d.deleteRule,
+		},
+
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/roles/:name/parents",
+			Object:  "roleparents",
+			Action:  "get",
+			Summary: "Get role parents method.",
+			Tag:     "roles",
+			handler: d.getRoleParents,
+		},
+		{
+			Method:  http.MethodPost,
+			Path:    "/api/v1/roles/:name/parents",
+			Object:  "roleparent",
+			Action:  "post",
+			Summary: "Add role parent method.",
+			Tag:     "roles",
+			handler: d.createRoleParent,
+		},
+		{
+			Method:  http.MethodDelete,
+			Path:    "/api/v1/roles/:name/parents/:parent",
+			Object:  "roleparent",
+			Action:  "delete",
+			Summary: "Delete role parent method.",
+			Tag:     "roles",
+			handler: d.deleteRoleParent,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/roles/:name/permissions",
+			Object:  "rolepermissions",
+			Action:  "get",
+			Summary: "Get role permissions method.",
+			Tag:     "roles",
+			handler: d.getRolePermissions,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/resources",
+			Object:  "resources",
+			Action:  "get",
+			Summary: "Get protected resources method.",
+			Tag:     "rules",
+			handler: d.getResources,
+		},
+		{
+			Method:  http.MethodGet,
+			Path:    "/api/v1/audit",
+			Object:  "audit",
+			Action:  "get",
+			Summary: "Get audit log method.",
+			Tag:     "audit",
+			handler: d.getAuditEntries,
+		},
+	}
+}
@@ -0,0 +1,69 @@
+package http
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInitRoutes(t *testing.T) {
+	router, err := (&Delivery{}).InitRoutes(gin.TestMode, nil)
+	require.NoError(t, err)
+
+	registered := make(map[string]bool)
+	for _, route := range router.Routes() {
+		registered[route.Method+" "+route.Path] = true
+	}
+
+	for _, route := range Routes() {
+		require.True(t, registered[route.Method+" "+route.Path], "%s %s is not registered", route.Method, route.Path)
+	}
+}
+
+func TestTrustedProxies(t *testing.T) {
+	clientIP := func(trustedProxies []string) string {
+		router, err := (&Delivery{}).InitRoutes(gin.TestMode, trustedProxies)
+		require.NoError(t, err)
+
+		var ip string
+
+		router.GET("/ip", func(ginCtx *gin.Context) { ip = ginCtx.ClientIP() })
+
+		request := httptest.NewRequest(http.MethodGet, "/ip", nil)
+		request.RemoteAddr = "10.0.0.1:1234"
+		request.Header.Set("X-Forwarded-For", "203.0.113.7")
+
+		router.ServeHTTP(httptest.NewRecorder(), request)
+
+		return ip
+	}
+
+	require.Equal(t, "10.0.0.1", clientIP(nil))
+	require.Equal(t, "203.0.113.7", clientIP([]string{"10.0.0.0/8"}))
+
+	_, err := (&Delivery{}).InitRoutes(gin.TestMode, []string{"proxy"})
+	require.Error(t, err)
+}
+
+func TestMissingPolicies(t *testing.T) {
+	routes := []Route{
+		{Method: http.MethodGet, Path: "/auth", Public: true},
+		{Method: http.MethodGet, Path: "/me", Object: ""},
+		{Method: http.MethodGet, Path: "/items", Object: "items", Action: "get"},
+		{Method: http.MethodPost, Path: "/items", Object: "item", Action: "post"},
+		{Method: http.MethodGet, Path: "/orders", Object: "orders", Action: "get"},
+	}
+
+	policies := [][]string{
+		{"vendor", "items", "get", "allow"},
+		{"admin", "item", "(post)|(patch)", "deny"},
+	}
+
+	missing := MissingPolicies(routes, policies)
+
+	require.Len(t, missing, 1)
+	require.Equal(t, "/orders", missing[0].Path)
+}
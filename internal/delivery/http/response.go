@@ -11,6 +11,14 @@ type AuthResponse struct {
 	User user.User `json:"user"`
 	// Tokens
 	Tokens token.Tokens `json:"tokens"`
+	// Backup codes, only after two-factor enrolment on signing in
+	BackupCodes []string `json:"backupCodes,omitempty"`
+}
+
+// ChallengeResponse is a response when signing in requires the second step.
+type ChallengeResponse struct {
+	// Challenge
+	Challenge token.Challenge `json:"challenge"`
 }
 
 // StatusResponse is a status response data.
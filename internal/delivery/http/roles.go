@@ -0,0 +1,178 @@
+package http
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getRoleParents
+// @Summary Get role parents method.
+// @Description Get roles the role inherits permissions from method.
+// @Tags roles
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   name 	path 		string 		true  "Role name"
+// @Success 200		{array}  	string		true  "Parent Role List"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/roles/{name}/parents [get].
+func (d *Delivery) getRoleParents(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getRoleParents")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	parents, err := d.ucRule.RuleGetRoleParents(ctx, ginCtx.Param("name"))
+	if err != nil {
+		NewErrorResponse(ginCtx, roleErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, parents)
+}
+
+// createRoleParent
+// @Summary Add role parent method.
+// @Description Makes the role inherit permissions of the parent role in all organizations.
+// @Tags roles
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   name 	path 		string 		   		true  "Role name"
+// @Param   input 	body 		rule.RoleParentInput 	true  "Parent role name"
+// @Success 200		{object}  	StatusResponse		true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/roles/{name}/parents [post].
+func (d *Delivery) createRoleParent(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.createRoleParent")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input rule.RoleParentInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if err = d.ucRule.RuleCreateRoleParent(ctx, meta, ginCtx.Param("name"), input); err != nil {
+		NewErrorResponse(ginCtx, roleErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// deleteRoleParent
+// @Summary Delete role parent method.
+// @Description Stops the role inheriting permissions of the parent role method.
+// @Tags roles
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   name 	path 		string 		   	true  "Role name"
+// @Param   parent 	path 		string 		   	true  "Parent role name"
+// @Success 200		{object}  	StatusResponse	true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/roles/{name}/parents/{parent} [delete].
+func (d *Delivery) deleteRoleParent(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.deleteRoleParent")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if err = d.ucRule.RuleDeleteRoleParent(ctx, meta, ginCtx.Param("name"), ginCtx.Param("parent")); err != nil {
+		NewErrorResponse(ginCtx, roleErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// getRolePermissions
+// @Summary Get role permissions method.
+// @Description Get effective permissions of the role including inherited ones, deny wins over allow.
+// @Tags roles
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   name 	path 		string 				true  "Role name"
+// @Success 200		{array}  	rule.Permission		true  "Permission List"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/roles/{name}/permissions [get].
+func (d *Delivery) getRolePermissions(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getRolePermissions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	permissions, err := d.ucRule.RuleGetRolePermissions(ctx, ginCtx.Param("name"))
+	if err != nil {
+		NewErrorResponse(ginCtx, roleErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, permissions)
+}
+
+// roleErrorStatus returns http status for role hierarchy error.
+func roleErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrRoleHierarchyCycle):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrRoleNotFound), errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	default:
+		return http.StatusInternalServerError
+	}
+}
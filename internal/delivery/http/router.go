@@ -1,6 +1,8 @@
 package http
 
 import (
+	"fmt"
+
 	"github.com/evgeniy-dammer/marketplace-api/docs"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/gin-contrib/gzip"
@@ -13,12 +15,23 @@ import (
 	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
-// InitRoutes initialize routes.
-func (d *Delivery) InitRoutes(mode string) *gin.Engine {
+// InitRoutes initialize routes. Client IP is read from forwarding headers only behind the trusted proxies,
+// no proxy is trusted if trustedProxies is empty.
+func (d *Delivery) InitRoutes(mode string, trustedProxies []string) (*gin.Engine, error) {
 	gin.SetMode(mode)
 
 	router := gin.New()
+
+	if len(trustedProxies) == 0 {
+		trustedProxies = nil
+	}
+
+	if err := router.SetTrustedProxies(trustedProxies); err != nil {
+		return nil, fmt.Errorf("invalid trusted proxies: %w", err)
+	}
+
 	router.Use(otelgin.Middleware("marketplace-api"))
+	router.Use(d.requestID)
 	router.Use(d.corsMiddleware())
 	router.Use(gzip.Gzip(gzip.DefaultCompression))
 	router.Use(ginzap.RecoveryWithZap(logger.Logger, true))
@@ -28,13 +41,6 @@ func (d *Delivery) InitRoutes(mode string) *gin.Engine {
 		pprof.Register(router, "dev/pprof")
 	}
 
-	auth := router.Group("/auth")
-	{
-		auth.POST("/signin", d.signIn)
-		auth.POST("/signup", d.signUp)
-		auth.POST("/refresh", d.refresh)
-	}
-
 	metrics := router.Group("/metrics")
 	{
 		metrics.GET("/", gin.WrapH(promhttp.Handler()))
@@ -43,108 +49,19 @@ func (d *Delivery) InitRoutes(mode string) *gin.Engine {
 	docs.SwaggerInfo_swagger.BasePath = "/" //nolint:nosnakecase
 	router.Any("/docs/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	api := router.Group("/api", d.userIdentity)
-	{
-		version1 := api.Group("/v1")
-		{
-			users := version1.Group("/users")
-			{
-				users.GET("", d.Authorize("users", "get", d.adapter), d.getAllUsers)
-				users.GET("/:id", d.Authorize("user", "get", d.adapter), d.getUser)
-				users.POST("", d.Authorize("user", "post", d.adapter), d.createUser)
-				users.PATCH("", d.Authorize("user", "patch", d.adapter), d.updateUser)
-				users.DELETE("/:id", d.Authorize("user", "delete", d.adapter), d.deleteUser)
-				users.GET("/roles", d.Authorize("roles", "get", d.adapter), d.getAllRoles)
-			}
-
-			organizations := version1.Group("/organizations")
-			{
-				organizations.GET("", d.Authorize("organizations", "get", d.adapter), d.getOrganizations)
-				organizations.GET("/:id", d.Authorize("organization", "get", d.adapter), d.getOrganization)
-				organizations.POST("", d.Authorize("organization", "post", d.adapter), d.createOrganization)
-				organizations.PATCH("", d.Authorize("organization", "patch", d.adapter), d.updateOrganization)
-				organizations.DELETE("/:id", d.Authorize("organization", "delete", d.adapter), d.deleteOrganization)
-			}
-
-			categories := version1.Group("/categories")
-			{
-				categories.GET("", d.Authorize("categories", "get", d.adapter), d.getCategories)
-				categories.GET("/:id", d.Authorize("category", "get", d.adapter), d.getCategory)
-				categories.POST("", d.Authorize("category", "post", d.adapter), d.createCategory)
-				categories.PATCH("", d.Authorize("category", "patch", d.adapter), d.updateCategory)
-				categories.DELETE("/:id", d.Authorize("category", "delete", d.adapter), d.deleteCategory)
-			}
-
-			items := version1.Group("/items")
-			{
-				items.GET("", d.Authorize("items", "get", d.adapter), d.getItems)
-				items.GET("/:id", d.Authorize("item", "get", d.adapter), d.getItem)
-				items.POST("", d.Authorize("item", "post", d.adapter), d.createItem)
-				items.PATCH("", d.Authorize("item", "patch", d.adapter), d.updateItem)
-				items.DELETE("/:id", d.Authorize("item", "delete", d.adapter), d.deleteItem)
-			}
-
-			tables := version1.Group("/tables")
-			{
-				tables.GET("", d.Authorize("tables", "get", d.adapter), d.getTables)
-				tables.GET("/:id", d.Authorize("table", "get", d.adapter), d.getTable)
-				tables.POST("", d.Authorize("table", "post", d.adapter), d.createTable)
-				tables.PATCH("", d.Authorize("table", "patch", d.adapter), d.updateTable)
-				tables.DELETE("/:id", d.Authorize("table", "delete", d.adapter), d.deleteTable)
-			}
-
-			orders := version1.Group("/orders")
-			{
-				orders.GET("", d.Authorize("orders", "get", d.adapter), d.getOrders)
-				orders.GET("/:id", d.Authorize("order", "get", d.adapter), d.getOrder)
-				orders.POST("", d.Authorize("order", "post", d.adapter), d.createOrder)
-				orders.PATCH("", d.Authorize("order", "patch", d.adapter), d.updateOrder)
-				orders.DELETE("/:id", d.Authorize("order", "delete", d.adapter), d.deleteOrder)
-			}
+	for _, route := range d.routes() {
+		handlers := []gin.HandlerFunc{route.handler}
 
-			images := version1.Group("/images")
-			{
-				images.GET("", d.Authorize("images", "get", d.adapter), d.getImages)
-				images.GET("/:id", d.Authorize("image", "get", d.adapter), d.getImage)
-				images.POST("", d.Authorize("image", "post", d.adapter), d.createImage)
-				images.PATCH("", d.Authorize("image", "patch", d.adapter), d.updateImage)
-				images.DELETE("/:id", d.Authorize("image", "delete", d.adapter), d.deleteImage)
-			}
-
-			comments := version1.Group("/comments")
-			{
-				comments.GET("", d.Authorize("comments", "get", d.adapter), d.getComments)
-				comments.GET("/:id", d.Authorize("comment", "get", d.adapter), d.getComment)
-				comments.POST("", d.Authorize("comment", "post", d.adapter), d.createComment)
-				comments.PATCH("", d.Authorize("comment", "patch", d.adapter), d.updateComment)
-				comments.DELETE("/:id", d.Authorize("comment", "delete", d.adapter), d.deleteComment)
-			}
-
-			specifications := version1.Group("/specifications")
-			{
-				specifications.GET("", d.Authorize("specifications", "get", d.adapter), d.getSpecifications)
-				specifications.GET("/:id", d.Authorize("specification", "get", d.adapter), d.getSpecification)
-				specifications.POST("", d.Authorize("specification", "post", d.adapter), d.createSpecification)
-				specifications.PATCH("", d.Authorize("specification", "patch", d.adapter), d.updateSpecification)
-				specifications.DELETE("/:id", d.Authorize("specification", "delete", d.adapter), d.deleteSpecification)
-			}
-
-			favorites := version1.Group("/favorites")
-			{
-				favorites.POST("", d.Authorize("favorite", "post", d.adapter), d.createFavorite)
-				favorites.DELETE("/:item_id", d.Authorize("favorite", "delete", d.adapter), d.deleteFavorite)
-			}
+		if route.IsProtected() {
+			handlers = append([]gin.HandlerFunc{d.Authorize(route.Object, route.Action)}, handlers...)
+		}
 
-			rules := version1.Group("/rules")
-			{
-				rules.GET("", d.Authorize("rules", "get", d.adapter), d.getRules)
-				rules.GET("/:id", d.Authorize("rule", "get", d.adapter), d.getRule)
-				rules.POST("", d.Authorize("rule", "post", d.adapter), d.createRule)
-				rules.PATCH("", d.Authorize("rule", "patch", d.adapter), d.updateRule)
-				rules.DELETE("/:id", d.Authorize("rule", "delete", d.adapter), d.deleteRule)
-			}
+		if !route.Public {
+			handlers = append([]gin.HandlerFunc{d.userIdentity, d.tenant(route.Personal)}, handlers...)
 		}
+
+		router.Handle(route.Method, route.Path, handlers...)
 	}
 
-	return router
+	return router, nil
 }
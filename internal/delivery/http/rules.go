@@ -1,7 +1,13 @@
 package http
 
 import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
@@ -98,7 +104,7 @@ func (d *Delivery) getRule(ginCtx *gin.Context) {
 
 // createRule
 // @Summary Create rule method.
-// @Description Create rule method.
+// @Description Create rule method, the rule must refer to known roles, resources, verbs and effects.
 // @Tags rules
 // @Accept  json
 // @Produce json
@@ -134,7 +140,7 @@ func (d *Delivery) createRule(ginCtx *gin.Context) {
 
 	ruleID, err := d.ucRule.RuleCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, ruleErrorStatus(err), err)
 
 		return
 	}
@@ -144,7 +150,7 @@ func (d *Delivery) createRule(ginCtx *gin.Context) {
 
 // updateRule
 // @Summary Update rule method.
-// @Description Update rule method.
+// @Description Update rule method, the rule must refer to known roles, resources, verbs and effects.
 // @Tags rules
 // @Accept  json
 // @Produce json
@@ -179,7 +185,7 @@ func (d *Delivery) updateRule(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucRule.RuleUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, ruleErrorStatus(err), err)
 
 		return
 	}
@@ -232,3 +238,231 @@ func (d *Delivery) deleteRule(ginCtx *gin.Context) {
 
 	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
 }
+
+// exportPolicy
+// @Summary Export policy method.
+// @Description Export all rules in the casbin policy file format or as JSON.
+// @Tags rules
+// @Accept  json
+// @Produce json,text/csv
+// @Security Bearer
+// @Param   format	query 		string 		   		false  "csv (default) or json"
+// @Success 200		{array}  	rule.PolicyRule		true  "Policy"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/rules/export [get].
+func (d *Delivery) exportPolicy(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.exportPolicy")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	format, err := getPolicyFormat(ginCtx)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	rules, err := d.ucRule.RuleExport(ctx)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	if format == policyFormatJSON {
+		ginCtx.JSON(http.StatusOK, rule.ListPolicyRule(rules))
+
+		return
+	}
+
+	ginCtx.Header("Content-Disposition", `attachment; filename="policy.csv"`)
+	ginCtx.Header("Content-Type", "text/csv; charset=utf-8")
+	ginCtx.Status(http.StatusOK)
+
+	if err = writePolicyCSV(ginCtx.Writer, rules); err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+	}
+}
+
+// importPolicy
+// @Summary Import policy method.
+// @Description Replace all rules with the imported ones in one transaction and return the difference, nothing changes on dry run.
+// @Tags rules
+// @Accept  json,text/csv
+// @Produce json
+// @Security Bearer
+// @Param   format	query 		string 		   		false  "csv (default) or json"
+// @Param   dry_run	query 		bool 		   		false  "Only return the difference"
+// @Param   input 	body 		[]rule.PolicyRule 	true  "Policy"
+// @Success 200		{object}  	rule.PolicyDiff		true  "Policy Difference"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/rules/import [post].
+func (d *Delivery) importPolicy(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.importPolicy")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	format, err := getPolicyFormat(ginCtx)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	dryRun, err := strconv.ParseBool(ginCtx.DefaultQuery("dry_run", "false"))
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	var rules rule.ListPolicyRule
+
+	if format == policyFormatJSON {
+		err = ginCtx.BindJSON(&rules)
+	} else {
+		rules, err = parsePolicyCSV(ginCtx.Request.Body)
+	}
+
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	diff, err := d.ucRule.RuleImport(ctx, meta, rules, dryRun)
+	if err != nil {
+		NewErrorResponse(ginCtx, ruleErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, diff)
+}
+
+// evaluatePolicy
+// @Summary Evaluate policy method.
+// @Description Check if the role may take the action on the object against the current policy or the policy with proposed changes and explain which rules matched and which effect won.
+// @Tags rules
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   input 	body 		rule.EvaluationInput 	true  "Request and proposed changes"
+// @Success 200		{object}  	rule.Evaluation			true  "Decision"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/rules/evaluate [post].
+func (d *Delivery) evaluatePolicy(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.evaluatePolicy")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input rule.EvaluationInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	evaluation, err := d.ucRule.RuleEvaluate(ctx, input)
+	if err != nil {
+		NewErrorResponse(ginCtx, ruleErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, evaluation)
+}
+
+// getPolicyFormat returns format of the policy from the query, csv by default.
+func getPolicyFormat(ginCtx *gin.Context) (string, error) {
+	format := ginCtx.DefaultQuery("format", policyFormatCSV)
+	if format != policyFormatCSV && format != policyFormatJSON {
+		return "", ErrUnknownPolicyFormat
+	}
+
+	return format, nil
+}
+
+// parsePolicyCSV reads rules in the casbin policy file format, lines starting with # are comments.
+func parsePolicyCSV(reader io.Reader) (rule.ListPolicyRule, error) {
+	csvReader := csv.NewReader(reader)
+	csvReader.Comment = '#'
+	csvReader.FieldsPerRecord = -1
+	csvReader.TrimLeadingSpace = true
+
+	rules := rule.ListPolicyRule{}
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return rules, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(record) > policyColumns {
+			line, _ := csvReader.FieldPos(0)
+
+			return nil, fmt.Errorf("%w: line %d", ErrInvalidPolicyLine, line)
+		}
+
+		fields := make([]string, policyColumns)
+		for i, value := range record {
+			fields[i] = strings.TrimSpace(value)
+		}
+
+		rules = append(rules, rule.PolicyRule{
+			Ptype: fields[0],
+			V0:    fields[1],
+			V1:    fields[2],
+			V2:    fields[3],
+			V3:    fields[4],
+			V4:    fields[5],
+			V5:    fields[6],
+		})
+	}
+}
+
+// writePolicyCSV writes rules in the casbin policy file format.
+func writePolicyCSV(writer io.Writer, rules []rule.PolicyRule) error {
+	csvWriter := csv.NewWriter(writer)
+
+	for _, rle := range rules {
+		if err := csvWriter.Write(rle.Fields()); err != nil {
+			return err
+		}
+	}
+
+	csvWriter.Flush()
+
+	return csvWriter.Error()
+}
@@ -0,0 +1,182 @@
+package http
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	_ "github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getMySessions
+// @Summary Get current user sessions method.
+// @Description Get active refresh sessions of the current user method.
+// @Tags sessions
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Success 200		{array}  	token.Session	true  "Session List"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/me/sessions/ [get].
+func (d *Delivery) getMySessions(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getMySessions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := d.getUserID(ginCtx)
+	if err != nil {
+		return
+	}
+
+	results, err := d.ucAuthentication.AuthenticationGetSessions(ctx, userID)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, results)
+}
+
+// deleteMySession
+// @Summary Revoke current user session method.
+// @Description Revoke one refresh session of the current user method.
+// @Tags sessions
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   	true  "Session ID"
+// @Success 200		{object}  	StatusResponse	true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/me/sessions/{id} [delete].
+func (d *Delivery) deleteMySession(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.deleteMySession")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := d.getUserID(ginCtx)
+	if err != nil {
+		return
+	}
+
+	sessionID := ginCtx.Param("id")
+	if sessionID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	if err = d.ucAuthentication.AuthenticationRevokeSession(ctx, userID, sessionID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			NewErrorResponse(ginCtx, http.StatusNotFound, err)
+
+			return
+		}
+
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// getUserSessions
+// @Summary Get user sessions method.
+// @Description Get active refresh sessions of any user method.
+// @Tags sessions
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   	true  "User ID"
+// @Success 200		{array}  	token.Session	true  "Session List"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/users/{id}/sessions/ [get].
+func (d *Delivery) getUserSessions(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getUserSessions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID := ginCtx.Param("id")
+	if userID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	results, err := d.ucAuthentication.AuthenticationGetSessions(ctx, userID)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, results)
+}
+
+// deleteUserSessions
+// @Summary Revoke user sessions method.
+// @Description Revoke all refresh sessions of any user method.
+// @Tags sessions
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   	true  "User ID"
+// @Success 200		{object}  	StatusResponse	true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/users/{id}/sessions/ [delete].
+func (d *Delivery) deleteUserSessions(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.deleteUserSessions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID := ginCtx.Param("id")
+	if userID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	if err := d.ucAuthentication.AuthenticationRevokeSessions(ctx, userID); err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
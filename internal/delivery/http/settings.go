@@ -0,0 +1,123 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getSettings
+// @Summary Get organization settings method.
+// @Description Get currency, timezone, locales, working hours, tax and order numbering settings of the organization.
+// @Tags settings
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   org_id	query 		string 		   		false "Organization ID"
+// @Success 200		{object}  	setting.Settings	true  "Settings"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/settings [get].
+func (d *Delivery) getSettings(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getSettings")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if meta.OrganizationID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyOrganizationID)
+
+		return
+	}
+
+	result, err := d.ucSetting.SettingGetOne(ctx, meta.OrganizationID)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, result)
+}
+
+// updateSettings
+// @Summary Update organization settings method.
+// @Description Changes settings of the organization, omitted fields are not changed.
+// @Tags settings
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   org_id	query 		string 		   				false "Organization ID"
+// @Param   input 	body 		setting.UpdateSettingsInput true  "Settings"
+// @Success 200		{object}  	setting.Settings			true  "Settings"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/settings [patch].
+func (d *Delivery) updateSettings(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.updateSettings")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	if meta.OrganizationID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyOrganizationID)
+
+		return
+	}
+
+	var input setting.UpdateSettingsInput
+	if err = ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	result, err := d.ucSetting.SettingUpdate(ctx, meta, meta.OrganizationID, input)
+	if err != nil {
+		NewErrorResponse(ginCtx, settingErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, result)
+}
+
+// settingErrorStatus returns http status for organization settings error.
+func settingErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, setting.ErrInvalidSettings),
+		errors.Is(err, setting.ErrStructHasNoValues):
+		return http.StatusBadRequest
+	case errors.Is(err, usecase.ErrForeignOrganization):
+		return http.StatusForbidden
+	default:
+		return http.StatusInternalServerError
+	}
+}
@@ -136,7 +136,7 @@ func (d *Delivery) createSpecification(ginCtx *gin.Context) {
 
 	specificationID, err := d.ucSpecification.SpecificationCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -181,7 +181,7 @@ func (d *Delivery) updateSpecification(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucSpecification.SpecificationUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -0,0 +1,192 @@
+package http
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/storefront"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// getStorefront
+// @Summary Get storefront organization method.
+// @Description Get public information and settings of the organization by slug, no token is required.
+// @Tags storefront
+// @Accept  json
+// @Produce json
+// @Param   slug	path 		string 		   				true  "Organization slug"
+// @Success 200		{object}  	storefront.Organization		true  "Organization"
+// @Success 304
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /public/v1/organizations/{slug} [get].
+func (d *Delivery) getStorefront(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getStorefront")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	result, err := d.ucStorefront.StorefrontGetOrganization(ctx, ginCtx.Param("slug"))
+	if err != nil {
+		NewErrorResponse(ginCtx, storefrontErrorStatus(err), err)
+
+		return
+	}
+
+	publicResponse(ginCtx, result)
+}
+
+// getStorefrontCategories
+// @Summary Get storefront categories method.
+// @Description Get category tree of the organization by slug, no token is required.
+// @Tags storefront
+// @Accept  json
+// @Produce json
+// @Param   slug	path 		string 		   			true  "Organization slug"
+// @Success 200		{array}  	storefront.Category		true  "Category Tree"
+// @Success 304
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /public/v1/organizations/{slug}/categories [get].
+func (d *Delivery) getStorefrontCategories(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getStorefrontCategories")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	results, err := d.ucStorefront.StorefrontGetCategories(ctx, ginCtx.Param("slug"))
+	if err != nil {
+		NewErrorResponse(ginCtx, storefrontErrorStatus(err), err)
+
+		return
+	}
+
+	publicResponse(ginCtx, results)
+}
+
+// getStorefrontItems
+// @Summary Get storefront items method.
+// @Description Get items of the organization by slug with their main images, no token is required.
+// @Tags storefront
+// @Accept  json
+// @Produce json
+// @Param   slug		path 		string 		   	true  "Organization slug"
+// @Param   category_id	query 		string 		   	false "Category ID"
+// @Param   limit		query 		int 		   	false "Limit, 100 at most"
+// @Param   offset		query 		int 		   	false "Offset"
+// @Success 200			{array}  	item.Item		true  "Item List"
+// @Success 304
+// @Failure 400 		{object}    ErrorResponse
+// @Failure 404	 		{object}	ErrorResponse
+// @Failure 500 		{object} 	ErrorResponse
+// @Router /public/v1/organizations/{slug}/items [get].
+func (d *Delivery) getStorefrontItems(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getStorefrontItems")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var filter storefront.ItemFilter
+	if err := ginCtx.ShouldBindQuery(&filter); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if filter.Limit == 0 || filter.Limit > maxStorefrontLimit {
+		filter.Limit = maxStorefrontLimit
+	}
+
+	results, err := d.ucStorefront.StorefrontGetItems(ctx, ginCtx.Param("slug"), filter)
+	if err != nil {
+		NewErrorResponse(ginCtx, storefrontErrorStatus(err), err)
+
+		return
+	}
+
+	publicResponse(ginCtx, results)
+}
+
+// getStorefrontItem
+// @Summary Get storefront item method.
+// @Description Get item of the organization by slug with its images, specifications and approved comments, no token is required.
+// @Tags storefront
+// @Accept  json
+// @Produce json
+// @Param   slug	path 		string 		   	true  "Organization slug"
+// @Param   id		path 		string 		   	true  "Item ID"
+// @Success 200		{object}  	item.Item		true  "Item"
+// @Success 304
+// @Failure 404	 	{object}	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /public/v1/organizations/{slug}/items/{id} [get].
+func (d *Delivery) getStorefrontItem(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.getStorefrontItem")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	result, err := d.ucStorefront.StorefrontGetItem(ctx, ginCtx.Param("slug"), ginCtx.Param("id"))
+	if err != nil {
+		NewErrorResponse(ginCtx, storefrontErrorStatus(err), err)
+
+		return
+	}
+
+	publicResponse(ginCtx, result)
+}
+
+// publicResponse writes the body with caching headers, 304 is returned if the client has the same body cached.
+func publicResponse(ginCtx *gin.Context, body interface{}) {
+	data, err := json.Marshal(body)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	sum := sha256.Sum256(data)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	ginCtx.Header("Cache-Control", storefrontCacheControl)
+	ginCtx.Header("ETag", etag)
+
+	if ginCtx.GetHeader("If-None-Match") == etag {
+		ginCtx.Status(http.StatusNotModified)
+
+		return
+	}
+
+	ginCtx.Data(http.StatusOK, gin.MIMEJSON+"; charset=utf-8", data)
+}
+
+// storefrontErrorStatus returns http status for storefront error.
+func storefrontErrorStatus(err error) int {
+	if errors.Is(err, sql.ErrNoRows) {
+		return http.StatusNotFound
+	}
+
+	return http.StatusInternalServerError
+}
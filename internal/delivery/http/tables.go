@@ -136,7 +136,7 @@ func (d *Delivery) createTable(ginCtx *gin.Context) {
 
 	tableID, err := d.ucTable.TableCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -181,7 +181,7 @@ func (d *Delivery) updateTable(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucTable.TableUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
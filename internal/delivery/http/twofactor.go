@@ -0,0 +1,303 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// enrollTwoFactorByChallenge
+// @Summary Enrol two-factor authentication while signing in method.
+// @Description Generates TOTP secret for the user whose role requires two-factor authentication.
+// @Tags authentication
+// @Accept  json
+// @Produce json
+// @Param   input 	body 		user.TwoFactorChallengeInput 	true  "Challenge token"
+// @Success 200		{object}  	user.TwoFactorEnrollment		true  "TOTP secret and provisioning URI"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401 	{object}    ErrorResponse
+// @Failure 409 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/2fa/enroll [post].
+func (d *Delivery) enrollTwoFactorByChallenge(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.enrollTwoFactorByChallenge")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input user.TwoFactorChallengeInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	enrollment, err := d.ucAuthentication.AuthenticationEnrollTwoFactorByChallenge(ctx, input.ChallengeToken)
+	if err != nil {
+		NewErrorResponse(ginCtx, twoFactorErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, enrollment)
+}
+
+// verifyTwoFactor
+// @Summary Two-factor sign in step method.
+// @Description Checks TOTP or backup code for the challenge and issues tokens.
+// @Tags authentication
+// @Accept  json
+// @Produce json
+// @Param   input 	body 		user.TwoFactorChallengeInput 	true  "Challenge token, code, client type and device name"
+// @Success 200		{object}  	AuthResponse					true  "User data, Tokens and backup codes after enrolment"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401 	{object}    ErrorResponse
+// @Failure 409 	{object}    ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /auth/2fa/verify [post].
+func (d *Delivery) verifyTwoFactor(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.verifyTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var input user.TwoFactorChallengeInput
+	if err := ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	usr, tokens, backupCodes, err := d.ucAuthentication.AuthenticationVerifyTwoFactor(ctx, input.ChallengeToken, input.Code)
+	if err != nil {
+		NewErrorResponse(ginCtx, twoFactorErrorStatus(err), err)
+
+		return
+	}
+
+	session := token.SessionInput{
+		ClientType: input.ClientType,
+		DeviceName: input.DeviceName,
+		IP:         ginCtx.ClientIP(),
+		ExpiresAt:  time.Unix(tokens.RefreshTokenExpires, 0),
+	}
+
+	err = d.ucAuthentication.AuthenticationCreateTokenHash(ctx, usr.ID, tokens.RefreshTokenHash, session)
+	if err != nil {
+		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	tokens.RefreshTokenHash = ""
+
+	ginCtx.JSON(http.StatusOK, AuthResponse{User: usr, Tokens: tokens, BackupCodes: backupCodes})
+}
+
+// enrollTwoFactor
+// @Summary Enrol two-factor authentication method.
+// @Description Generates new TOTP secret of the current user, it has to be confirmed with the first code.
+// @Tags twofactor
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Success 200		{object}  	user.TwoFactorEnrollment	true  "TOTP secret and provisioning URI"
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 409 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/me/2fa [post].
+func (d *Delivery) enrollTwoFactor(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.enrollTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := d.getUserID(ginCtx)
+	if err != nil {
+		return
+	}
+
+	enrollment, err := d.ucAuthentication.AuthenticationEnrollTwoFactor(ctx, userID)
+	if err != nil {
+		NewErrorResponse(ginCtx, twoFactorErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, enrollment)
+}
+
+// confirmTwoFactor
+// @Summary Confirm two-factor authentication method.
+// @Description Checks the first TOTP code, enables two-factor authentication and returns backup codes.
+// @Tags twofactor
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   input 	body 		user.TwoFactorCodeInput 	true  "TOTP code"
+// @Success 200		{object}  	user.BackupCodes			true  "Backup codes"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 409 	{object}    ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/me/2fa/confirm [post].
+func (d *Delivery) confirmTwoFactor(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.confirmTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := d.getUserID(ginCtx)
+	if err != nil {
+		return
+	}
+
+	var input user.TwoFactorCodeInput
+	if err = ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	codes, err := d.ucAuthentication.AuthenticationConfirmTwoFactor(ctx, userID, input.Code)
+	if err != nil {
+		NewErrorResponse(ginCtx, twoFactorErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, user.BackupCodes{Codes: codes})
+}
+
+// regenerateBackupCodes
+// @Summary Regenerate backup codes method.
+// @Description Checks TOTP code and replaces backup codes of the current user.
+// @Tags twofactor
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   input 	body 		user.TwoFactorCodeInput 	true  "TOTP code"
+// @Success 200		{object}  	user.BackupCodes			true  "Backup codes"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 409 	{object}    ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/me/2fa/backup-codes [post].
+func (d *Delivery) regenerateBackupCodes(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.regenerateBackupCodes")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := d.getUserID(ginCtx)
+	if err != nil {
+		return
+	}
+
+	var input user.TwoFactorCodeInput
+	if err = ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	codes, err := d.ucAuthentication.AuthenticationRegenerateBackupCodes(ctx, userID, input.Code)
+	if err != nil {
+		NewErrorResponse(ginCtx, twoFactorErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, user.BackupCodes{Codes: codes})
+}
+
+// disableTwoFactor
+// @Summary Disable two-factor authentication method.
+// @Description Checks TOTP or backup code and disables two-factor authentication of the current user.
+// @Tags twofactor
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   input 	body 		user.TwoFactorCodeInput 	true  "TOTP or backup code"
+// @Success 200		{object}  	StatusResponse				true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 409 	{object}    ErrorResponse
+// @Failure 429 	{object}    ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/me/2fa [delete].
+func (d *Delivery) disableTwoFactor(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.disableTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := d.getUserID(ginCtx)
+	if err != nil {
+		return
+	}
+
+	var input user.TwoFactorCodeInput
+	if err = ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if err = d.ucAuthentication.AuthenticationDisableTwoFactor(ctx, userID, input.Code); err != nil {
+		NewErrorResponse(ginCtx, twoFactorErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// twoFactorErrorStatus returns http status for two-factor authentication error.
+func twoFactorErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidChallenge):
+		return http.StatusUnauthorized
+	case errors.Is(err, usecase.ErrTwoFactorRequired), isAccountLocked(err):
+		return http.StatusForbidden
+	case errors.Is(err, usecase.ErrTwoFactorNotEnrolled), errors.Is(err, usecase.ErrTwoFactorAlreadyEnabled):
+		return http.StatusConflict
+	default:
+		return otpErrorStatus(err)
+	}
+}
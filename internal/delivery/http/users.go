@@ -21,6 +21,7 @@ import (
 // @Success 200		{array}  	user.User		true  "User List"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/users/ [get].
@@ -43,7 +44,7 @@ func (d *Delivery) getAllUsers(ginCtx *gin.Context) {
 
 	results, err := d.ucUser.UserGetAll(ctx, meta, params)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -62,6 +63,7 @@ func (d *Delivery) getAllUsers(ginCtx *gin.Context) {
 // @Success 200		{object}  	user.User		true  "User data"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/users/{id} [get].
@@ -89,7 +91,7 @@ func (d *Delivery) getUser(ginCtx *gin.Context) {
 
 	list, err := d.ucUser.UserGetOne(ctx, meta, userID)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -107,6 +109,7 @@ func (d *Delivery) getUser(ginCtx *gin.Context) {
 // @Success 200		{array}  	role.Role		true  "Role List"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/users/roles/ [get].
@@ -129,7 +132,7 @@ func (d *Delivery) getAllRoles(ginCtx *gin.Context) {
 
 	results, err := d.ucUser.UserGetAllRoles(ctx, meta, params)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -148,6 +151,7 @@ func (d *Delivery) getAllRoles(ginCtx *gin.Context) {
 // @Success 200		{string}  	string					true  "User ID"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/users/ [post].
@@ -175,7 +179,7 @@ func (d *Delivery) createUser(ginCtx *gin.Context) {
 
 	insertID, err := d.ucUser.UserCreate(ctx, meta, input)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -195,6 +199,7 @@ func (d *Delivery) createUser(ginCtx *gin.Context) {
 // @Success 200		{object}  	StatusResponse			true  "OK"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/users/ [patch].
@@ -221,7 +226,7 @@ func (d *Delivery) updateUser(ginCtx *gin.Context) {
 	}
 
 	if err = d.ucUser.UserUpdate(ctx, meta, input); err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -240,6 +245,7 @@ func (d *Delivery) updateUser(ginCtx *gin.Context) {
 // @Success 200		{object}  	StatusResponse	true  "OK"
 // @Failure 400 	{object}    ErrorResponse
 // @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
 // @Failure 404 	{object} 	ErrorResponse
 // @Failure 500 	{object} 	ErrorResponse
 // @Router /api/v1/users/{id} [delete].
@@ -267,7 +273,49 @@ func (d *Delivery) deleteUser(ginCtx *gin.Context) {
 
 	err = d.ucUser.UserDelete(ctx, meta, userID)
 	if err != nil {
-		NewErrorResponse(ginCtx, http.StatusInternalServerError, err)
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// unlockUser
+// @Summary Unlock user sign in method.
+// @Description Removes sign in lock after failed attempts of the user and, if set, of the ip address.
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   	true  "User ID"
+// @Param   ip	 	query 		string 		   	false "IP address"
+// @Success 200		{object}  	StatusResponse	true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 403	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/users/{id}/lock [delete].
+func (d *Delivery) unlockUser(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.unlockUser")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID := ginCtx.Param("id")
+	if userID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	if err := d.ucAuthentication.AuthenticationUnlock(ctx, userID, ginCtx.Query("ip")); err != nil {
+		NewErrorResponse(ginCtx, accessErrorStatus(err), err)
 
 		return
 	}
@@ -0,0 +1,153 @@
+package http
+
+import (
+	"database/sql"
+	"errors"
+	"net/http"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/gin-gonic/gin"
+)
+
+// changeStatusFunc is a usecase method changing status of the user.
+type changeStatusFunc func(ctx context.Context, meta query.MetaData, userID string, input user.ChangeStatusInput) error
+
+// blockUser
+// @Summary Block user method.
+// @Description Blocks user until reactivation and revokes all sessions of the user.
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   			true  "User ID"
+// @Param   input 	body 		user.ChangeStatusInput 	true  "Reason"
+// @Success 200		{object}  	StatusResponse			true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 409 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/users/{id}/block [post].
+func (d *Delivery) blockUser(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.blockUser")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	d.changeUserStatus(ctx, ginCtx, d.ucUser.UserBlock)
+}
+
+// suspendUser
+// @Summary Suspend user method.
+// @Description Blocks user until the given date and revokes all sessions of the user.
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   			true  "User ID"
+// @Param   input 	body 		user.ChangeStatusInput 	true  "Reason and suspension end"
+// @Success 200		{object}  	StatusResponse			true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 409 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/users/{id}/suspend [post].
+func (d *Delivery) suspendUser(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.suspendUser")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	d.changeUserStatus(ctx, ginCtx, d.ucUser.UserSuspend)
+}
+
+// reactivateUser
+// @Summary Reactivate user method.
+// @Description Sets active status to the blocked or suspended user.
+// @Tags users
+// @Accept  json
+// @Produce json
+// @Security Bearer
+// @Param   id	 	path 		string 		   			true  "User ID"
+// @Param   input 	body 		user.ChangeStatusInput 	true  "Reason"
+// @Success 200		{object}  	StatusResponse			true  "OK"
+// @Failure 400 	{object}    ErrorResponse
+// @Failure 401	 	{object}	ErrorResponse
+// @Failure 404 	{object} 	ErrorResponse
+// @Failure 409 	{object} 	ErrorResponse
+// @Failure 500 	{object} 	ErrorResponse
+// @Router /api/v1/users/{id}/reactivate [post].
+func (d *Delivery) reactivateUser(ginCtx *gin.Context) {
+	ctx := context.New(ginCtx)
+
+	if d.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ginCtx.Request.Context(), "Delivery.reactivateUser")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	d.changeUserStatus(ctx, ginCtx, d.ucUser.UserReactivate)
+}
+
+// changeUserStatus binds status change input and applies it to the user from the path.
+func (d *Delivery) changeUserStatus(ctx context.Context, ginCtx *gin.Context, change changeStatusFunc) {
+	meta, err := d.parseMetadata(ginCtx)
+	if err != nil {
+		return
+	}
+
+	userID := ginCtx.Param("id")
+	if userID == "" {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, ErrEmptyIDParam)
+
+		return
+	}
+
+	var input user.ChangeStatusInput
+	if err = ginCtx.BindJSON(&input); err != nil {
+		NewErrorResponse(ginCtx, http.StatusBadRequest, err)
+
+		return
+	}
+
+	if err = change(ctx, meta, userID, input); err != nil {
+		NewErrorResponse(ginCtx, userStatusErrorStatus(err), err)
+
+		return
+	}
+
+	ginCtx.JSON(http.StatusOK, StatusResponse{Status: "ok"})
+}
+
+// userStatusErrorStatus returns http status for user status change error.
+func userStatusErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, usecase.ErrInvalidSuspensionEnd):
+		return http.StatusBadRequest
+	case errors.Is(err, sql.ErrNoRows):
+		return http.StatusNotFound
+	case errors.Is(err, usecase.ErrOwnStatusChange):
+		return http.StatusConflict
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// isAccountLocked checks if the error is caused by blocked or suspended user.
+func isAccountLocked(err error) bool {
+	return errors.Is(err, usecase.ErrUserBlocked) || errors.Is(err, usecase.ErrUserSuspended)
+}
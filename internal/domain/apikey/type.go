@@ -0,0 +1,86 @@
+package apikey
+
+import (
+	"strings"
+	"time"
+
+	"github.com/lib/pq"
+)
+
+const (
+	// ScopeSeparator separates casbin object and action in the scope.
+	ScopeSeparator = ":"
+	// ScopeAnyAction allows any action on the object.
+	ScopeAnyAction = "*"
+)
+
+// ListAPIKey
+//
+//easyjson:json
+type ListAPIKey []APIKey
+
+// APIKey entity.
+//
+//easyjson:json
+type APIKey struct {
+	// API key ID
+	ID string `json:"id" db:"id"`
+	// Organization ID
+	OrganizationID string `json:"organizationId" db:"organization_id"`
+	// ID of the user who created the key, requests are made on his behalf
+	UserID string `json:"userId" db:"user_id"`
+	// API key name
+	Name string `json:"name" db:"name"`
+	// Visible part of the key
+	Prefix string `json:"prefix" db:"prefix"`
+	// Scopes in object:action format
+	Scopes pq.StringArray `json:"scopes" db:"scopes"`
+	// Creation datetime
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	// Last usage datetime
+	LastUsedAt *time.Time `json:"lastUsedAt" db:"last_used_at"`
+}
+
+// CreateAPIKeyInput entity.
+//
+//easyjson:json
+type CreateAPIKeyInput struct {
+	// Organization ID
+	OrganizationID string `json:"organizationId" binding:"required"`
+	// API key name
+	Name string `json:"name" binding:"required"`
+	// Scopes in object:action format
+	Scopes []string `json:"scopes" binding:"required,min=1,dive,required"`
+}
+
+// CreatedAPIKey is a new API key, the key itself is shown only once.
+//
+//easyjson:json
+type CreatedAPIKey struct {
+	// API key
+	APIKey APIKey `json:"apiKey"`
+	// Secret key for X-API-Key header
+	Key string `json:"key"`
+}
+
+// ParseScope splits scope into casbin object and action.
+func ParseScope(scope string) (string, string, bool) {
+	obj, act, found := strings.Cut(scope, ScopeSeparator)
+	if !found || obj == "" || act == "" {
+		return "", "", false
+	}
+
+	return obj, act, true
+}
+
+// Allows checks if the key has scope for the object and action.
+func (k APIKey) Allows(obj string, act string) bool {
+	for _, scope := range k.Scopes {
+		scopeObj, scopeAct, ok := ParseScope(scope)
+		if ok && scopeObj == obj && (scopeAct == act || scopeAct == ScopeAnyAction) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,57 @@
+package apikey
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseScope(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		scope string
+		obj   string
+		act   string
+		ok    bool
+	}{
+		{scope: "item:read", obj: "item", act: "read", ok: true},
+		{scope: "order:*", obj: "order", act: "*", ok: true},
+		{scope: "item", ok: false},
+		{scope: ":read", ok: false},
+		{scope: "item:", ok: false},
+		{scope: "", ok: false},
+	}
+
+	for _, test := range tests {
+		obj, act, ok := ParseScope(test.scope)
+		assert.Equal(t, test.ok, ok, test.scope)
+		assert.Equal(t, test.obj, obj, test.scope)
+		assert.Equal(t, test.act, act, test.scope)
+	}
+}
+
+func TestAPIKeyAllows(t *testing.T) {
+	t.Parallel()
+
+	key := APIKey{Scopes: []string{"item:read", "order:*", "broken"}}
+
+	tests := []struct {
+		name     string
+		obj      string
+		act      string
+		expected bool
+	}{
+		{name: "exact scope", obj: "item", act: "read", expected: true},
+		{name: "other action", obj: "item", act: "write", expected: false},
+		{name: "any action", obj: "order", act: "delete", expected: true},
+		{name: "other object", obj: "category", act: "read", expected: false},
+		{name: "invalid scope", obj: "broken", act: "read", expected: false},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, key.Allows(test.obj, test.act), test.name)
+	}
+
+	assert.False(t, APIKey{}.Allows("item", "read"), "no scopes")
+}
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrInvalidChanges = errors.New("changes are not a json array")
+
+// Actions of audit entries.
+const (
+	ActionCreate = "create"
+	ActionUpdate = "update"
+	ActionDelete = "delete"
+)
+
+//easyjson:json
+type ListEntry []Entry
+
+// Entry is a record of a change made in the system.
+//
+//easyjson:json
+type Entry struct {
+	// Entry ID
+	ID string `json:"id" db:"id"`
+	// ID of the user who made the change
+	ActorID string `json:"actorId" db:"actor_id"`
+	// Role name of the user
+	Role string `json:"role" db:"role"`
+	// Organization ID of the request
+	OrganizationID string `json:"organizationId" db:"organization_id"`
+	// Entity name
+	Entity string `json:"entity" db:"entity"`
+	// Entity ID
+	EntityID string `json:"entityId" db:"entity_id"`
+	// create, update or delete
+	Action string `json:"action" db:"action"`
+	// Changed fields
+	Changes Changes `json:"changes" db:"changes"`
+	// Request ID
+	RequestID string `json:"requestId" db:"request_id"`
+	// Client IP address
+	IP string `json:"ip" db:"ip"`
+	// Datetime of the change
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+}
+
+//easyjson:json
+type Changes []Change
+
+// Change is a change of the entity field.
+//
+//easyjson:json
+type Change struct {
+	// Field name
+	Field string `json:"field"`
+	// Value before the change, absent for created entities
+	Before json.RawMessage `json:"before,omitempty"`
+	// Value after the change, absent for deleted entities
+	After json.RawMessage `json:"after,omitempty"`
+}
+
+// Value stores changes as json.
+func (c Changes) Value() (driver.Value, error) {
+	if c == nil {
+		return []byte("[]"), nil
+	}
+
+	return c.MarshalJSON()
+}
+
+// Scan reads changes from json.
+func (c *Changes) Scan(src interface{}) error {
+	var data []byte
+
+	switch value := src.(type) {
+	case []byte:
+		data = value
+	case string:
+		data = []byte(value)
+	case nil:
+		*c = nil
+
+		return nil
+	default:
+		return ErrInvalidChanges
+	}
+
+	return c.UnmarshalJSON(data)
+}
+
+// Filter is a filter of audit entries, empty fields match any value.
+//
+//easyjson:json
+type Filter struct {
+	// ID of the user who made the change
+	ActorID string `json:"actorId" form:"actor_id"`
+	// Organization ID of the request
+	OrganizationID string `json:"organizationId" form:"organization_id"`
+	// Entity name
+	Entity string `json:"entity" form:"entity"`
+	// Entity ID
+	EntityID string `json:"entityId" form:"entity_id"`
+	// create, update or delete
+	Action string `json:"action" form:"action" binding:"omitempty,oneof=create update delete"`
+	// Request ID
+	RequestID string `json:"requestId" form:"request_id"`
+}
@@ -0,0 +1,474 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package audit
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit(in *jlexer.Lexer, out *ListEntry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ListEntry, 0, 0)
+			} else {
+				*out = ListEntry{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 Entry
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit(out *jwriter.Writer, in ListEntry) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListEntry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListEntry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListEntry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListEntry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit1(in *jlexer.Lexer, out *Filter) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "actorId":
+			out.ActorID = string(in.String())
+		case "organizationId":
+			out.OrganizationID = string(in.String())
+		case "entity":
+			out.Entity = string(in.String())
+		case "entityId":
+			out.EntityID = string(in.String())
+		case "action":
+			out.Action = string(in.String())
+		case "requestId":
+			out.RequestID = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit1(out *jwriter.Writer, in Filter) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"actorId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ActorID))
+	}
+	{
+		const prefix string = ",\"organizationId\":"
+		out.RawString(prefix)
+		out.String(string(in.OrganizationID))
+	}
+	{
+		const prefix string = ",\"entity\":"
+		out.RawString(prefix)
+		out.String(string(in.Entity))
+	}
+	{
+		const prefix string = ",\"entityId\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"requestId\":"
+		out.RawString(prefix)
+		out.String(string(in.RequestID))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Filter) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Filter) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Filter) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Filter) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit1(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit2(in *jlexer.Lexer, out *Entry) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "actorId":
+			out.ActorID = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		case "organizationId":
+			out.OrganizationID = string(in.String())
+		case "entity":
+			out.Entity = string(in.String())
+		case "entityId":
+			out.EntityID = string(in.String())
+		case "action":
+			out.Action = string(in.String())
+		case "changes":
+			(out.Changes).UnmarshalEasyJSON(in)
+		case "requestId":
+			out.RequestID = string(in.String())
+		case "ip":
+			out.IP = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit2(out *jwriter.Writer, in Entry) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"actorId\":"
+		out.RawString(prefix)
+		out.String(string(in.ActorID))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	{
+		const prefix string = ",\"organizationId\":"
+		out.RawString(prefix)
+		out.String(string(in.OrganizationID))
+	}
+	{
+		const prefix string = ",\"entity\":"
+		out.RawString(prefix)
+		out.String(string(in.Entity))
+	}
+	{
+		const prefix string = ",\"entityId\":"
+		out.RawString(prefix)
+		out.String(string(in.EntityID))
+	}
+	{
+		const prefix string = ",\"action\":"
+		out.RawString(prefix)
+		out.String(string(in.Action))
+	}
+	{
+		const prefix string = ",\"changes\":"
+		out.RawString(prefix)
+		(in.Changes).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"requestId\":"
+		out.RawString(prefix)
+		out.String(string(in.RequestID))
+	}
+	{
+		const prefix string = ",\"ip\":"
+		out.RawString(prefix)
+		out.String(string(in.IP))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Entry) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Entry) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Entry) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Entry) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit2(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit3(in *jlexer.Lexer, out *Changes) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Changes, 0, 1)
+			} else {
+				*out = Changes{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v4 Change
+			(v4).UnmarshalEasyJSON(in)
+			*out = append(*out, v4)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit3(out *jwriter.Writer, in Changes) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v5, v6 := range in {
+			if v5 > 0 {
+				out.RawByte(',')
+			}
+			(v6).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Changes) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Changes) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Changes) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Changes) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit3(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit4(in *jlexer.Lexer, out *Change) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "field":
+			out.Field = string(in.String())
+		case "before":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.Before).UnmarshalJSON(data))
+			}
+		case "after":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.After).UnmarshalJSON(data))
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit4(out *jwriter.Writer, in Change) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"field\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Field))
+	}
+	if len(in.Before) != 0 {
+		const prefix string = ",\"before\":"
+		out.RawString(prefix)
+		out.Raw((in.Before).MarshalJSON())
+	}
+	if len(in.After) != 0 {
+		const prefix string = ",\"after\":"
+		out.RawString(prefix)
+		out.Raw((in.After).MarshalJSON())
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Change) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Change) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Change) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Change) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainAudit4(l, v)
+}
@@ -6,6 +6,9 @@ import (
 
 var ErrStructHasNoValues = errors.New("update structure has no values")
 
+// StatusApproved is a status name of comments shown to customers.
+const StatusApproved = "approved"
+
 // ListComment
 //
 //easyjson:json
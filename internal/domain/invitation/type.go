@@ -0,0 +1,72 @@
+package invitation
+
+import "time"
+
+const (
+	// StatusPending is a status of invitation waiting for acceptance.
+	StatusPending = "pending"
+	// StatusAccepted is a status of accepted invitation.
+	StatusAccepted = "accepted"
+	// StatusRevoked is a status of invitation revoked by the organization.
+	StatusRevoked = "revoked"
+	// StatusExpired is a status of invitation not accepted in time.
+	StatusExpired = "expired"
+)
+
+// ListInvitation
+//
+//easyjson:json
+type ListInvitation []Invitation
+
+// Invitation entity.
+//
+//easyjson:json
+type Invitation struct {
+	// Invitation ID
+	ID string `json:"id" db:"id"`
+	// Organization ID
+	OrganizationID string `json:"organizationId" db:"organization_id"`
+	// Invited phone number
+	Phone string `json:"phone" db:"phone"`
+	// Role name given to the user on acceptance
+	RoleName string `json:"role" db:"role"`
+	// Invitation status: pending, accepted, revoked or expired
+	Status string `json:"status" db:"status"`
+	// ID of the user who created the invitation
+	UserCreated string `json:"userCreated" db:"user_created"`
+	// Creation datetime
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	// Expiration datetime
+	ExpiresAt time.Time `json:"expiresAt" db:"expires_at"`
+	// ID of the user who accepted the invitation
+	AcceptedBy *string `json:"acceptedBy" db:"accepted_by"`
+	// Acceptance datetime
+	AcceptedAt *time.Time `json:"acceptedAt" db:"accepted_at"`
+}
+
+// CreateInvitationInput entity.
+//
+//easyjson:json
+type CreateInvitationInput struct {
+	// Organization ID
+	OrganizationID string `json:"organizationId" binding:"required"`
+	// Invited phone number
+	Phone string `json:"phone" binding:"required"`
+	// Role name given to the user on acceptance
+	Role string `json:"role" binding:"required"`
+}
+
+// AcceptInvitationInput is an input data for accepting invitation.
+// Name and password are required only if there is no user with the invited phone number.
+//
+//easyjson:json
+type AcceptInvitationInput struct {
+	// Invitation code sent by SMS
+	Token string `json:"token" binding:"required"`
+	// Password
+	Password string `json:"password,omitempty"`
+	// First name
+	FirstName string `json:"firstname"`
+	// Last Name
+	LastName string `json:"lastname"`
+}
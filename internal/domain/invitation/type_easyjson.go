@@ -0,0 +1,412 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package invitation
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation(in *jlexer.Lexer, out *ListInvitation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(ListInvitation, 0, 0)
+			} else {
+				*out = ListInvitation{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 Invitation
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation(out *jwriter.Writer, in ListInvitation) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v ListInvitation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v ListInvitation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *ListInvitation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *ListInvitation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation1(in *jlexer.Lexer, out *Invitation) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "id":
+			out.ID = string(in.String())
+		case "organizationId":
+			out.OrganizationID = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "role":
+			out.RoleName = string(in.String())
+		case "status":
+			out.Status = string(in.String())
+		case "userCreated":
+			out.UserCreated = string(in.String())
+		case "createdAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.CreatedAt).UnmarshalJSON(data))
+			}
+		case "expiresAt":
+			if data := in.Raw(); in.Ok() {
+				in.AddError((out.ExpiresAt).UnmarshalJSON(data))
+			}
+		case "acceptedBy":
+			if in.IsNull() {
+				in.Skip()
+				out.AcceptedBy = nil
+			} else {
+				if out.AcceptedBy == nil {
+					out.AcceptedBy = new(string)
+				}
+				*out.AcceptedBy = string(in.String())
+			}
+		case "acceptedAt":
+			if in.IsNull() {
+				in.Skip()
+				out.AcceptedAt = nil
+			} else {
+				if out.AcceptedAt == nil {
+					out.AcceptedAt = new(time.Time)
+				}
+				if data := in.Raw(); in.Ok() {
+					in.AddError((*out.AcceptedAt).UnmarshalJSON(data))
+				}
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation1(out *jwriter.Writer, in Invitation) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"id\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.ID))
+	}
+	{
+		const prefix string = ",\"organizationId\":"
+		out.RawString(prefix)
+		out.String(string(in.OrganizationID))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.RoleName))
+	}
+	{
+		const prefix string = ",\"status\":"
+		out.RawString(prefix)
+		out.String(string(in.Status))
+	}
+	{
+		const prefix string = ",\"userCreated\":"
+		out.RawString(prefix)
+		out.String(string(in.UserCreated))
+	}
+	{
+		const prefix string = ",\"createdAt\":"
+		out.RawString(prefix)
+		out.Raw((in.CreatedAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"expiresAt\":"
+		out.RawString(prefix)
+		out.Raw((in.ExpiresAt).MarshalJSON())
+	}
+	{
+		const prefix string = ",\"acceptedBy\":"
+		out.RawString(prefix)
+		if in.AcceptedBy == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.AcceptedBy))
+		}
+	}
+	{
+		const prefix string = ",\"acceptedAt\":"
+		out.RawString(prefix)
+		if in.AcceptedAt == nil {
+			out.RawString("null")
+		} else {
+			out.Raw((*in.AcceptedAt).MarshalJSON())
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Invitation) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Invitation) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Invitation) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Invitation) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation1(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation2(in *jlexer.Lexer, out *CreateInvitationInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "organizationId":
+			out.OrganizationID = string(in.String())
+		case "phone":
+			out.Phone = string(in.String())
+		case "role":
+			out.Role = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation2(out *jwriter.Writer, in CreateInvitationInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"organizationId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OrganizationID))
+	}
+	{
+		const prefix string = ",\"phone\":"
+		out.RawString(prefix)
+		out.String(string(in.Phone))
+	}
+	{
+		const prefix string = ",\"role\":"
+		out.RawString(prefix)
+		out.String(string(in.Role))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v CreateInvitationInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v CreateInvitationInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *CreateInvitationInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *CreateInvitationInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation2(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation3(in *jlexer.Lexer, out *AcceptInvitationInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "token":
+			out.Token = string(in.String())
+		case "password":
+			out.Password = string(in.String())
+		case "firstname":
+			out.FirstName = string(in.String())
+		case "lastname":
+			out.LastName = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation3(out *jwriter.Writer, in AcceptInvitationInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"token\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Token))
+	}
+	if in.Password != "" {
+		const prefix string = ",\"password\":"
+		out.RawString(prefix)
+		out.String(string(in.Password))
+	}
+	{
+		const prefix string = ",\"firstname\":"
+		out.RawString(prefix)
+		out.String(string(in.FirstName))
+	}
+	{
+		const prefix string = ",\"lastname\":"
+		out.RawString(prefix)
+		out.String(string(in.LastName))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v AcceptInvitationInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v AcceptInvitationInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *AcceptInvitationInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *AcceptInvitationInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainInvitation3(l, v)
+}
@@ -0,0 +1,70 @@
+package member
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var ErrStructHasNoValues = errors.New("update structure has no values")
+
+const (
+	// StatusActive is a status of the member working in the organization.
+	StatusActive = "active"
+	// StatusSuspended is a status of the member temporarily losing access to the organization.
+	StatusSuspended = "suspended"
+)
+
+//easyjson:json
+type ListMember []Member
+
+// Member is a user working in the organization.
+//
+//easyjson:json
+type Member struct {
+	// User ID
+	UserID string `json:"userId" db:"user_id"`
+	// Organization ID
+	OrganizationID string `json:"organizationId" db:"organization_id"`
+	// User phone
+	Phone string `json:"phone" db:"phone"`
+	// User first name
+	FirstName string `json:"firstname" db:"first_name"`
+	// User last name
+	LastName string `json:"lastname" db:"last_name"`
+	// Role name in the organization
+	RoleName string `json:"role" db:"role"`
+	// Membership status
+	Status string `json:"status" db:"status"`
+	// Membership start datetime
+	JoinedAt time.Time `json:"joinedAt" db:"joined_at"`
+}
+
+// AddMemberInput is an input data for adding user to the organization.
+//
+//easyjson:json
+type AddMemberInput struct {
+	// User ID
+	UserID string `json:"userId" binding:"required"`
+	// Role name in the organization
+	Role string `json:"role" binding:"required"`
+}
+
+// UpdateMemberInput is an input data for changing role or status of the member.
+//
+//easyjson:json
+type UpdateMemberInput struct {
+	// Role name in the organization
+	Role *string `json:"role"`
+	// Membership status
+	Status *string `json:"status" binding:"omitempty,oneof=active suspended"`
+}
+
+// Validate checks if update input is nil.
+func (i UpdateMemberInput) Validate() error {
+	if i.Role == nil && i.Status == nil {
+		return ErrStructHasNoValues
+	}
+
+	return nil
+}
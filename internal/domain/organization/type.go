@@ -1,10 +1,21 @@
 package organization
 
 import (
+	"regexp"
+
 	"github.com/pkg/errors"
 )
 
-var ErrStructHasNoValues = errors.New("update structure has no values")
+var (
+	ErrStructHasNoValues = errors.New("update structure has no values")
+	ErrInvalidSlug       = errors.New("slug must be up to 64 lowercase latin letters, digits and single dashes")
+	ErrSlugTaken         = errors.New("slug is already used by another organization")
+)
+
+// MaxSlugLength is a maximal length of the organization slug.
+const MaxSlugLength = 64
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
 
 // ListOrganization
 //
@@ -19,6 +30,8 @@ type Organization struct {
 	ID string `json:"id" db:"id"`
 	// Organization name
 	Name string `json:"name" db:"name" binding:"required"`
+	// Unique name used in public URLs
+	Slug string `json:"slug" db:"slug"`
 	// User ID
 	UserID string `json:"userid" db:"user_id" binding:"required"`
 	// Organization address
@@ -33,6 +46,8 @@ type Organization struct {
 type CreateOrganizationInput struct {
 	// Organization name
 	Name string `json:"name" db:"name" binding:"required"`
+	// Unique name used in public URLs, generated from the name if empty
+	Slug string `json:"slug" db:"slug"`
 	// User ID
 	UserID string `json:"userid" db:"user_id" binding:"required"`
 	// Organization address
@@ -49,6 +64,8 @@ type UpdateOrganizationInput struct {
 	ID *string `json:"id"`
 	// Organization name
 	Name *string `json:"name"`
+	// Unique name used in public URLs
+	Slug *string `json:"slug"`
 	// Organization address
 	Address *string `json:"address"`
 	// Organization phone number
@@ -61,5 +78,18 @@ func (i UpdateOrganizationInput) Validate() error {
 		return ErrStructHasNoValues
 	}
 
+	if i.Slug != nil {
+		return ValidateSlug(*i.Slug)
+	}
+
+	return nil
+}
+
+// ValidateSlug checks if the slug may be used in public URLs.
+func ValidateSlug(slug string) error {
+	if len(slug) > MaxSlugLength || !slugPattern.MatchString(slug) {
+		return ErrInvalidSlug
+	}
+
 	return nil
 }
@@ -56,6 +56,16 @@ func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainOrg
 				}
 				*out.Name = string(in.String())
 			}
+		case "slug":
+			if in.IsNull() {
+				in.Skip()
+				out.Slug = nil
+			} else {
+				if out.Slug == nil {
+					out.Slug = new(string)
+				}
+				*out.Slug = string(in.String())
+			}
 		case "address":
 			if in.IsNull() {
 				in.Skip()
@@ -108,6 +118,15 @@ func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainOrg
 			out.String(string(*in.Name))
 		}
 	}
+	{
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		if in.Slug == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Slug))
+		}
+	}
 	{
 		const prefix string = ",\"address\":"
 		out.RawString(prefix)
@@ -175,6 +194,8 @@ func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainOrg
 			out.ID = string(in.String())
 		case "name":
 			out.Name = string(in.String())
+		case "slug":
+			out.Slug = string(in.String())
 		case "userid":
 			out.UserID = string(in.String())
 		case "address":
@@ -205,6 +226,11 @@ func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainOrg
 		out.RawString(prefix)
 		out.String(string(in.Name))
 	}
+	{
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		out.String(string(in.Slug))
+	}
 	{
 		const prefix string = ",\"userid\":"
 		out.RawString(prefix)
@@ -333,6 +359,8 @@ func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainOrg
 		switch key {
 		case "name":
 			out.Name = string(in.String())
+		case "slug":
+			out.Slug = string(in.String())
 		case "userid":
 			out.UserID = string(in.String())
 		case "address":
@@ -358,6 +386,11 @@ func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainOrg
 		out.RawString(prefix[1:])
 		out.String(string(in.Name))
 	}
+	{
+		const prefix string = ",\"slug\":"
+		out.RawString(prefix)
+		out.String(string(in.Slug))
+	}
 	{
 		const prefix string = ",\"userid\":"
 		out.RawString(prefix)
@@ -14,3 +14,28 @@ type Role struct {
 	// Role name
 	Name string `json:"name"`
 }
+
+// ListAssignment
+//
+//easyjson:json
+type ListAssignment []Assignment
+
+// Assignment is a role of the user in the organization.
+//
+//easyjson:json
+type Assignment struct {
+	// User ID
+	UserID string `json:"userId" db:"user_id"`
+	// Organization ID
+	OrganizationID string `json:"organizationId" db:"organization_id"`
+	// Role name
+	RoleName string `json:"role" db:"role"`
+}
+
+// AssignRoleInput is an input data for assigning role of the user in the organization.
+//
+//easyjson:json
+type AssignRoleInput struct {
+	// Role name
+	Role string `json:"role" binding:"required"`
+}
@@ -86,3 +86,178 @@ func (i UpdateRuleInput) Validate() error {
 
 	return nil
 }
+
+// Apply returns the rule with the updated values.
+func (i UpdateRuleInput) Apply(rle PolicyRule) PolicyRule {
+	for _, field := range []struct {
+		value  *string
+		target *string
+	}{
+		{i.Ptype, &rle.Ptype},
+		{i.V0, &rle.V0},
+		{i.V1, &rle.V1},
+		{i.V2, &rle.V2},
+		{i.V3, &rle.V3},
+		{i.V4, &rle.V4},
+		{i.V5, &rle.V5},
+	} {
+		if field.value != nil {
+			*field.target = *field.value
+		}
+	}
+
+	return rle
+}
+
+// RoleParentInput is an input data for adding parent role.
+//
+//easyjson:json
+type RoleParentInput struct {
+	// Parent role name, the role inherits its permissions
+	Parent string `json:"parent" binding:"required"`
+}
+
+//easyjson:json
+type ListPermission []Permission
+
+// Permission is an effective permission of a role.
+//
+//easyjson:json
+type Permission struct {
+	// Recourse name
+	Object string `json:"object"`
+	// REST verb
+	Action string `json:"action"`
+	// Permission status, deny wins over allow
+	Effect string `json:"effect"`
+	// Roles the permission comes from
+	Roles []string `json:"roles"`
+}
+
+// UserPermissions is an effective permission matrix of the current user.
+//
+//easyjson:json
+type UserPermissions struct {
+	// Role name of the user in the organization or the global one
+	Role string `json:"role"`
+	// Organization ID
+	OrganizationID string `json:"organizationId,omitempty"`
+	// Allowed REST verbs by recourse name
+	Permissions map[string][]string `json:"permissions"`
+}
+
+//easyjson:json
+type ListResource []Resource
+
+// Resource is an API endpoint protected by the policy.
+//
+//easyjson:json
+type Resource struct {
+	// Recourse name
+	Object string `json:"object"`
+	// REST verb
+	Action string `json:"action"`
+	// HTTP method
+	Method string `json:"method"`
+	// Endpoint path
+	Path string `json:"path"`
+	// Endpoint summary
+	Summary string `json:"summary"`
+	// Endpoint tag
+	Tag string `json:"tag"`
+}
+
+//easyjson:json
+type ListPolicyRule []PolicyRule
+
+// PolicyRule is a rule of the policy set, fields are in the order of casbin policy columns.
+//
+//easyjson:json
+type PolicyRule struct {
+	// Type
+	Ptype string `json:"ptype" db:"ptype"`
+	// Role name
+	V0 string `json:"v0" db:"v0"`
+	// Recourse name or parent role name
+	V1 string `json:"v1" db:"v1"`
+	// REST verb or organization
+	V2 string `json:"v2" db:"v2"`
+	// Permission status
+	V3 string `json:"v3" db:"v3"`
+	// Empty
+	V4 string `json:"v4" db:"v4"`
+	// Empty
+	V5 string `json:"v5" db:"v5"`
+}
+
+// Fields returns values of the rule without trailing empty ones, like in a casbin policy file.
+func (r PolicyRule) Fields() []string {
+	fields := []string{r.Ptype, r.V0, r.V1, r.V2, r.V3, r.V4, r.V5}
+
+	for len(fields) > 0 && fields[len(fields)-1] == "" {
+		fields = fields[:len(fields)-1]
+	}
+
+	return fields
+}
+
+// PolicyDiff is a difference between the current policy set and the imported one.
+//
+//easyjson:json
+type PolicyDiff struct {
+	// Rules missing in the current policy set
+	Added ListPolicyRule `json:"added"`
+	// Rules missing in the imported policy set
+	Removed ListPolicyRule `json:"removed"`
+	// Number of rules present in both
+	Unchanged int `json:"unchanged"`
+	// The imported policy set replaced the current one
+	Applied bool `json:"applied"`
+}
+
+// EvaluationInput is a request to check access of a role against the current policy or the policy with proposed changes.
+//
+//easyjson:json
+type EvaluationInput struct {
+	// Role name
+	Role string `json:"role" binding:"required"`
+	// Recourse name
+	Object string `json:"object" binding:"required"`
+	// REST verb
+	Action string `json:"action" binding:"required"`
+	// Proposed rules to add
+	Added ListPolicyRule `json:"added"`
+	// Proposed rules to remove
+	Removed ListPolicyRule `json:"removed"`
+}
+
+// Evaluation explains the decision of the policy.
+//
+//easyjson:json
+type Evaluation struct {
+	// The role may take the action on the object
+	Allowed bool `json:"allowed"`
+	// Effect which won, empty if no rule matched
+	Effect string `json:"effect"`
+	// Explanation of the decision
+	Reason string `json:"reason"`
+	// Roles the role inherits
+	Roles []string `json:"roles"`
+	// Rules matching the request
+	Matched ListMatchedRule `json:"matched"`
+}
+
+//easyjson:json
+type ListMatchedRule []MatchedRule
+
+// MatchedRule is a policy rule matching the request.
+//
+//easyjson:json
+type MatchedRule struct {
+	// Rule ID, empty for proposed rules
+	ID string `json:"id,omitempty"`
+	// Rule
+	Rule PolicyRule `json:"rule"`
+	// The rule is added by the proposed changes
+	Proposed bool `json:"proposed"`
+}
@@ -0,0 +1,352 @@
+package setting
+
+import (
+	"database/sql/driver"
+	"regexp"
+	"time"
+
+	"github.com/mailru/easyjson"
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrStructHasNoValues = errors.New("update structure has no values")
+	ErrInvalidSettings   = errors.New("invalid organization settings")
+	ErrInvalidJSON       = errors.New("settings value is not a json")
+)
+
+const (
+	// DefaultCurrency is a currency of the organization without settings.
+	DefaultCurrency = "USD"
+	// DefaultTimezone is a timezone of the organization without settings.
+	DefaultTimezone = "UTC"
+	// DefaultLocale is a locale of the organization without settings.
+	DefaultLocale = "en"
+
+	// TimeLayout is a layout of opening and closing times.
+	TimeLayout = "15:04"
+	// DateLayout is a layout of exception dates.
+	DateLayout = "2006-01-02"
+
+	maxOrderPrefixLength = 10
+	maxTaxRate           = 100
+)
+
+var (
+	currencyPattern    = regexp.MustCompile(`^[A-Z]{3}$`)
+	localePattern      = regexp.MustCompile(`^[a-z]{2,3}(-[A-Z][a-z]{3})?(-[A-Z]{2}|-[0-9]{3})?$`)
+	orderPrefixPattern = regexp.MustCompile(`^[A-Za-z0-9-]*$`)
+)
+
+// Settings of the organization.
+//
+//easyjson:json
+type Settings struct {
+	// Organization ID
+	OrganizationID string `json:"organizationId" db:"organization_id"`
+	// ISO 4217 currency code
+	Currency string `json:"currency" db:"currency"`
+	// IANA timezone name
+	Timezone string `json:"timezone" db:"timezone"`
+	// Enabled locales
+	Locales Locales `json:"locales" db:"locales"`
+	// Default locale, one of the enabled locales
+	DefaultLocale string `json:"defaultLocale" db:"default_locale"`
+	// Weekly working hours in the timezone of the organization
+	WorkingHours WorkingHours `json:"workingHours" db:"working_hours"`
+	// Holidays and days with changed working hours
+	Exceptions Exceptions `json:"exceptions" db:"exceptions"`
+	// Tax settings
+	Tax Tax `json:"tax" db:"tax"`
+	// Prefix of order numbers
+	OrderPrefix string `json:"orderPrefix" db:"order_prefix"`
+}
+
+//easyjson:json
+type Locales []string
+
+//easyjson:json
+type WorkingHours []Interval
+
+// Interval is a time the organization is open on the day of the week.
+//
+//easyjson:json
+type Interval struct {
+	// Day of the week, 0 is Sunday
+	Weekday time.Weekday `json:"weekday"`
+	// Opening time in HH:MM format
+	Open string `json:"open"`
+	// Closing time in HH:MM format
+	Close string `json:"close"`
+}
+
+//easyjson:json
+type Exceptions []Exception
+
+// Exception replaces weekly working hours on the date.
+//
+//easyjson:json
+type Exception struct {
+	// Date in YYYY-MM-DD format
+	Date string `json:"date"`
+	// Organization is closed the whole day
+	Closed bool `json:"closed"`
+	// Opening time in HH:MM format, ignored if closed
+	Open string `json:"open,omitempty"`
+	// Closing time in HH:MM format, ignored if closed
+	Close string `json:"close,omitempty"`
+	// Reason of the exception
+	Note string `json:"note,omitempty"`
+}
+
+// Tax settings of the organization.
+//
+//easyjson:json
+type Tax struct {
+	// Tax name shown in receipts
+	Name string `json:"name"`
+	// Tax rate in percent
+	Rate float32 `json:"rate"`
+	// Item prices include the tax
+	Included bool `json:"included"`
+}
+
+// UpdateSettingsInput is an input data for updating settings of the organization.
+//
+//easyjson:json
+type UpdateSettingsInput struct {
+	// ISO 4217 currency code
+	Currency *string `json:"currency"`
+	// IANA timezone name
+	Timezone *string `json:"timezone"`
+	// Enabled locales
+	Locales *Locales `json:"locales"`
+	// Default locale, one of the enabled locales
+	DefaultLocale *string `json:"defaultLocale"`
+	// Weekly working hours in the timezone of the organization
+	WorkingHours *WorkingHours `json:"workingHours"`
+	// Holidays and days with changed working hours
+	Exceptions *Exceptions `json:"exceptions"`
+	// Tax settings
+	Tax *Tax `json:"tax"`
+	// Prefix of order numbers
+	OrderPrefix *string `json:"orderPrefix"`
+}
+
+// Default returns settings of the organization which has not changed them.
+func Default(organizationID string) Settings {
+	return Settings{
+		OrganizationID: organizationID,
+		Currency:       DefaultCurrency,
+		Timezone:       DefaultTimezone,
+		Locales:        Locales{DefaultLocale},
+		DefaultLocale:  DefaultLocale,
+		WorkingHours:   WorkingHours{},
+		Exceptions:     Exceptions{},
+	}
+}
+
+// Validate checks if update input is nil.
+func (i UpdateSettingsInput) Validate() error {
+	if i.Currency == nil && i.Timezone == nil && i.Locales == nil && i.DefaultLocale == nil &&
+		i.WorkingHours == nil && i.Exceptions == nil && i.Tax == nil && i.OrderPrefix == nil {
+		return ErrStructHasNoValues
+	}
+
+	return nil
+}
+
+// Apply returns the settings with changed fields of the input.
+func (i UpdateSettingsInput) Apply(settings Settings) Settings {
+	if i.Currency != nil {
+		settings.Currency = *i.Currency
+	}
+
+	if i.Timezone != nil {
+		settings.Timezone = *i.Timezone
+	}
+
+	if i.Locales != nil {
+		settings.Locales = *i.Locales
+	}
+
+	if i.DefaultLocale != nil {
+		settings.DefaultLocale = *i.DefaultLocale
+	}
+
+	if i.WorkingHours != nil {
+		settings.WorkingHours = *i.WorkingHours
+	}
+
+	if i.Exceptions != nil {
+		settings.Exceptions = *i.Exceptions
+	}
+
+	if i.Tax != nil {
+		settings.Tax = *i.Tax
+	}
+
+	if i.OrderPrefix != nil {
+		settings.OrderPrefix = *i.OrderPrefix
+	}
+
+	return settings
+}
+
+// Validate checks if the settings are consistent.
+func (s Settings) Validate() error { //nolint:cyclop
+	if !currencyPattern.MatchString(s.Currency) {
+		return errors.Wrap(ErrInvalidSettings, "currency must be an ISO 4217 code")
+	}
+
+	if _, err := time.LoadLocation(s.Timezone); err != nil || s.Timezone == "" || s.Timezone == "Local" {
+		return errors.Wrap(ErrInvalidSettings, "timezone must be an IANA timezone name")
+	}
+
+	if len(s.Locales) == 0 {
+		return errors.Wrap(ErrInvalidSettings, "at least one locale must be enabled")
+	}
+
+	isDefaultEnabled := false
+
+	for _, locale := range s.Locales {
+		if !localePattern.MatchString(locale) {
+			return errors.Wrap(ErrInvalidSettings, "invalid locale "+locale)
+		}
+
+		if locale == s.DefaultLocale {
+			isDefaultEnabled = true
+		}
+	}
+
+	if !isDefaultEnabled {
+		return errors.Wrap(ErrInvalidSettings, "default locale must be enabled")
+	}
+
+	for _, interval := range s.WorkingHours {
+		if interval.Weekday < time.Sunday || interval.Weekday > time.Saturday {
+			return errors.Wrap(ErrInvalidSettings, "weekday must be from 0 to 6")
+		}
+
+		if err := validateHours(interval.Open, interval.Close); err != nil {
+			return err
+		}
+	}
+
+	dates := make(map[string]struct{}, len(s.Exceptions))
+
+	for _, exception := range s.Exceptions {
+		if _, err := time.Parse(DateLayout, exception.Date); err != nil {
+			return errors.Wrap(ErrInvalidSettings, "exception date must be in YYYY-MM-DD format")
+		}
+
+		if _, ok := dates[exception.Date]; ok {
+			return errors.Wrap(ErrInvalidSettings, "duplicate exception date "+exception.Date)
+		}
+
+		dates[exception.Date] = struct{}{}
+
+		if exception.Closed {
+			continue
+		}
+
+		if err := validateHours(exception.Open, exception.Close); err != nil {
+			return err
+		}
+	}
+
+	if s.Tax.Rate < 0 || s.Tax.Rate > maxTaxRate {
+		return errors.Wrap(ErrInvalidSettings, "tax rate must be from 0 to 100 percent")
+	}
+
+	if len(s.OrderPrefix) > maxOrderPrefixLength || !orderPrefixPattern.MatchString(s.OrderPrefix) {
+		return errors.Wrap(ErrInvalidSettings, "order prefix must be up to 10 letters, digits or dashes")
+	}
+
+	return nil
+}
+
+// validateHours checks if opening and closing times are valid and the organization closes after it opens.
+func validateHours(opensAt string, closesAt string) error {
+	openTime, err := time.Parse(TimeLayout, opensAt)
+	if err != nil {
+		return errors.Wrap(ErrInvalidSettings, "opening time must be in HH:MM format")
+	}
+
+	closeTime, err := time.Parse(TimeLayout, closesAt)
+	if err != nil {
+		return errors.Wrap(ErrInvalidSettings, "closing time must be in HH:MM format")
+	}
+
+	if !closeTime.After(openTime) {
+		return errors.Wrap(ErrInvalidSettings, "closing time must be after opening time")
+	}
+
+	return nil
+}
+
+// Value stores locales as json.
+func (l Locales) Value() (driver.Value, error) {
+	if l == nil {
+		return []byte("[]"), nil
+	}
+
+	return l.MarshalJSON()
+}
+
+// Scan reads locales from json.
+func (l *Locales) Scan(src interface{}) error {
+	return scanJSON(src, l)
+}
+
+// Value stores working hours as json.
+func (w WorkingHours) Value() (driver.Value, error) {
+	if w == nil {
+		return []byte("[]"), nil
+	}
+
+	return w.MarshalJSON()
+}
+
+// Scan reads working hours from json.
+func (w *WorkingHours) Scan(src interface{}) error {
+	return scanJSON(src, w)
+}
+
+// Value stores exceptions as json.
+func (e Exceptions) Value() (driver.Value, error) {
+	if e == nil {
+		return []byte("[]"), nil
+	}
+
+	return e.MarshalJSON()
+}
+
+// Scan reads exceptions from json.
+func (e *Exceptions) Scan(src interface{}) error {
+	return scanJSON(src, e)
+}
+
+// Value stores tax settings as json.
+func (t Tax) Value() (driver.Value, error) {
+	return t.MarshalJSON()
+}
+
+// Scan reads tax settings from json.
+func (t *Tax) Scan(src interface{}) error {
+	return scanJSON(src, t)
+}
+
+// scanJSON reads the database json value into the destination.
+func scanJSON(src interface{}, dst easyjson.Unmarshaler) error {
+	switch value := src.(type) {
+	case []byte:
+		return errors.Wrap(easyjson.Unmarshal(value, dst), "unable to unmarshal")
+	case string:
+		return errors.Wrap(easyjson.Unmarshal([]byte(value), dst), "unable to unmarshal")
+	case nil:
+		return nil
+	default:
+		return ErrInvalidJSON
+	}
+}
@@ -0,0 +1,805 @@
+// Code generated by easyjson for marshaling/unmarshaling. DO NOT EDIT.
+
+package setting
+
+import (
+	json "encoding/json"
+	easyjson "github.com/mailru/easyjson"
+	jlexer "github.com/mailru/easyjson/jlexer"
+	jwriter "github.com/mailru/easyjson/jwriter"
+	time "time"
+)
+
+// suppress unused package warning
+var (
+	_ *json.RawMessage
+	_ *jlexer.Lexer
+	_ *jwriter.Writer
+	_ easyjson.Marshaler
+)
+
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting(in *jlexer.Lexer, out *WorkingHours) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(WorkingHours, 0, 1)
+			} else {
+				*out = WorkingHours{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v1 Interval
+			(v1).UnmarshalEasyJSON(in)
+			*out = append(*out, v1)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting(out *jwriter.Writer, in WorkingHours) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v2, v3 := range in {
+			if v2 > 0 {
+				out.RawByte(',')
+			}
+			(v3).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v WorkingHours) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v WorkingHours) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *WorkingHours) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *WorkingHours) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting1(in *jlexer.Lexer, out *UpdateSettingsInput) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "currency":
+			if in.IsNull() {
+				in.Skip()
+				out.Currency = nil
+			} else {
+				if out.Currency == nil {
+					out.Currency = new(string)
+				}
+				*out.Currency = string(in.String())
+			}
+		case "timezone":
+			if in.IsNull() {
+				in.Skip()
+				out.Timezone = nil
+			} else {
+				if out.Timezone == nil {
+					out.Timezone = new(string)
+				}
+				*out.Timezone = string(in.String())
+			}
+		case "locales":
+			if in.IsNull() {
+				in.Skip()
+				out.Locales = nil
+			} else {
+				if out.Locales == nil {
+					out.Locales = new(Locales)
+				}
+				(*out.Locales).UnmarshalEasyJSON(in)
+			}
+		case "defaultLocale":
+			if in.IsNull() {
+				in.Skip()
+				out.DefaultLocale = nil
+			} else {
+				if out.DefaultLocale == nil {
+					out.DefaultLocale = new(string)
+				}
+				*out.DefaultLocale = string(in.String())
+			}
+		case "workingHours":
+			if in.IsNull() {
+				in.Skip()
+				out.WorkingHours = nil
+			} else {
+				if out.WorkingHours == nil {
+					out.WorkingHours = new(WorkingHours)
+				}
+				(*out.WorkingHours).UnmarshalEasyJSON(in)
+			}
+		case "exceptions":
+			if in.IsNull() {
+				in.Skip()
+				out.Exceptions = nil
+			} else {
+				if out.Exceptions == nil {
+					out.Exceptions = new(Exceptions)
+				}
+				(*out.Exceptions).UnmarshalEasyJSON(in)
+			}
+		case "tax":
+			if in.IsNull() {
+				in.Skip()
+				out.Tax = nil
+			} else {
+				if out.Tax == nil {
+					out.Tax = new(Tax)
+				}
+				(*out.Tax).UnmarshalEasyJSON(in)
+			}
+		case "orderPrefix":
+			if in.IsNull() {
+				in.Skip()
+				out.OrderPrefix = nil
+			} else {
+				if out.OrderPrefix == nil {
+					out.OrderPrefix = new(string)
+				}
+				*out.OrderPrefix = string(in.String())
+			}
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting1(out *jwriter.Writer, in UpdateSettingsInput) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix[1:])
+		if in.Currency == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Currency))
+		}
+	}
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		if in.Timezone == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.Timezone))
+		}
+	}
+	{
+		const prefix string = ",\"locales\":"
+		out.RawString(prefix)
+		if in.Locales == nil {
+			out.RawString("null")
+		} else {
+			(*in.Locales).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"defaultLocale\":"
+		out.RawString(prefix)
+		if in.DefaultLocale == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.DefaultLocale))
+		}
+	}
+	{
+		const prefix string = ",\"workingHours\":"
+		out.RawString(prefix)
+		if in.WorkingHours == nil {
+			out.RawString("null")
+		} else {
+			(*in.WorkingHours).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"exceptions\":"
+		out.RawString(prefix)
+		if in.Exceptions == nil {
+			out.RawString("null")
+		} else {
+			(*in.Exceptions).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"tax\":"
+		out.RawString(prefix)
+		if in.Tax == nil {
+			out.RawString("null")
+		} else {
+			(*in.Tax).MarshalEasyJSON(out)
+		}
+	}
+	{
+		const prefix string = ",\"orderPrefix\":"
+		out.RawString(prefix)
+		if in.OrderPrefix == nil {
+			out.RawString("null")
+		} else {
+			out.String(string(*in.OrderPrefix))
+		}
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v UpdateSettingsInput) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting1(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v UpdateSettingsInput) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting1(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *UpdateSettingsInput) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting1(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *UpdateSettingsInput) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting1(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting2(in *jlexer.Lexer, out *Tax) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "name":
+			out.Name = string(in.String())
+		case "rate":
+			out.Rate = float32(in.Float32())
+		case "included":
+			out.Included = bool(in.Bool())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting2(out *jwriter.Writer, in Tax) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"name\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Name))
+	}
+	{
+		const prefix string = ",\"rate\":"
+		out.RawString(prefix)
+		out.Float32(float32(in.Rate))
+	}
+	{
+		const prefix string = ",\"included\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Included))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Tax) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting2(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Tax) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting2(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Tax) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting2(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Tax) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting2(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting3(in *jlexer.Lexer, out *Settings) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "organizationId":
+			out.OrganizationID = string(in.String())
+		case "currency":
+			out.Currency = string(in.String())
+		case "timezone":
+			out.Timezone = string(in.String())
+		case "locales":
+			(out.Locales).UnmarshalEasyJSON(in)
+		case "defaultLocale":
+			out.DefaultLocale = string(in.String())
+		case "workingHours":
+			(out.WorkingHours).UnmarshalEasyJSON(in)
+		case "exceptions":
+			(out.Exceptions).UnmarshalEasyJSON(in)
+		case "tax":
+			(out.Tax).UnmarshalEasyJSON(in)
+		case "orderPrefix":
+			out.OrderPrefix = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting3(out *jwriter.Writer, in Settings) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"organizationId\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.OrganizationID))
+	}
+	{
+		const prefix string = ",\"currency\":"
+		out.RawString(prefix)
+		out.String(string(in.Currency))
+	}
+	{
+		const prefix string = ",\"timezone\":"
+		out.RawString(prefix)
+		out.String(string(in.Timezone))
+	}
+	{
+		const prefix string = ",\"locales\":"
+		out.RawString(prefix)
+		(in.Locales).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"defaultLocale\":"
+		out.RawString(prefix)
+		out.String(string(in.DefaultLocale))
+	}
+	{
+		const prefix string = ",\"workingHours\":"
+		out.RawString(prefix)
+		(in.WorkingHours).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"exceptions\":"
+		out.RawString(prefix)
+		(in.Exceptions).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"tax\":"
+		out.RawString(prefix)
+		(in.Tax).MarshalEasyJSON(out)
+	}
+	{
+		const prefix string = ",\"orderPrefix\":"
+		out.RawString(prefix)
+		out.String(string(in.OrderPrefix))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Settings) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting3(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Settings) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting3(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Settings) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting3(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Settings) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting3(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting4(in *jlexer.Lexer, out *Locales) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Locales, 0, 4)
+			} else {
+				*out = Locales{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v4 string
+			v4 = string(in.String())
+			*out = append(*out, v4)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting4(out *jwriter.Writer, in Locales) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v5, v6 := range in {
+			if v5 > 0 {
+				out.RawByte(',')
+			}
+			out.String(string(v6))
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Locales) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting4(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Locales) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting4(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Locales) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting4(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Locales) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting4(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting5(in *jlexer.Lexer, out *Interval) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "weekday":
+			out.Weekday = time.Weekday(in.Int())
+		case "open":
+			out.Open = string(in.String())
+		case "close":
+			out.Close = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting5(out *jwriter.Writer, in Interval) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"weekday\":"
+		out.RawString(prefix[1:])
+		out.Int(int(in.Weekday))
+	}
+	{
+		const prefix string = ",\"open\":"
+		out.RawString(prefix)
+		out.String(string(in.Open))
+	}
+	{
+		const prefix string = ",\"close\":"
+		out.RawString(prefix)
+		out.String(string(in.Close))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Interval) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting5(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Interval) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting5(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Interval) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting5(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Interval) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting5(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting6(in *jlexer.Lexer, out *Exceptions) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		in.Skip()
+		*out = nil
+	} else {
+		in.Delim('[')
+		if *out == nil {
+			if !in.IsDelim(']') {
+				*out = make(Exceptions, 0, 0)
+			} else {
+				*out = Exceptions{}
+			}
+		} else {
+			*out = (*out)[:0]
+		}
+		for !in.IsDelim(']') {
+			var v7 Exception
+			(v7).UnmarshalEasyJSON(in)
+			*out = append(*out, v7)
+			in.WantComma()
+		}
+		in.Delim(']')
+	}
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting6(out *jwriter.Writer, in Exceptions) {
+	if in == nil && (out.Flags&jwriter.NilSliceAsEmpty) == 0 {
+		out.RawString("null")
+	} else {
+		out.RawByte('[')
+		for v8, v9 := range in {
+			if v8 > 0 {
+				out.RawByte(',')
+			}
+			(v9).MarshalEasyJSON(out)
+		}
+		out.RawByte(']')
+	}
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Exceptions) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting6(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Exceptions) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting6(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Exceptions) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting6(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Exceptions) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting6(l, v)
+}
+func easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting7(in *jlexer.Lexer, out *Exception) {
+	isTopLevel := in.IsStart()
+	if in.IsNull() {
+		if isTopLevel {
+			in.Consumed()
+		}
+		in.Skip()
+		return
+	}
+	in.Delim('{')
+	for !in.IsDelim('}') {
+		key := in.UnsafeFieldName(false)
+		in.WantColon()
+		if in.IsNull() {
+			in.Skip()
+			in.WantComma()
+			continue
+		}
+		switch key {
+		case "date":
+			out.Date = string(in.String())
+		case "closed":
+			out.Closed = bool(in.Bool())
+		case "open":
+			out.Open = string(in.String())
+		case "close":
+			out.Close = string(in.String())
+		case "note":
+			out.Note = string(in.String())
+		default:
+			in.SkipRecursive()
+		}
+		in.WantComma()
+	}
+	in.Delim('}')
+	if isTopLevel {
+		in.Consumed()
+	}
+}
+func easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting7(out *jwriter.Writer, in Exception) {
+	out.RawByte('{')
+	first := true
+	_ = first
+	{
+		const prefix string = ",\"date\":"
+		out.RawString(prefix[1:])
+		out.String(string(in.Date))
+	}
+	{
+		const prefix string = ",\"closed\":"
+		out.RawString(prefix)
+		out.Bool(bool(in.Closed))
+	}
+	if in.Open != "" {
+		const prefix string = ",\"open\":"
+		out.RawString(prefix)
+		out.String(string(in.Open))
+	}
+	if in.Close != "" {
+		const prefix string = ",\"close\":"
+		out.RawString(prefix)
+		out.String(string(in.Close))
+	}
+	if in.Note != "" {
+		const prefix string = ",\"note\":"
+		out.RawString(prefix)
+		out.String(string(in.Note))
+	}
+	out.RawByte('}')
+}
+
+// MarshalJSON supports json.Marshaler interface
+func (v Exception) MarshalJSON() ([]byte, error) {
+	w := jwriter.Writer{}
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting7(&w, v)
+	return w.Buffer.BuildBytes(), w.Error
+}
+
+// MarshalEasyJSON supports easyjson.Marshaler interface
+func (v Exception) MarshalEasyJSON(w *jwriter.Writer) {
+	easyjsonBc289ab0EncodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting7(w, v)
+}
+
+// UnmarshalJSON supports json.Unmarshaler interface
+func (v *Exception) UnmarshalJSON(data []byte) error {
+	r := jlexer.Lexer{Data: data}
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting7(&r, v)
+	return r.Error()
+}
+
+// UnmarshalEasyJSON supports easyjson.Unmarshaler interface
+func (v *Exception) UnmarshalEasyJSON(l *jlexer.Lexer) {
+	easyjsonBc289ab0DecodeGithubComEvgeniyDammermarketplaceApiInternalDomainSetting7(l, v)
+}
@@ -0,0 +1,60 @@
+package storefront
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
+)
+
+// Organization is a public information of the organization.
+//
+//easyjson:json
+type Organization struct {
+	// Organization ID
+	ID string `json:"id" db:"id"`
+	// Unique name used in public URLs
+	Slug string `json:"slug" db:"slug"`
+	// Organization name
+	Name string `json:"name" db:"name"`
+	// Organization address
+	Address string `json:"address" db:"address"`
+	// Organization phone number
+	Phone string `json:"phone" db:"phone"`
+	// Organization settings
+	Settings setting.Settings `json:"settings" db:"-"`
+}
+
+//easyjson:json
+type ListCategory []Category
+
+// Category is a node of the category tree.
+//
+//easyjson:json
+type Category struct {
+	// Category ID
+	ID string `json:"id" db:"id"`
+	// Name Turkmen
+	NameTm string `json:"nametm" db:"name_tm"`
+	// Name Russian
+	NameRu string `json:"nameru" db:"name_ru"`
+	// Name Turkish
+	NameTr string `json:"nametr" db:"name_tr"`
+	// Name English
+	NameEn string `json:"nameen" db:"name_en"`
+	// Parent category ID
+	Parent string `json:"parent" db:"parent_id"`
+	// Depth level
+	Level int `json:"level" db:"level"`
+	// Child categories
+	Children []Category `json:"children"`
+}
+
+// ItemFilter is a filter of public items.
+//
+//easyjson:json
+type ItemFilter struct {
+	// Category ID
+	CategoryID string `json:"categoryId" form:"category_id"`
+	// Maximal number of items
+	Limit uint64 `json:"limit" form:"limit"`
+	// Number of skipped items
+	Offset uint64 `json:"offset" form:"offset"`
+}
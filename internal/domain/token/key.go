@@ -0,0 +1,54 @@
+package token
+
+import "time"
+
+const (
+	// AlgorithmHS256 is a symmetric HMAC SHA-256 signing algorithm.
+	AlgorithmHS256 = "HS256"
+	// AlgorithmRS256 is an asymmetric RSA SHA-256 signing algorithm.
+	AlgorithmRS256 = "RS256"
+	// AlgorithmEdDSA is an asymmetric Ed25519 signing algorithm.
+	AlgorithmEdDSA = "EdDSA"
+)
+
+// SigningKey is a key pair used for signing and verifying tokens.
+type SigningKey struct {
+	// Key ID
+	ID string `db:"id"`
+	// Signing algorithm
+	Algorithm string `db:"algorithm"`
+	// PEM encoded PKCS #8 private key encrypted with the key encryption key held in Vault
+	PrivateKey string `db:"private_key"`
+	// Creation datetime
+	CreatedAt time.Time `db:"created_at"`
+	// Datetime after which the key is no longer used for signing
+	ExpiresAt time.Time `db:"expires_at"`
+	// Datetime after which the key is no longer used for verifying
+	RetiredAt time.Time `db:"retired_at"`
+}
+
+// JWK is a public JSON Web Key.
+type JWK struct {
+	// Key type
+	Kty string `json:"kty"`
+	// Key ID
+	Kid string `json:"kid"`
+	// Key usage
+	Use string `json:"use"`
+	// Signing algorithm
+	Alg string `json:"alg"`
+	// RSA modulus
+	N string `json:"n,omitempty"`
+	// RSA public exponent
+	E string `json:"e,omitempty"`
+	// Curve name
+	Crv string `json:"crv,omitempty"`
+	// Public key of the curve
+	X string `json:"x,omitempty"`
+}
+
+// JWKS is a set of public JSON Web Keys.
+type JWKS struct {
+	// Keys
+	Keys []JWK `json:"keys"`
+}
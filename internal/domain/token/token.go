@@ -1,6 +1,17 @@
 package token
 
-import "github.com/golang-jwt/jwt"
+import (
+	"time"
+
+	"github.com/golang-jwt/jwt"
+)
+
+const (
+	// ClientTypeMobile is a mobile application client.
+	ClientTypeMobile = "mobile"
+	// ClientTypeWeb is a web browser client.
+	ClientTypeWeb = "web"
+)
 
 // Tokens is a signin and refresh token response.
 type Tokens struct {
@@ -14,6 +25,18 @@ type Tokens struct {
 	RefreshTokenHash string `json:"refreshTokenHash,omitempty"`
 	// Access Token Expires datetime
 	AccessTokenExpires int64 `json:"accessTokenExpires"`
+	// Refresh Token Expires datetime
+	RefreshTokenExpires int64 `json:"-"`
+}
+
+// Challenge is a second sign in step required before tokens are issued.
+type Challenge struct {
+	// Challenge token for the second step
+	Token string `json:"challengeToken"`
+	// User has to enrol TOTP first
+	EnrollmentRequired bool `json:"enrollmentRequired"`
+	// Challenge expires datetime
+	ExpiresAt int64 `json:"expiresAt"`
 }
 
 // RefreshToken is a refresh token input.
@@ -28,3 +51,33 @@ type Claims struct {
 	UserID string `json:"userId"`
 	Hash   string `json:"hash"`
 }
+
+// Session is an active refresh token of a user device.
+type Session struct {
+	// Session ID
+	ID string `json:"id" db:"id"`
+	// Client type name
+	ClientType string `json:"clientType" db:"client_type"`
+	// Device name
+	DeviceName string `json:"deviceName" db:"device_name"`
+	// IP address of the last usage
+	IP string `json:"ip" db:"ip"`
+	// Session creation datetime
+	CreatedAt time.Time `json:"createdAt" db:"created_at"`
+	// Last usage datetime
+	LastUsedAt time.Time `json:"lastUsedAt" db:"last_used_at"`
+	// Expiration datetime
+	ExpiresAt time.Time `json:"expiresAt" db:"expires_at"`
+}
+
+// SessionInput is a client data stored together with refresh token hash.
+type SessionInput struct {
+	// Client type name
+	ClientType string
+	// Device name
+	DeviceName string
+	// IP address
+	IP string
+	// Expiration datetime
+	ExpiresAt time.Time
+}
@@ -1,11 +1,26 @@
 package user
 
 import (
+	"time"
+
 	"github.com/pkg/errors"
 )
 
 var ErrStructHasNoValues = errors.New("update structure has no values")
 
+const (
+	// StatusActive is a status of verified user.
+	StatusActive = "active"
+	// StatusInactive is a status of deactivated user.
+	StatusInactive = "inactive"
+	// StatusBlocked is a status of blocked user.
+	StatusBlocked = "blocked"
+	// StatusPending is a status of user waiting for phone verification.
+	StatusPending = "pending"
+	// StatusSuspended is a status of user blocked until a date.
+	StatusSuspended = "suspended"
+)
+
 //easyjson:json
 type ListUser []User
 
@@ -27,10 +42,44 @@ type User struct {
 	RoleName string `json:"role,omitempty" db:"role"`
 	// Users status
 	Status string `json:"status" db:"status"`
+	// Reason of the last status change
+	StatusReason string `json:"statusReason,omitempty" db:"status_reason"`
+	// Suspension end datetime
+	SuspendedUntil *time.Time `json:"suspendedUntil,omitempty" db:"suspended_until"`
 	// Role ID
 	RoleID int `json:"roleid,omitempty"`
 }
 
+// AccountStatus is a status of the user checked on sign in and on every request.
+//
+//easyjson:json
+type AccountStatus struct {
+	// Users status
+	Status string `json:"status" db:"status"`
+	// Suspension end datetime
+	SuspendedUntil *time.Time `json:"suspendedUntil" db:"suspended_until"`
+}
+
+// IsBlocked checks if the user is blocked.
+func (s AccountStatus) IsBlocked() bool {
+	return s.Status == StatusBlocked
+}
+
+// IsSuspended checks if the user is suspended at the moment.
+func (s AccountStatus) IsSuspended(now time.Time) bool {
+	return s.Status == StatusSuspended && s.SuspendedUntil != nil && now.Before(*s.SuspendedUntil)
+}
+
+// ChangeStatusInput is an input data for blocking, suspending and reactivating user.
+//
+//easyjson:json
+type ChangeStatusInput struct {
+	// Reason of the status change
+	Reason string `json:"reason" binding:"required,max=1000"`
+	// Suspension end datetime, required for suspending
+	Until *time.Time `json:"until"`
+}
+
 // CreateUserInput entity.
 //
 //easyjson:json
@@ -47,12 +96,160 @@ type CreateUserInput struct {
 	RoleID int `json:"roleid,omitempty"`
 }
 
+// SignUpInput is an input data for public sign up, new users are always customers.
+//
+//easyjson:json
+type SignUpInput struct {
+	// Phone number
+	Phone string `json:"phone" binding:"required"`
+	// Password
+	Password string `json:"password,omitempty" binding:"required"`
+	// First name
+	FirstName string `json:"firstname" binding:"required"`
+	// Last Name
+	LastName string `json:"lastname" binding:"required"`
+}
+
 // SignInInput is an input data for signing in.
 type SignInInput struct {
 	// Users phone number
 	Phone string `json:"phone" binding:"required"`
 	// Users password
 	Password string `json:"password" binding:"required"`
+	// Client type: mobile or web
+	ClientType string `json:"clientType" binding:"omitempty,oneof=mobile web"`
+	// Device name
+	DeviceName string `json:"deviceName"`
+}
+
+// RequestOTPInput is an input data for requesting one-time code.
+//
+//easyjson:json
+type RequestOTPInput struct {
+	// Users phone number
+	Phone string `json:"phone" binding:"required"`
+}
+
+// VerifyPhoneInput is an input data for verifying phone number with one-time code.
+//
+//easyjson:json
+type VerifyPhoneInput struct {
+	// Users phone number
+	Phone string `json:"phone" binding:"required"`
+	// One-time code
+	Code string `json:"code" binding:"required"`
+}
+
+// ForgotPasswordInput is an input data for requesting password reset code.
+//
+//easyjson:json
+type ForgotPasswordInput struct {
+	// Users phone number
+	Phone string `json:"phone" binding:"required"`
+}
+
+// ResetPasswordInput is an input data for setting new password with one-time code.
+//
+//easyjson:json
+type ResetPasswordInput struct {
+	// Users phone number
+	Phone string `json:"phone" binding:"required"`
+	// One-time code
+	Code string `json:"code" binding:"required"`
+	// New password
+	Password string `json:"password" binding:"required"`
+}
+
+// ChangePasswordInput is an input data for changing password of the current user.
+//
+//easyjson:json
+type ChangePasswordInput struct {
+	// Current password
+	CurrentPassword string `json:"currentPassword" binding:"required"`
+	// New password
+	NewPassword string `json:"newPassword" binding:"required"`
+}
+
+// TwoFactor is a TOTP two-factor authentication settings of the user.
+type TwoFactor struct {
+	// User ID
+	UserID string `db:"user_id"`
+	// Base32 encoded TOTP secret
+	Secret string `db:"secret"`
+	// Enrolment is confirmed
+	Enabled bool `db:"enabled"`
+}
+
+// TwoFactorEnrollment is a new TOTP secret for the authenticator application.
+//
+//easyjson:json
+type TwoFactorEnrollment struct {
+	// Base32 encoded TOTP secret
+	Secret string `json:"secret"`
+	// otpauth:// provisioning URI for QR code
+	ProvisioningURI string `json:"provisioningUri"`
+}
+
+// TwoFactorCodeInput is an input data with TOTP or backup code.
+//
+//easyjson:json
+type TwoFactorCodeInput struct {
+	// TOTP or backup code
+	Code string `json:"code" binding:"required"`
+}
+
+// TwoFactorChallengeInput is an input data for the second step of signing in.
+//
+//easyjson:json
+type TwoFactorChallengeInput struct {
+	// Challenge token from the first step
+	ChallengeToken string `json:"challengeToken" binding:"required"`
+	// TOTP or backup code, not needed for enrolment
+	Code string `json:"code"`
+	// Client type: mobile or web
+	ClientType string `json:"clientType" binding:"omitempty,oneof=mobile web"`
+	// Device name
+	DeviceName string `json:"deviceName"`
+}
+
+// BackupCodes is a list of one-time backup codes shown once.
+//
+//easyjson:json
+type BackupCodes struct {
+	// Backup codes
+	Codes []string `json:"codes"`
+}
+
+// ExternalIdentity is a verified identity returned by an external identity provider.
+//
+//easyjson:json
+type ExternalIdentity struct {
+	// Identity provider name
+	Provider string `json:"provider"`
+	// Subject identifier at the provider
+	Subject string `json:"subject"`
+	// Phone number
+	Phone string `json:"phone"`
+	// Phone number is verified by the provider
+	PhoneVerified bool `json:"phoneVerified"`
+	// First name
+	FirstName string `json:"firstname"`
+	// Last Name
+	LastName string `json:"lastname"`
+	// Role name mapped from provider claims, empty if no mapping matched
+	RoleName string `json:"role"`
+}
+
+// OIDCState is a pending OpenID Connect login stored until the callback.
+//
+//easyjson:json
+type OIDCState struct {
+	// Identity provider name
+	Provider string `json:"provider"`
+	// PKCE code verifier
+	CodeVerifier string `json:"codeVerifier"`
+	// ID token nonce
+	Nonce string `json:"nonce"`
 }
 
 // UpdateUserInput is an input data for updating user entity.
@@ -65,8 +262,6 @@ type UpdateUserInput struct {
 	FirstName *string `json:"firstname"`
 	// Last Name
 	LastName *string `json:"lastname"`
-	// Password
-	Password *string `json:"password"`
 }
 
 // Validate checks if update input is nil.
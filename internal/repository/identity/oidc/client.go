@@ -0,0 +1,396 @@
+package oidc
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/golang-jwt/jwt"
+	"github.com/pkg/errors"
+)
+
+const (
+	discoveryPath       = "/.well-known/openid-configuration"
+	codeChallengeMethod = "S256"
+	maxResponseSize     = 1 << 20
+)
+
+var (
+	ErrUnknownProvider  = errors.New("unknown identity provider")
+	ErrInvalidIDToken   = errors.New("invalid id token")
+	ErrUnknownKey       = errors.New("unknown id token signing key")
+	ErrUnexpectedStatus = errors.New("unexpected identity provider response status")
+)
+
+// defaultScopes are requested if provider has no scopes configured.
+var defaultScopes = []string{"openid", "profile", "phone"}
+
+// ProviderConfig is a configuration of the OpenID Connect provider.
+type ProviderConfig struct {
+	// Provider name used in the login URL
+	Name string `mapstructure:"name"`
+	// Issuer URL, discovery document is loaded from it
+	Issuer string `mapstructure:"issuer"`
+	// Client ID
+	ClientID string `mapstructure:"client_id"`
+	// Client secret, empty for public clients
+	ClientSecret string `mapstructure:"client_secret"`
+	// Redirect URL pointing to the callback endpoint
+	RedirectURL string `mapstructure:"redirect_url"`
+	// Requested scopes
+	Scopes []string `mapstructure:"scopes"`
+	// ID token claim with role values, nested claims are separated by dots
+	RoleClaim string `mapstructure:"role_claim"`
+	// Role mapping, the first matching entry wins
+	RoleMapping []RoleMapping `mapstructure:"role_mapping"`
+}
+
+// RoleMapping maps a value of the role claim to the role name.
+type RoleMapping struct {
+	// Claim value
+	Value string `mapstructure:"value"`
+	// Role name
+	Role string `mapstructure:"role"`
+}
+
+// Client is an OpenID Connect relying party for configured providers.
+type Client struct {
+	providers  map[string]*provider
+	httpClient *http.Client
+}
+
+// provider is a configured provider with lazily loaded metadata and keys.
+type provider struct {
+	config   ProviderConfig
+	mutex    sync.RWMutex
+	metadata *metadata
+	keys     map[string]interface{}
+}
+
+// metadata is a provider discovery document.
+type metadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JWKSURI               string `json:"jwks_uri"`
+}
+
+// tokenResponse is a token endpoint response.
+type tokenResponse struct {
+	IDToken          string `json:"id_token"`
+	AccessToken      string `json:"access_token"`
+	TokenType        string `json:"token_type"`
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// New constructor for Client.
+func New(providers []ProviderConfig, timeout time.Duration) *Client {
+	client := &Client{
+		providers:  make(map[string]*provider, len(providers)),
+		httpClient: &http.Client{Timeout: timeout},
+	}
+
+	for _, config := range providers {
+		if len(config.Scopes) == 0 {
+			config.Scopes = defaultScopes
+		}
+
+		client.providers[config.Name] = &provider{config: config}
+	}
+
+	return client
+}
+
+// HasProvider checks if provider is configured.
+func (c *Client) HasProvider(name string) bool {
+	_, ok := c.providers[name]
+
+	return ok
+}
+
+// AuthCodeURL returns provider authorization URL for the authorization code flow with PKCE.
+func (c *Client) AuthCodeURL(ctx context.Context, name string, state string, nonce string, codeChallenge string) (string, error) { //nolint:lll
+	prov, ok := c.providers[name]
+	if !ok {
+		return "", ErrUnknownProvider
+	}
+
+	meta, err := c.getMetadata(ctx, prov)
+	if err != nil {
+		return "", err
+	}
+
+	authURL, err := url.Parse(meta.AuthorizationEndpoint)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid authorization endpoint")
+	}
+
+	values := authURL.Query()
+	values.Set("response_type", "code")
+	values.Set("client_id", prov.config.ClientID)
+	values.Set("redirect_uri", prov.config.RedirectURL)
+	values.Set("scope", strings.Join(prov.config.Scopes, " "))
+	values.Set("state", state)
+	values.Set("nonce", nonce)
+	values.Set("code_challenge", codeChallenge)
+	values.Set("code_challenge_method", codeChallengeMethod)
+
+	authURL.RawQuery = values.Encode()
+
+	return authURL.String(), nil
+}
+
+// Exchange redeems authorization code and returns identity from the verified ID token.
+func (c *Client) Exchange(ctx context.Context, name string, code string, codeVerifier string, nonce string) (user.ExternalIdentity, error) { //nolint:lll
+	var identity user.ExternalIdentity
+
+	prov, ok := c.providers[name]
+	if !ok {
+		return identity, ErrUnknownProvider
+	}
+
+	meta, err := c.getMetadata(ctx, prov)
+	if err != nil {
+		return identity, err
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "authorization_code")
+	form.Set("code", code)
+	form.Set("redirect_uri", prov.config.RedirectURL)
+	form.Set("client_id", prov.config.ClientID)
+	form.Set("code_verifier", codeVerifier)
+
+	if prov.config.ClientSecret != "" {
+		form.Set("client_secret", prov.config.ClientSecret)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, meta.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return identity, errors.Wrap(err, "unable to create token request")
+	}
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+
+	var response tokenResponse
+
+	if err = c.do(req, &response); err != nil {
+		if response.Error != "" {
+			return identity, errors.Wrapf(err, "%s: %s", response.Error, response.ErrorDescription)
+		}
+
+		return identity, err
+	}
+
+	if response.IDToken == "" {
+		return identity, errors.Wrap(ErrInvalidIDToken, "token response has no id token")
+	}
+
+	claims, err := c.verify(ctx, prov, meta, response.IDToken, nonce)
+	if err != nil {
+		return identity, err
+	}
+
+	identity.Provider = name
+	identity.Subject, _ = claims["sub"].(string)
+	identity.Phone, _ = claims["phone_number"].(string)
+	identity.PhoneVerified, _ = claims["phone_number_verified"].(bool)
+	identity.FirstName, _ = claims["given_name"].(string)
+	identity.LastName, _ = claims["family_name"].(string)
+	identity.RoleName = mapRole(claimValues(claims, prov.config.RoleClaim), prov.config.RoleMapping)
+
+	return identity, nil
+}
+
+// verify checks ID token signature and claims.
+func (c *Client) verify(ctx context.Context, prov *provider, meta metadata, rawToken string, nonce string) (jwt.MapClaims, error) { //nolint:lll
+	claims := jwt.MapClaims{}
+
+	_, err := jwt.ParseWithClaims(rawToken, claims, func(tkn *jwt.Token) (interface{}, error) {
+		switch tkn.Method.(type) {
+		case *jwt.SigningMethodRSA, *jwt.SigningMethodRSAPSS, *jwt.SigningMethodECDSA, *jwt.SigningMethodEd25519:
+		default:
+			return nil, errors.Wrapf(ErrInvalidIDToken, "unexpected signing method %v", tkn.Header["alg"])
+		}
+
+		kid, _ := tkn.Header["kid"].(string)
+
+		return c.getKey(ctx, prov, meta, kid)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "can not parse id token")
+	}
+
+	if !claims.VerifyIssuer(meta.Issuer, true) {
+		return nil, errors.Wrap(ErrInvalidIDToken, "issuer mismatch")
+	}
+
+	if !claims.VerifyAudience(prov.config.ClientID, true) {
+		return nil, errors.Wrap(ErrInvalidIDToken, "audience mismatch")
+	}
+
+	if !claims.VerifyExpiresAt(time.Now().Unix(), true) {
+		return nil, errors.Wrap(ErrInvalidIDToken, "token is expired")
+	}
+
+	tokenNonce, _ := claims["nonce"].(string)
+	if subtle.ConstantTimeCompare([]byte(tokenNonce), []byte(nonce)) != 1 {
+		return nil, errors.Wrap(ErrInvalidIDToken, "nonce mismatch")
+	}
+
+	if subject, _ := claims["sub"].(string); subject == "" {
+		return nil, errors.Wrap(ErrInvalidIDToken, "subject is empty")
+	}
+
+	return claims, nil
+}
+
+// getMetadata returns cached provider discovery document or loads it.
+func (c *Client) getMetadata(ctx context.Context, prov *provider) (metadata, error) {
+	prov.mutex.RLock()
+	meta := prov.metadata
+	prov.mutex.RUnlock()
+
+	if meta != nil {
+		return *meta, nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, strings.TrimSuffix(prov.config.Issuer, "/")+discoveryPath, nil)
+	if err != nil {
+		return metadata{}, errors.Wrap(err, "unable to create discovery request")
+	}
+
+	meta = &metadata{}
+
+	if err = c.do(req, meta); err != nil {
+		return metadata{}, errors.Wrap(err, "unable to load discovery document")
+	}
+
+	if meta.Issuer != strings.TrimSuffix(prov.config.Issuer, "/") && meta.Issuer != prov.config.Issuer {
+		return metadata{}, errors.Errorf("discovery issuer %q does not match configured issuer", meta.Issuer)
+	}
+
+	prov.mutex.Lock()
+	prov.metadata = meta
+	prov.mutex.Unlock()
+
+	return *meta, nil
+}
+
+// getKey returns ID token verification key, keys are reloaded if kid is unknown.
+func (c *Client) getKey(ctx context.Context, prov *provider, meta metadata, kid string) (interface{}, error) {
+	prov.mutex.RLock()
+	key, ok := findKey(prov.keys, kid)
+	prov.mutex.RUnlock()
+
+	if ok {
+		return key, nil
+	}
+
+	keys, err := c.loadKeys(ctx, meta.JWKSURI)
+	if err != nil {
+		return nil, err
+	}
+
+	prov.mutex.Lock()
+	prov.keys = keys
+	prov.mutex.Unlock()
+
+	if key, ok = findKey(keys, kid); ok {
+		return key, nil
+	}
+
+	return nil, errors.Wrapf(ErrUnknownKey, "kid %q", kid)
+}
+
+// findKey returns the key by kid, or the only key if token has no kid.
+func findKey(keys map[string]interface{}, kid string) (interface{}, bool) {
+	if kid == "" && len(keys) == 1 {
+		for _, key := range keys {
+			return key, true
+		}
+	}
+
+	key, ok := keys[kid]
+
+	return key, ok
+}
+
+// do sends the request and decodes JSON response.
+func (c *Client) do(req *http.Request, out interface{}) error {
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "identity provider request error")
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return errors.Wrap(err, "unable to read identity provider response")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		_ = json.Unmarshal(body, out)
+
+		return errors.Wrapf(ErrUnexpectedStatus, "%s %d", req.URL.Path, resp.StatusCode)
+	}
+
+	return errors.Wrap(json.Unmarshal(body, out), "unable to unmarshal identity provider response")
+}
+
+// claimValues returns string values of the claim, nested claims are separated by dots.
+func claimValues(claims jwt.MapClaims, path string) []string {
+	if path == "" {
+		return nil
+	}
+
+	var value interface{} = map[string]interface{}(claims)
+
+	for _, part := range strings.Split(path, ".") {
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+
+		value = object[part]
+	}
+
+	switch typed := value.(type) {
+	case string:
+		return strings.Fields(typed)
+	case []interface{}:
+		values := make([]string, 0, len(typed))
+
+		for _, item := range typed {
+			if str, ok := item.(string); ok {
+				values = append(values, str)
+			}
+		}
+
+		return values
+	default:
+		return nil
+	}
+}
+
+// mapRole returns role of the first mapping entry matching any of the claim values.
+func mapRole(values []string, mapping []RoleMapping) string {
+	for _, entry := range mapping {
+		for _, value := range values {
+			if value == entry.Value {
+				return entry.Role
+			}
+		}
+	}
+
+	return ""
+}
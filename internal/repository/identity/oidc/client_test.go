@@ -0,0 +1,117 @@
+package oidc
+
+import (
+	stdContext "context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/oidcmock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testProvider = "mock"
+	testClientID = "marketplace"
+	testVerifier = "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	testNonce    = "nonce"
+)
+
+func TestClient(t *testing.T) {
+	t.Parallel()
+
+	provider, err := oidcmock.New("", testClientID, "subject", map[string]interface{}{
+		"phone_number":          "+10000000001",
+		"phone_number_verified": true,
+		"given_name":            "Jane",
+		"family_name":           "Doe",
+		"groups":                []string{"staff", "admins"},
+	})
+	require.NoError(t, err)
+
+	server := httptest.NewServer(provider)
+	defer server.Close()
+
+	provider.Issuer = server.URL
+
+	client := New([]ProviderConfig{{
+		Name:        testProvider,
+		Issuer:      server.URL,
+		ClientID:    testClientID,
+		RedirectURL: "http://localhost/auth/oidc/mock/callback",
+		RoleClaim:   "groups",
+		RoleMapping: []RoleMapping{{Value: "admins", Role: "admin"}, {Value: "staff", Role: "operator"}},
+	}}, time.Second)
+
+	ctx := context.New(stdContext.Background())
+
+	t.Run("exchange", func(t *testing.T) {
+		code := authorize(t, client, ctx, "state")
+
+		identity, err := client.Exchange(ctx, testProvider, code, testVerifier, testNonce)
+		require.NoError(t, err)
+
+		assert.Equal(t, testProvider, identity.Provider)
+		assert.Equal(t, "subject", identity.Subject)
+		assert.Equal(t, "+10000000001", identity.Phone)
+		assert.True(t, identity.PhoneVerified)
+		assert.Equal(t, "Jane", identity.FirstName)
+		assert.Equal(t, "Doe", identity.LastName)
+		assert.Equal(t, "admin", identity.RoleName)
+
+		_, err = client.Exchange(ctx, testProvider, code, testVerifier, testNonce)
+		assert.ErrorIs(t, err, ErrUnexpectedStatus)
+	})
+
+	t.Run("wrongVerifier", func(t *testing.T) {
+		code := authorize(t, client, ctx, "state")
+
+		_, err := client.Exchange(ctx, testProvider, code, "wrong", testNonce)
+		assert.ErrorIs(t, err, ErrUnexpectedStatus)
+	})
+
+	t.Run("wrongNonce", func(t *testing.T) {
+		code := authorize(t, client, ctx, "state")
+
+		_, err := client.Exchange(ctx, testProvider, code, testVerifier, "wrong")
+		assert.ErrorIs(t, err, ErrInvalidIDToken)
+	})
+
+	t.Run("unknownProvider", func(t *testing.T) {
+		assert.False(t, client.HasProvider("unknown"))
+
+		_, err := client.AuthCodeURL(ctx, "unknown", "state", testNonce, "challenge")
+		assert.ErrorIs(t, err, ErrUnknownProvider)
+	})
+}
+
+// authorize follows the mock provider authorization redirect and returns the code.
+func authorize(t *testing.T, client *Client, ctx context.Context, state string) string {
+	t.Helper()
+
+	challenge := sha256.Sum256([]byte(testVerifier))
+
+	authURL, err := client.AuthCodeURL(ctx, testProvider, state, testNonce, base64.RawURLEncoding.EncodeToString(challenge[:]))
+	require.NoError(t, err)
+
+	httpClient := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+
+	resp, err := httpClient.Get(authURL) //nolint:noctx
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusFound, resp.StatusCode)
+
+	location, err := url.Parse(resp.Header.Get("Location"))
+	require.NoError(t, err)
+	require.Equal(t, state, location.Query().Get("state"))
+
+	return location.Query().Get("code")
+}
@@ -0,0 +1,46 @@
+package policy
+
+import (
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/pkg/errors"
+)
+
+// AssignmentLoader loads roles of users in organizations.
+type AssignmentLoader interface {
+	AuthorizationGetRoleAssignments(ctx context.Context) ([]role.Assignment, error)
+}
+
+// DomainAdapter loads the policy rules from the base adapter and groups users into organization roles.
+type DomainAdapter struct {
+	persist.Adapter
+	loader AssignmentLoader
+}
+
+// NewDomainAdapter constructor for DomainAdapter.
+func NewDomainAdapter(adapter persist.Adapter, loader AssignmentLoader) *DomainAdapter {
+	return &DomainAdapter{Adapter: adapter, loader: loader}
+}
+
+// LoadPolicy loads the policy rules and role assignments of users in organizations.
+func (a *DomainAdapter) LoadPolicy(mdl model.Model) error {
+	if err := a.Adapter.LoadPolicy(mdl); err != nil {
+		return errors.Wrap(err, "failed to load rules")
+	}
+
+	assignments, err := a.loader.AuthorizationGetRoleAssignments(context.Empty())
+	if err != nil {
+		return errors.Wrap(err, "failed to load role assignments")
+	}
+
+	for _, assignment := range assignments {
+		err = persist.LoadPolicyArray([]string{"g", assignment.UserID, assignment.RoleName, assignment.OrganizationID}, mdl)
+		if err != nil {
+			return errors.Wrap(err, "failed to load role assignment")
+		}
+	}
+
+	return nil
+}
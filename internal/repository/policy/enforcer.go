@@ -0,0 +1,251 @@
+package policy
+
+import (
+	stdContext "context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/casbin/casbin/v2"
+	"github.com/casbin/casbin/v2/model"
+	"github.com/casbin/casbin/v2/persist"
+	"github.com/casbin/casbin/v2/util"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// allDomains is a domain of role groupings applied in all organizations.
+const allDomains = "*"
+
+// Enforcer is a shared casbin enforcer, the policy is loaded once and reloaded on changes.
+// A reload builds a new casbin enforcer which replaces the current one, so enforcement is never blocked.
+type Enforcer struct {
+	enforcer    atomic.Pointer[casbin.Enforcer]
+	modelPath   string
+	adapter     persist.Adapter
+	watcher     persist.Watcher
+	reloadMutex sync.Mutex
+	isTracingOn bool
+}
+
+// New constructor for Enforcer, watcher notifies other instances about policy changes and may be nil.
+func New(modelPath string, adapter persist.Adapter, watcher persist.Watcher, isTracingOn bool) (*Enforcer, error) {
+	enf := &Enforcer{modelPath: modelPath, adapter: adapter, watcher: watcher, isTracingOn: isTracingOn}
+
+	enforcer, err := enf.build()
+	if err != nil {
+		return nil, err
+	}
+
+	enf.enforcer.Store(enforcer)
+
+	if watcher != nil {
+		err = watcher.SetUpdateCallback(func(string) {
+			if err := enf.load(reloadSourceRemote); err != nil {
+				logger.Logger.Error("unable to reload policy", zap.String("error", err.Error()))
+			}
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to set watcher callback")
+		}
+	}
+
+	return enf, nil
+}
+
+// Enforce checks if the subject may take the action on the object in the organization.
+func (e *Enforcer) Enforce(ctx context.Context, sub string, dom string, obj string, act string) (bool, error) {
+	if e.isTracingOn {
+		_, span := tracing.Tracer.Start(ctx, "Policy.Enforce")
+		defer span.End()
+	}
+
+	start := time.Now()
+	ok, err := e.enforcer.Load().Enforce(sub, dom, obj, act)
+
+	enforceDuration.Observe(time.Since(start).Seconds())
+
+	return ok, errors.Wrap(err, "failed enforcing")
+}
+
+// GetDomainRoles returns roles of the user in the organization.
+func (e *Enforcer) GetDomainRoles(userID string, dom string) []string {
+	return e.enforcer.Load().GetRolesForUserInDomain(userID, dom)
+}
+
+// GetUserDomains returns organizations the user has a role in.
+func (e *Enforcer) GetUserDomains(userID string) []string {
+	var domains []string
+
+	for _, grouping := range e.enforcer.Load().GetFilteredGroupingPolicy(0, userID) {
+		if len(grouping) > 2 && grouping[2] != allDomains {
+			domains = append(domains, grouping[2])
+		}
+	}
+
+	return domains
+}
+
+// GetRoles returns roles the role inherits, directly or through other roles.
+func (e *Enforcer) GetRoles(role string) ([]string, error) {
+	roles, err := e.enforcer.Load().GetImplicitRolesForUser(role, allDomains)
+
+	return roles, errors.Wrap(err, "failed to get inherited roles")
+}
+
+// GetPermissions returns rules of the role and roles it inherits.
+func (e *Enforcer) GetPermissions(role string) ([][]string, error) {
+	enforcer := e.enforcer.Load()
+
+	roles, err := enforcer.GetImplicitRolesForUser(role, allDomains)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get inherited roles")
+	}
+
+	var permissions [][]string
+
+	for _, name := range append([]string{role}, roles...) {
+		permissions = append(permissions, enforcer.GetFilteredPolicy(0, name)...)
+	}
+
+	return permissions, nil
+}
+
+// GetPolicies returns all policy rules.
+func (e *Enforcer) GetPolicies() [][]string {
+	return e.enforcer.Load().GetPolicy()
+}
+
+// Evaluate checks if the role may take the action on the object with the given rules instead of the current policy.
+func (e *Enforcer) Evaluate(ctx context.Context, rules []rule.PolicyRule, role string, obj string, act string) (rule.Evaluation, error) { //nolint:lll
+	if e.isTracingOn {
+		_, span := tracing.Tracer.Start(ctx, "Policy.Evaluate")
+		defer span.End()
+	}
+
+	var evaluation rule.Evaluation
+
+	mdl, err := model.NewModelFromFile(e.modelPath)
+	if err != nil {
+		return evaluation, errors.Wrap(err, "failed to load model")
+	}
+
+	for _, rle := range rules {
+		if err = persist.LoadPolicyArray(rle.Fields(), mdl); err != nil {
+			return evaluation, errors.Wrap(err, "failed to load rule")
+		}
+	}
+
+	enforcer, err := casbin.NewEnforcer(mdl)
+	if err != nil {
+		return evaluation, errors.Wrap(err, "failed to create enforcer")
+	}
+
+	enforcer.AddNamedDomainMatchingFunc("g", "keyMatch", util.KeyMatch)
+
+	if err = enforcer.BuildRoleLinks(); err != nil {
+		return evaluation, errors.Wrap(err, "failed to build role links")
+	}
+
+	if evaluation.Allowed, err = enforcer.Enforce(role, allDomains, obj, act); err != nil {
+		return evaluation, errors.Wrap(err, "failed enforcing")
+	}
+
+	if evaluation.Roles, err = enforcer.GetImplicitRolesForUser(role, allDomains); err != nil {
+		return evaluation, errors.Wrap(err, "failed to get inherited roles")
+	}
+
+	subjects := map[string]bool{role: true}
+	for _, name := range evaluation.Roles {
+		subjects[name] = true
+	}
+
+	// rules are matched the way the model matcher does
+	for _, rle := range rules {
+		if rle.Ptype == "p" && subjects[rle.V0] && util.KeyMatch(obj, rle.V1) && util.RegexMatch(act, rle.V2) {
+			evaluation.Matched = append(evaluation.Matched, rule.MatchedRule{Rule: rle})
+		}
+	}
+
+	return evaluation, nil
+}
+
+// Reload reloads the policy from database and notifies other instances.
+func (e *Enforcer) Reload(ctx context.Context) error {
+	if e.isTracingOn {
+		_, span := tracing.Tracer.Start(ctx, "Policy.Reload")
+		defer span.End()
+	}
+
+	if err := e.load(reloadSourceLocal); err != nil {
+		return err
+	}
+
+	if e.watcher == nil {
+		return nil
+	}
+
+	return errors.Wrap(e.watcher.Update(), "failed to notify other instances")
+}
+
+// Run reloads the policy periodically until ctx is done, in case a change notification was missed.
+func (e *Enforcer) Run(ctx stdContext.Context, interval time.Duration) {
+	if interval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := e.load(reloadSourcePeriodic); err != nil {
+				logger.Logger.Error("unable to reload policy", zap.String("error", err.Error()))
+			}
+		}
+	}
+}
+
+// Close stops the watcher.
+func (e *Enforcer) Close() {
+	if e.watcher != nil {
+		e.watcher.Close()
+	}
+}
+
+// load loads the policy without blocking enforcement, concurrent reloads are serialized.
+func (e *Enforcer) load(source string) error {
+	e.reloadMutex.Lock()
+	defer e.reloadMutex.Unlock()
+
+	enforcer, err := e.build()
+	if err != nil {
+		policyReloads.WithLabelValues(source, reloadResultError).Inc()
+
+		return err
+	}
+
+	e.enforcer.Store(enforcer)
+	policyReloads.WithLabelValues(source, reloadResultOK).Inc()
+
+	return nil
+}
+
+// build creates casbin enforcer with the current policy, roles granted in the "*" organization apply in all of them.
+func (e *Enforcer) build() (*casbin.Enforcer, error) {
+	enforcer, err := casbin.NewEnforcer(e.modelPath, e.adapter)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load policy")
+	}
+
+	enforcer.AddNamedDomainMatchingFunc("g", "keyMatch", util.KeyMatch)
+
+	return enforcer, nil
+}
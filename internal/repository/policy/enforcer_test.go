@@ -0,0 +1,198 @@
+package policy
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	fileadapter "github.com/casbin/casbin/v2/persist/file-adapter"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/stretchr/testify/require"
+)
+
+// stubWatcher counts notifications and lets the test trigger remote updates.
+type stubWatcher struct {
+	callback func(string)
+	updates  int
+}
+
+func (w *stubWatcher) SetUpdateCallback(callback func(string)) error {
+	w.callback = callback
+
+	return nil
+}
+
+func (w *stubWatcher) Update() error {
+	w.updates++
+
+	return nil
+}
+
+func (w *stubWatcher) Close() {}
+
+func TestEnforcerReload(t *testing.T) {
+	_ = logger.InitLogger()
+
+	dir := t.TempDir()
+	policyPath := filepath.Join(dir, "policy.csv")
+
+	writePolicy := func(policy string) {
+		require.NoError(t, os.WriteFile(policyPath, []byte(policy), 0o600))
+	}
+
+	writePolicy("p, vendor, items, get, allow\n")
+
+	watcher := &stubWatcher{}
+
+	enforcer, err := New("../../../configs/rbac_model.conf", fileadapter.NewAdapter(policyPath), watcher, false)
+	require.NoError(t, err)
+
+	ctx := context.Empty()
+
+	allowed, err := enforcer.Enforce(ctx, "vendor", "", "items", "get")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	writePolicy("p, vendor, items, get, deny\n")
+
+	// the policy is cached until reloaded
+	allowed, err = enforcer.Enforce(ctx, "vendor", "", "items", "get")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	require.NoError(t, enforcer.Reload(ctx))
+	require.Equal(t, 1, watcher.updates)
+
+	allowed, err = enforcer.Enforce(ctx, "vendor", "", "items", "get")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	// notification of another instance reloads the policy without notifying again
+	writePolicy("p, vendor, items, get, allow\n")
+	watcher.callback("instance")
+	require.Equal(t, 1, watcher.updates)
+
+	allowed, err = enforcer.Enforce(ctx, "vendor", "", "items", "get")
+	require.NoError(t, err)
+	require.True(t, allowed)
+}
+
+func TestEnforcerDomainRoles(t *testing.T) {
+	_ = logger.InitLogger()
+
+	policyPath := filepath.Join(t.TempDir(), "policy.csv")
+	policy := "p, customer, items, get, deny\n" +
+		"p, vendor, items, get, allow\n" +
+		"g, user, vendor, organization\n"
+
+	require.NoError(t, os.WriteFile(policyPath, []byte(policy), 0o600))
+
+	enforcer, err := New("../../../configs/rbac_model.conf", fileadapter.NewAdapter(policyPath), nil, false)
+	require.NoError(t, err)
+
+	ctx := context.Empty()
+
+	require.Equal(t, []string{"vendor"}, enforcer.GetDomainRoles("user", "organization"))
+	require.Empty(t, enforcer.GetDomainRoles("user", "other"))
+	require.Equal(t, []string{"organization"}, enforcer.GetUserDomains("user"))
+	require.Empty(t, enforcer.GetUserDomains("vendor"))
+
+	allowed, err := enforcer.Enforce(ctx, "user", "organization", "items", "get")
+	require.NoError(t, err)
+	require.True(t, allowed)
+
+	allowed, err = enforcer.Enforce(ctx, "user", "other", "items", "get")
+	require.NoError(t, err)
+	require.False(t, allowed)
+
+	allowed, err = enforcer.Enforce(ctx, "customer", "organization", "items", "get")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
+
+func TestEnforcerRoleHierarchy(t *testing.T) {
+	_ = logger.InitLogger()
+
+	policyPath := filepath.Join(t.TempDir(), "policy.csv")
+	policy := "p, operator, orders, get, allow\n" +
+		"p, vendor, apikeys, get, allow\n" +
+		"p, admin, rules, get, allow\n" +
+		"g, vendor, operator, *\n" +
+		"g, admin, vendor, *\n" +
+		"g, user, vendor, organization\n"
+
+	require.NoError(t, os.WriteFile(policyPath, []byte(policy), 0o600))
+
+	enforcer, err := New("../../../configs/rbac_model.conf", fileadapter.NewAdapter(policyPath), nil, false)
+	require.NoError(t, err)
+
+	ctx := context.Empty()
+
+	tests := []struct {
+		sub     string
+		dom     string
+		obj     string
+		allowed bool
+	}{
+		{sub: "admin", obj: "orders", allowed: true},
+		{sub: "admin", obj: "rules", allowed: true},
+		{sub: "vendor", obj: "orders", allowed: true},
+		{sub: "vendor", obj: "rules", allowed: false},
+		{sub: "operator", obj: "apikeys", allowed: false},
+		{sub: "user", dom: "organization", obj: "orders", allowed: true},
+		{sub: "user", dom: "organization", obj: "rules", allowed: false},
+		{sub: "user", dom: "other", obj: "orders", allowed: false},
+	}
+
+	for _, test := range tests {
+		allowed, err := enforcer.Enforce(ctx, test.sub, test.dom, test.obj, "get")
+		require.NoError(t, err)
+		require.Equal(t, test.allowed, allowed, "%s in %q on %s", test.sub, test.dom, test.obj)
+	}
+
+	roles, err := enforcer.GetRoles("admin")
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"vendor", "operator"}, roles)
+
+	permissions, err := enforcer.GetPermissions("vendor")
+	require.NoError(t, err)
+	require.ElementsMatch(t, [][]string{{"vendor", "apikeys", "get", "allow"}, {"operator", "orders", "get", "allow"}}, permissions)
+
+	require.Equal(t, []string{"vendor"}, enforcer.GetDomainRoles("user", "organization"))
+}
+
+func TestEnforcerEvaluate(t *testing.T) {
+	_ = logger.InitLogger()
+
+	policyPath := filepath.Join(t.TempDir(), "policy.csv")
+	require.NoError(t, os.WriteFile(policyPath, []byte("p, admin, rules, get, allow\n"), 0o600))
+
+	enforcer, err := New("../../../configs/rbac_model.conf", fileadapter.NewAdapter(policyPath), nil, false)
+	require.NoError(t, err)
+
+	allow := rule.PolicyRule{Ptype: "p", V0: "operator", V1: "orders", V2: "get", V3: "allow"}
+	deny := rule.PolicyRule{Ptype: "p", V0: "vendor", V1: "orders", V2: "get", V3: "deny"}
+	rules := []rule.PolicyRule{
+		allow,
+		{Ptype: "p", V0: "vendor", V1: "items", V2: "get", V3: "allow"},
+		{Ptype: "g", V0: "vendor", V1: "operator", V2: "*"},
+	}
+
+	evaluation, err := enforcer.Evaluate(context.Empty(), rules, "vendor", "orders", "get")
+	require.NoError(t, err)
+	require.True(t, evaluation.Allowed)
+	require.Equal(t, []string{"operator"}, evaluation.Roles)
+	require.Equal(t, rule.ListMatchedRule{{Rule: allow}}, evaluation.Matched)
+
+	evaluation, err = enforcer.Evaluate(context.Empty(), append(rules, deny), "vendor", "orders", "get")
+	require.NoError(t, err)
+	require.False(t, evaluation.Allowed)
+	require.Len(t, evaluation.Matched, 2)
+
+	// the current policy is not changed
+	allowed, err := enforcer.Enforce(context.Empty(), "vendor", "", "orders", "get")
+	require.NoError(t, err)
+	require.False(t, allowed)
+}
@@ -0,0 +1,30 @@
+package policy
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	reloadSourceLocal    = "local"
+	reloadSourceRemote   = "remote"
+	reloadSourcePeriodic = "periodic"
+
+	reloadResultOK    = "ok"
+	reloadResultError = "error"
+)
+
+// enforceDuration observes policy enforcement latency.
+var enforceDuration = promauto.NewHistogram(prometheus.HistogramOpts{
+	Namespace: "marketplace",
+	Name:      "authorization_enforce_duration_seconds",
+	Help:      "Latency of the policy enforcement.",
+	Buckets:   prometheus.ExponentialBuckets(0.00001, 4, 8), //nolint:gomnd
+})
+
+// policyReloads counts policy reloads by source and result.
+var policyReloads = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "marketplace",
+	Name:      "authorization_policy_reloads_total",
+	Help:      "Number of policy reloads.",
+}, []string{"source", "result"})
@@ -0,0 +1,96 @@
+package policy
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/redis/go-redis/v9"
+)
+
+const instanceIDLength = 16
+
+// Watcher is a casbin watcher notifying API instances about policy changes through Redis pub/sub.
+type Watcher struct {
+	client     *redis.Client
+	pubSub     *redis.PubSub
+	channel    string
+	instanceID string
+	timeout    time.Duration
+	mutex      sync.RWMutex
+	callback   func(string)
+}
+
+// NewWatcher constructor for Watcher, it subscribes to the channel until Close is called.
+func NewWatcher(ctx context.Context, client *redis.Client, channel string, timeout time.Duration) (*Watcher, error) {
+	bytes := make([]byte, instanceIDLength)
+
+	if _, err := rand.Read(bytes); err != nil {
+		return nil, errors.Wrap(err, "can not generate instance id")
+	}
+
+	pubSub := client.Subscribe(ctx, channel)
+
+	// wait for the subscription confirmation, so no notification sent after start is lost
+	if _, err := pubSub.Receive(ctx); err != nil {
+		_ = pubSub.Close()
+
+		return nil, errors.Wrap(err, "unable to subscribe to policy updates")
+	}
+
+	watcher := &Watcher{
+		client:     client,
+		pubSub:     pubSub,
+		channel:    channel,
+		instanceID: hex.EncodeToString(bytes),
+		timeout:    timeout,
+	}
+
+	go watcher.listen()
+
+	return watcher, nil
+}
+
+// SetUpdateCallback sets the function called when another instance has changed the policy.
+func (w *Watcher) SetUpdateCallback(callback func(string)) error {
+	w.mutex.Lock()
+	w.callback = callback
+	w.mutex.Unlock()
+
+	return nil
+}
+
+// Update notifies other instances that the policy has been changed.
+func (w *Watcher) Update() error {
+	ctx, cancel := context.WithTimeout(context.Background(), w.timeout)
+	defer cancel()
+
+	err := w.client.Publish(ctx, w.channel, w.instanceID).Err()
+
+	return errors.Wrap(err, "unable to publish policy update")
+}
+
+// Close stops listening for notifications.
+func (w *Watcher) Close() {
+	_ = w.pubSub.Close()
+}
+
+// listen calls the callback for notifications of other instances, own notifications are skipped.
+func (w *Watcher) listen() {
+	for message := range w.pubSub.Channel() {
+		if message.Payload == w.instanceID {
+			continue
+		}
+
+		w.mutex.RLock()
+		callback := w.callback
+		w.mutex.RUnlock()
+
+		if callback != nil {
+			callback(message.Payload)
+		}
+	}
+}
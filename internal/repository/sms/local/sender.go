@@ -0,0 +1,52 @@
+package local
+
+import (
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const filePermissions = 0o600
+
+// Sender is a development SMS sender which writes messages to the log and, if configured, to a local file.
+type Sender struct {
+	file  string
+	mutex sync.Mutex
+}
+
+// New constructor for Sender.
+func New(file string) *Sender {
+	return &Sender{file: file}
+}
+
+// Send writes SMS message.
+func (s *Sender) Send(_ context.Context, phone string, message string) error {
+	logger.Logger.Info("sms message", zap.String("phone", phone), zap.String("message", message))
+
+	if s.file == "" {
+		return nil
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	file, err := os.OpenFile(s.file, os.O_APPEND|os.O_CREATE|os.O_WRONLY, filePermissions)
+	if err != nil {
+		return errors.Wrap(err, "unable to open sms file")
+	}
+
+	_, err = fmt.Fprintf(file, "%s\t%s\t%s\n", time.Now().UTC().Format(time.RFC3339), phone, message)
+	if err != nil {
+		_ = file.Close()
+
+		return errors.Wrap(err, "unable to write sms file")
+	}
+
+	return errors.Wrap(file.Close(), "unable to close sms file")
+}
@@ -6,6 +6,8 @@ import (
 	context "github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	mock "github.com/stretchr/testify/mock"
 
+	token "github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+
 	user "github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
 )
 
@@ -14,22 +16,98 @@ type Authentication struct {
 	mock.Mock
 }
 
+// AuthenticationActivateUser provides a mock function with given fields: ctx, phone
+func (_m *Authentication) AuthenticationActivateUser(ctx context.Context, phone string) error {
+	ret := _m.Called(ctx, phone)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, phone)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationCreateExternalUser provides a mock function with given fields: ctx, input, roleName, provider, subject
+func (_m *Authentication) AuthenticationCreateExternalUser(ctx context.Context, input user.CreateUserInput, roleName string, provider string, subject string) (string, error) {
+	ret := _m.Called(ctx, input, roleName, provider, subject)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, user.CreateUserInput, string, string, string) (string, error)); ok {
+		return rf(ctx, input, roleName, provider, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, user.CreateUserInput, string, string, string) string); ok {
+		r0 = rf(ctx, input, roleName, provider, subject)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, user.CreateUserInput, string, string, string) error); ok {
+		r1 = rf(ctx, input, roleName, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationCreateSigningKey provides a mock function with given fields: ctx, key
+func (_m *Authentication) AuthenticationCreateSigningKey(ctx context.Context, key token.SigningKey) (bool, error) {
+	ret := _m.Called(ctx, key)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, token.SigningKey) (bool, error)); ok {
+		return rf(ctx, key)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, token.SigningKey) bool); ok {
+		r0 = rf(ctx, key)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, token.SigningKey) error); ok {
+		r1 = rf(ctx, key)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationCreateTokenHash provides a mock function with given fields: ctx, userID, hash, session
+func (_m *Authentication) AuthenticationCreateTokenHash(ctx context.Context, userID string, hash string, session token.SessionInput) error {
+	ret := _m.Called(ctx, userID, hash, session)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, token.SessionInput) error); ok {
+		r0 = rf(ctx, userID, hash, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AuthenticationCreateUser provides a mock function with given fields: ctx, input
-func (_m *Authentication) AuthenticationCreateUser(ctx context.Context, input user.CreateUserInput) (string, error) {
+func (_m *Authentication) AuthenticationCreateUser(ctx context.Context, input user.SignUpInput) (string, error) {
 	ret := _m.Called(ctx, input)
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, user.CreateUserInput) (string, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, user.SignUpInput) (string, error)); ok {
 		return rf(ctx, input)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, user.CreateUserInput) string); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, user.SignUpInput) string); ok {
 		r0 = rf(ctx, input)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, user.CreateUserInput) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, user.SignUpInput) error); ok {
 		r1 = rf(ctx, input)
 	} else {
 		r1 = ret.Error(1)
@@ -38,6 +116,182 @@ func (_m *Authentication) AuthenticationCreateUser(ctx context.Context, input us
 	return r0, r1
 }
 
+// AuthenticationDisableTwoFactor provides a mock function with given fields: ctx, userID
+func (_m *Authentication) AuthenticationDisableTwoFactor(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationEnableTwoFactor provides a mock function with given fields: ctx, userID, codeHashes
+func (_m *Authentication) AuthenticationEnableTwoFactor(ctx context.Context, userID string, codeHashes []string) error {
+	ret := _m.Called(ctx, userID, codeHashes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, userID, codeHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationGetIdentityUser provides a mock function with given fields: ctx, provider, subject
+func (_m *Authentication) AuthenticationGetIdentityUser(ctx context.Context, provider string, subject string) (string, error) {
+	ret := _m.Called(ctx, provider, subject)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, provider, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, provider, subject)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, provider, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationGetSessions provides a mock function with given fields: ctx, userID
+func (_m *Authentication) AuthenticationGetSessions(ctx context.Context, userID string) ([]token.Session, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 []token.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]token.Session, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []token.Session); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]token.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationGetSigningKeys provides a mock function with given fields: ctx
+func (_m *Authentication) AuthenticationGetSigningKeys(ctx context.Context) ([]token.SigningKey, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []token.SigningKey
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]token.SigningKey, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []token.SigningKey); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]token.SigningKey)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationGetTokenFamily provides a mock function with given fields: ctx, userID, hash
+func (_m *Authentication) AuthenticationGetTokenFamily(ctx context.Context, userID string, hash string) (string, error) {
+	ret := _m.Called(ctx, userID, hash)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, userID, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, userID, hash)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationGetTokenHash provides a mock function with given fields: ctx, userID, hash
+func (_m *Authentication) AuthenticationGetTokenHash(ctx context.Context, userID string, hash string) (string, error) {
+	ret := _m.Called(ctx, userID, hash)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, userID, hash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, userID, hash)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, hash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationGetTwoFactor provides a mock function with given fields: ctx, userID
+func (_m *Authentication) AuthenticationGetTwoFactor(ctx context.Context, userID string) (user.TwoFactor, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 user.TwoFactor
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.TwoFactor, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.TwoFactor); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(user.TwoFactor)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // AuthenticationGetUser provides a mock function with given fields: ctx, id, username
 func (_m *Authentication) AuthenticationGetUser(ctx context.Context, id string, username string) (user.User, error) {
 	ret := _m.Called(ctx, id, username)
@@ -62,6 +316,180 @@ func (_m *Authentication) AuthenticationGetUser(ctx context.Context, id string,
 	return r0, r1
 }
 
+// AuthenticationIsMember provides a mock function with given fields: ctx, userID
+func (_m *Authentication) AuthenticationIsMember(ctx context.Context, userID string) (bool, error) {
+	ret := _m.Called(ctx, userID)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (bool, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) bool); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationLinkIdentity provides a mock function with given fields: ctx, userID, provider, subject
+func (_m *Authentication) AuthenticationLinkIdentity(ctx context.Context, userID string, provider string, subject string) error {
+	ret := _m.Called(ctx, userID, provider, subject)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userID, provider, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationRehashPassword provides a mock function with given fields: ctx, userID, oldHash, newHash
+func (_m *Authentication) AuthenticationRehashPassword(ctx context.Context, userID string, oldHash string, newHash string) error {
+	ret := _m.Called(ctx, userID, oldHash, newHash)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, userID, oldHash, newHash)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationReplaceBackupCodes provides a mock function with given fields: ctx, userID, codeHashes
+func (_m *Authentication) AuthenticationReplaceBackupCodes(ctx context.Context, userID string, codeHashes []string) error {
+	ret := _m.Called(ctx, userID, codeHashes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, userID, codeHashes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationRevokeSession provides a mock function with given fields: ctx, userID, sessionID
+func (_m *Authentication) AuthenticationRevokeSession(ctx context.Context, userID string, sessionID string) error {
+	ret := _m.Called(ctx, userID, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationRevokeSessions provides a mock function with given fields: ctx, userID
+func (_m *Authentication) AuthenticationRevokeSessions(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationRevokeTokenFamily provides a mock function with given fields: ctx, tokenID
+func (_m *Authentication) AuthenticationRevokeTokenFamily(ctx context.Context, tokenID string) error {
+	ret := _m.Called(ctx, tokenID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, tokenID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationSetTwoFactorSecret provides a mock function with given fields: ctx, userID, secret
+func (_m *Authentication) AuthenticationSetTwoFactorSecret(ctx context.Context, userID string, secret string) error {
+	ret := _m.Called(ctx, userID, secret)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, secret)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationUpdatePassword provides a mock function with given fields: ctx, userID, password
+func (_m *Authentication) AuthenticationUpdatePassword(ctx context.Context, userID string, password string) error {
+	ret := _m.Called(ctx, userID, password)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, userID, password)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationUpdateTokenHash provides a mock function with given fields: ctx, tokenID, previousHash, hash, session
+func (_m *Authentication) AuthenticationUpdateTokenHash(ctx context.Context, tokenID string, previousHash string, hash string, session token.SessionInput) error {
+	ret := _m.Called(ctx, tokenID, previousHash, hash, session)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, token.SessionInput) error); ok {
+		r0 = rf(ctx, tokenID, previousHash, hash, session)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationUseBackupCode provides a mock function with given fields: ctx, userID, codeHash
+func (_m *Authentication) AuthenticationUseBackupCode(ctx context.Context, userID string, codeHash string) (bool, error) {
+	ret := _m.Called(ctx, userID, codeHash)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (bool, error)); ok {
+		return rf(ctx, userID, codeHash)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) bool); ok {
+		r0 = rf(ctx, userID, codeHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, userID, codeHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewAuthentication interface {
 	mock.TestingT
 	Cleanup(func())
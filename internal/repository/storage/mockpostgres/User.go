@@ -134,6 +134,20 @@ func (_m *User) UserGetOne(ctx context.Context, meta query.MetaData, userID stri
 	return r0, r1
 }
 
+// UserSetStatus provides a mock function with given fields: ctx, meta, userID, status, input
+func (_m *User) UserSetStatus(ctx context.Context, meta query.MetaData, userID string, status string, input user.ChangeStatusInput) error {
+	ret := _m.Called(ctx, meta, userID, status, input)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, query.MetaData, string, string, user.ChangeStatusInput) error); ok {
+		r0 = rf(ctx, meta, userID, status, input)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UserUpdate provides a mock function with given fields: ctx, meta, input
 func (_m *User) UserUpdate(ctx context.Context, meta query.MetaData, input user.UpdateUserInput) error {
 	ret := _m.Called(ctx, meta, input)
@@ -2,13 +2,310 @@
 
 package mockCache
 
-import mock "github.com/stretchr/testify/mock"
+import (
+	context "github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+
+	user "github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+)
 
 // Authentication is an autogenerated mock type for the Authentication type
 type Authentication struct {
 	mock.Mock
 }
 
+// AuthenticationDeleteChallenge provides a mock function with given fields: ctx, challenge
+func (_m *Authentication) AuthenticationDeleteChallenge(ctx context.Context, challenge string) error {
+	ret := _m.Called(ctx, challenge)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, challenge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationDeleteOTP provides a mock function with given fields: ctx, purpose, phone, resetAttempts
+func (_m *Authentication) AuthenticationDeleteOTP(ctx context.Context, purpose string, phone string, resetAttempts bool) error {
+	ret := _m.Called(ctx, purpose, phone, resetAttempts)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, bool) error); ok {
+		r0 = rf(ctx, purpose, phone, resetAttempts)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationGetChallenge provides a mock function with given fields: ctx, challenge
+func (_m *Authentication) AuthenticationGetChallenge(ctx context.Context, challenge string) (string, error) {
+	ret := _m.Called(ctx, challenge)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, challenge)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, challenge)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, challenge)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationGetLoginLock provides a mock function with given fields: ctx, subject
+func (_m *Authentication) AuthenticationGetLoginLock(ctx context.Context, subject string) (time.Duration, error) {
+	ret := _m.Called(ctx, subject)
+
+	var r0 time.Duration
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (time.Duration, error)); ok {
+		return rf(ctx, subject)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) time.Duration); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, subject)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationGetOTP provides a mock function with given fields: ctx, purpose, phone
+func (_m *Authentication) AuthenticationGetOTP(ctx context.Context, purpose string, phone string) (string, error) {
+	ret := _m.Called(ctx, purpose, phone)
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (string, error)); ok {
+		return rf(ctx, purpose, phone)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) string); ok {
+		r0 = rf(ctx, purpose, phone)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, purpose, phone)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationIncrLoginFailures provides a mock function with given fields: ctx, subject, window
+func (_m *Authentication) AuthenticationIncrLoginFailures(ctx context.Context, subject string, window time.Duration) (int64, error) {
+	ret := _m.Called(ctx, subject, window)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) (int64, error)); ok {
+		return rf(ctx, subject, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) int64); ok {
+		r0 = rf(ctx, subject, window)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, time.Duration) error); ok {
+		r1 = rf(ctx, subject, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationIncrOTPAttempts provides a mock function with given fields: ctx, purpose, phone, window
+func (_m *Authentication) AuthenticationIncrOTPAttempts(ctx context.Context, purpose string, phone string, window time.Duration) (int64, error) {
+	ret := _m.Called(ctx, purpose, phone, window)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (int64, error)); ok {
+		return rf(ctx, purpose, phone, window)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) int64); ok {
+		r0 = rf(ctx, purpose, phone, window)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, purpose, phone, window)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationLockLogin provides a mock function with given fields: ctx, subject, ttl
+func (_m *Authentication) AuthenticationLockLogin(ctx context.Context, subject string, ttl time.Duration) error {
+	ret := _m.Called(ctx, subject, ttl)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, time.Duration) error); ok {
+		r0 = rf(ctx, subject, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationLockOTPResend provides a mock function with given fields: ctx, purpose, phone, ttl
+func (_m *Authentication) AuthenticationLockOTPResend(ctx context.Context, purpose string, phone string, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, purpose, phone, ttl)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) (bool, error)); ok {
+		return rf(ctx, purpose, phone, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) bool); ok {
+		r0 = rf(ctx, purpose, phone, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Duration) error); ok {
+		r1 = rf(ctx, purpose, phone, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationPopOIDCState provides a mock function with given fields: ctx, state
+func (_m *Authentication) AuthenticationPopOIDCState(ctx context.Context, state string) (user.OIDCState, error) {
+	ret := _m.Called(ctx, state)
+
+	var r0 user.OIDCState
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (user.OIDCState, error)); ok {
+		return rf(ctx, state)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) user.OIDCState); ok {
+		r0 = rf(ctx, state)
+	} else {
+		r0 = ret.Get(0).(user.OIDCState)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, state)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AuthenticationResetLoginFailures provides a mock function with given fields: ctx, subject
+func (_m *Authentication) AuthenticationResetLoginFailures(ctx context.Context, subject string) error {
+	ret := _m.Called(ctx, subject)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, subject)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationSetChallenge provides a mock function with given fields: ctx, challenge, userID, ttl
+func (_m *Authentication) AuthenticationSetChallenge(ctx context.Context, challenge string, userID string, ttl time.Duration) error {
+	ret := _m.Called(ctx, challenge, userID, ttl)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, challenge, userID, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationSetOIDCState provides a mock function with given fields: ctx, state, value, ttl
+func (_m *Authentication) AuthenticationSetOIDCState(ctx context.Context, state string, value user.OIDCState, ttl time.Duration) error {
+	ret := _m.Called(ctx, state, value, ttl)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, user.OIDCState, time.Duration) error); ok {
+		r0 = rf(ctx, state, value, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationSetOTP provides a mock function with given fields: ctx, purpose, phone, hash, ttl
+func (_m *Authentication) AuthenticationSetOTP(ctx context.Context, purpose string, phone string, hash string, ttl time.Duration) error {
+	ret := _m.Called(ctx, purpose, phone, hash, ttl)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, time.Duration) error); ok {
+		r0 = rf(ctx, purpose, phone, hash, ttl)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AuthenticationUseTOTPStep provides a mock function with given fields: ctx, userID, step, ttl
+func (_m *Authentication) AuthenticationUseTOTPStep(ctx context.Context, userID string, step int64, ttl time.Duration) (bool, error) {
+	ret := _m.Called(ctx, userID, step, ttl)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Duration) (bool, error)); ok {
+		return rf(ctx, userID, step, ttl)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, time.Duration) bool); ok {
+		r0 = rf(ctx, userID, step, ttl)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, time.Duration) error); ok {
+		r1 = rf(ctx, userID, step, ttl)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewAuthentication interface {
 	mock.TestingT
 	Cleanup(func())
@@ -48,6 +48,20 @@ func (_m *User) UserDelete(ctx context.Context, userID string) error {
 	return r0
 }
 
+// UserDeleteStatus provides a mock function with given fields: ctx, userID
+func (_m *User) UserDeleteStatus(ctx context.Context, userID string) error {
+	ret := _m.Called(ctx, userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UserGetAll provides a mock function with given fields: ctx, meta, params
 func (_m *User) UserGetAll(ctx context.Context, meta query.MetaData, params queryparameter.QueryParameter) ([]user.User, error) {
 	ret := _m.Called(ctx, meta, params)
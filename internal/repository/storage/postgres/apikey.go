@@ -0,0 +1,215 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/apikey"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
+)
+
+const apiKeyTouchInterval = time.Minute
+
+var apiKeyColumns = []string{
+	"id", "organization_id", "user_id", "name", "prefix", "scopes", "created_at", "last_used_at",
+}
+
+// APIKeyGetAll returns not revoked API keys of the organization from database.
+func (r *Repository) APIKeyGetAll(ctxr context.Context, organizationID string) ([]apikey.APIKey, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.APIKeyGetAll")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var keys []apikey.APIKey
+
+	builder := r.genSQL.Select(apiKeyColumns...).
+		From(apiKeyTable).
+		Where(squirrel.Eq{"organization_id": organizationID, "revoked_at": nil}).
+		OrderBy("created_at DESC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return keys, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &keys, qry, args...)
+
+	return keys, errors.Wrap(err, "api keys select query error")
+}
+
+// APIKeyGetOne returns not revoked API key by id from database.
+func (r *Repository) APIKeyGetOne(ctxr context.Context, keyID string) (apikey.APIKey, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.APIKeyGetOne")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var key apikey.APIKey
+
+	builder := r.genSQL.Select(apiKeyColumns...).
+		From(apiKeyTable).
+		Where(squirrel.Eq{"id": keyID, "revoked_at": nil})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return key, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &key, qry, args...)
+
+	return key, errors.Wrap(err, "api key select query error")
+}
+
+// APIKeyGetByHash returns not revoked API key by key hash from database.
+func (r *Repository) APIKeyGetByHash(ctxr context.Context, hash string) (apikey.APIKey, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.APIKeyGetByHash")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var key apikey.APIKey
+
+	builder := r.genSQL.Select(apiKeyColumns...).
+		From(apiKeyTable).
+		Where(squirrel.Eq{"key_hash": hash, "revoked_at": nil})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return key, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &key, qry, args...)
+
+	return key, errors.Wrap(err, "api key select query error")
+}
+
+// APIKeyCreate inserts API key into database.
+func (r *Repository) APIKeyCreate(ctxr context.Context, userID string, input apikey.CreateAPIKeyInput, prefix string, hash string) (apikey.APIKey, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.APIKeyCreate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var key apikey.APIKey
+
+	builder := r.genSQL.Insert(apiKeyTable).
+		Columns("organization_id", "user_id", "name", "prefix", "key_hash", "scopes").
+		Values(input.OrganizationID, userID, input.Name, prefix, hash, pq.StringArray(input.Scopes)).
+		Suffix("RETURNING id, organization_id, user_id, name, prefix, scopes, created_at, last_used_at")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return key, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &key, qry, args...)
+
+	return key, errors.Wrap(err, "api key insert query error")
+}
+
+// APIKeyRevoke revokes API key by id in database.
+func (r *Repository) APIKeyRevoke(ctxr context.Context, keyID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.APIKeyRevoke")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(apiKeyTable).
+		Set("revoked_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": keyID, "revoked_at": nil})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "api key revoke query error")
+}
+
+// APIKeyTouch updates last usage datetime of API key, at most once per minute.
+func (r *Repository) APIKeyTouch(ctxr context.Context, keyID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.APIKeyTouch")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	now := time.Now().UTC()
+
+	builder := r.genSQL.Update(apiKeyTable).
+		Set("last_used_at", now).
+		Where(squirrel.Eq{"id": keyID}).
+		Where(squirrel.Or{squirrel.Eq{"last_used_at": nil}, squirrel.Lt{"last_used_at": now.Add(-apiKeyTouchInterval)}})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "api key touch query error")
+}
+
+// APIKeyGetOrganizationOwner returns id of the user who owns the organization.
+func (r *Repository) APIKeyGetOrganizationOwner(ctxr context.Context, organizationID string) (string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.APIKeyGetOrganizationOwner")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var userID string
+
+	builder := r.genSQL.Select("user_id").
+		From(organizationTable).
+		Where(squirrel.Eq{"id": organizationID, "is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return userID, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &userID, qry, args...)
+
+	return userID, errors.Wrap(err, "organization owner select query error")
+}
@@ -0,0 +1,152 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/queryparameter"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// AuditCreate inserts audit entry into database.
+func (r *Repository) AuditCreate(ctxr context.Context, entry audit.Entry) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuditCreate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Insert(auditTable).
+		Columns(
+			"actor_id", "role", "organization_id", "entity", "entity_id", "action", "changes", "request_id", "ip",
+		).
+		Values(
+			entry.ActorID,
+			entry.Role,
+			entry.OrganizationID,
+			entry.Entity,
+			entry.EntityID,
+			entry.Action,
+			entry.Changes,
+			entry.RequestID,
+			entry.IP,
+		)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "audit entry create query error")
+}
+
+// AuditGetAll selects audit entries from database, the newest first.
+func (r *Repository) AuditGetAll(ctxr context.Context, filter audit.Filter, params queryparameter.QueryParameter) ([]audit.Entry, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuditGetAll")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var entries []audit.Entry
+
+	qry, args, err := r.auditGetAllQuery(filter, params)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &entries, qry, args...)
+
+	return entries, errors.Wrap(err, "audit entries select query error")
+}
+
+// auditGetAllQuery creates sql query.
+func (r *Repository) auditGetAllQuery(filter audit.Filter, params queryparameter.QueryParameter) (string, []interface{}, error) { //nolint:lll
+	builder := r.genSQL.Select(
+		"id", "actor_id", "role", "organization_id", "entity", "entity_id", "action", "changes", "request_id", "ip",
+		"created_at",
+	).From(auditTable)
+
+	for _, condition := range []struct {
+		column string
+		value  string
+	}{
+		{"actor_id", filter.ActorID},
+		{"organization_id", filter.OrganizationID},
+		{"entity", filter.Entity},
+		{"entity_id", filter.EntityID},
+		{"action", filter.Action},
+		{"request_id", filter.RequestID},
+	} {
+		if condition.value != "" {
+			builder = builder.Where(squirrel.Eq{condition.column: condition.value})
+		}
+	}
+
+	if !params.StartDate.IsZero() {
+		builder = builder.Where(squirrel.GtOrEq{"created_at": params.StartDate})
+	}
+
+	if !params.EndDate.IsZero() {
+		builder = builder.Where(squirrel.LtOrEq{"created_at": params.EndDate})
+	}
+
+	builder = builder.OrderBy("created_at DESC", "id ASC")
+
+	if params.Pagination.Limit > 0 {
+		builder = builder.Limit(params.Pagination.Limit)
+	} else {
+		builder = builder.Limit(DefaultLimit)
+	}
+
+	if params.Pagination.Offset > 0 {
+		builder = builder.Offset(params.Pagination.Offset)
+	}
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return "", nil, errors.Wrap(err, "unable to build a query string")
+	}
+
+	return qry, args, nil
+}
+
+// AuditDeleteBefore deletes audit entries created before the datetime from database.
+func (r *Repository) AuditDeleteBefore(ctxr context.Context, before time.Time) (int64, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuditDeleteBefore")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	qry, args, err := r.genSQL.Delete(auditTable).Where(squirrel.Lt{"created_at": before.UTC()}).ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to build a query string")
+	}
+
+	result, err := r.database.ExecContext(ctx, qry, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "audit entries delete query error")
+	}
+
+	deleted, err := result.RowsAffected()
+
+	return deleted, errors.Wrap(err, "audit entries delete query error")
+}
@@ -1,7 +1,11 @@
 package postgres
 
 import (
+	"database/sql"
+	"time"
+
 	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
@@ -23,7 +27,8 @@ func (r *Repository) AuthenticationGetUser(ctxr context.Context, userID string,
 	var usr user.User
 
 	builder := r.genSQL.Select(
-		"us.id", "us.phone", "us.password", "us.first_name", "us.last_name", "ro.name AS role", "st.name AS status").
+		"us.id", "us.phone", "us.password", "us.first_name", "us.last_name", "ro.name AS role", "st.name AS status",
+		"us.suspended_until").
 		From(userTable + " us").
 		InnerJoin(userRoleTable + " ur ON ur.user_id = us.id").
 		InnerJoin(roleTable + " ro ON ur.role_id = ro.id").
@@ -46,8 +51,8 @@ func (r *Repository) AuthenticationGetUser(ctxr context.Context, userID string,
 	return usr, errors.Wrap(err, "user select error")
 }
 
-// AuthenticationCreateUser insert user into database.
-func (r *Repository) AuthenticationCreateUser(ctxr context.Context, input user.CreateUserInput) (string, error) {
+// AuthenticationCreateUser insert user with the customer role into database.
+func (r *Repository) AuthenticationCreateUser(ctxr context.Context, input user.SignUpInput) (string, error) {
 	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
@@ -66,20 +71,30 @@ func (r *Repository) AuthenticationCreateUser(ctxr context.Context, input user.C
 	}
 
 	builder := r.genSQL.Insert(userTable).
-		Columns("phone", "password", "first_name", "last_name").
-		Values(input.Phone, input.Password, input.FirstName, input.LastName).
+		Columns("phone", "password", "first_name", "last_name", "status_id").
+		Values(
+			input.Phone,
+			input.Password,
+			input.FirstName,
+			input.LastName,
+			squirrel.Expr("(SELECT id FROM "+statusTable+" WHERE name = ?)", user.StatusPending),
+		).
 		Suffix("RETURNING \"id\"")
 
 	createUserQuery, args, err := builder.ToSql()
 	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
 		return "", errors.Wrap(err, "unable to build a query string")
 	}
 
 	row := trx.QueryRowContext(ctx, createUserQuery, args...)
 
 	if err = row.Scan(&userID); err != nil {
-		if err = trx.Rollback(); err != nil {
-			return "", errors.Wrap(err, "transaction rollback error")
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
 		}
 
 		return "", errors.Wrap(err, "user id scan error")
@@ -87,16 +102,20 @@ func (r *Repository) AuthenticationCreateUser(ctxr context.Context, input user.C
 
 	builderUsersRoleQuery := r.genSQL.Insert(userRoleTable).
 		Columns("user_id", "role_id").
-		Values(userID, input.RoleID)
+		Values(userID, squirrel.Expr("(SELECT id FROM "+roleTable+" WHERE name = ?)", customerRole))
 
 	createUsersRoleQuery, args, err := builderUsersRoleQuery.ToSql()
 	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
 		return "", errors.Wrap(err, "unable to build a query string")
 	}
 
 	if _, err = trx.ExecContext(ctx, createUsersRoleQuery, args...); err != nil {
-		if err = trx.Rollback(); err != nil {
-			return "", errors.Wrap(err, "role table rollback error")
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "role table rollback error")
 		}
 
 		return "", errors.Wrap(err, "role insert query error")
@@ -105,8 +124,90 @@ func (r *Repository) AuthenticationCreateUser(ctxr context.Context, input user.C
 	return userID, errors.Wrap(trx.Commit(), "transaction commit error")
 }
 
+// AuthenticationActivateUser sets active status to the user waiting for phone verification.
+func (r *Repository) AuthenticationActivateUser(ctxr context.Context, phone string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationActivateUser")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(userTable).
+		Set("status_id", squirrel.Expr("(SELECT id FROM "+statusTable+" WHERE name = ?)", user.StatusActive)).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"phone": phone, "is_deleted": false}).
+		Where(squirrel.Expr("status_id = (SELECT id FROM "+statusTable+" WHERE name = ?)", user.StatusPending))
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "user activate query error")
+}
+
+// AuthenticationUpdatePassword sets new password hash to the user.
+func (r *Repository) AuthenticationUpdatePassword(ctxr context.Context, userID string, password string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationUpdatePassword")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(userTable).
+		Set("password", password).
+		Set("user_updated", userID).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": userID, "is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "user password update query error")
+}
+
+// AuthenticationRehashPassword replaces password hash of the user only if it has not been changed meanwhile.
+func (r *Repository) AuthenticationRehashPassword(ctxr context.Context, userID string, oldHash string, newHash string) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationRehashPassword")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(userTable).
+		Set("password", newHash).
+		Where(squirrel.Eq{"id": userID, "password": oldHash, "is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "user password rehash query error")
+}
+
 // AuthenticationCreateTokenHash inserts token hash into database.
-func (r *Repository) AuthenticationCreateTokenHash(ctxr context.Context, userID string, hash string) error {
+func (r *Repository) AuthenticationCreateTokenHash(ctxr context.Context, userID string, hash string, session token.SessionInput) error { //nolint:lll
 	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
@@ -119,7 +220,17 @@ func (r *Repository) AuthenticationCreateTokenHash(ctxr context.Context, userID
 
 	var tokenID string
 
-	builder := r.genSQL.Insert(tokenTable).Columns("user_id", "hash").Values(userID, hash).Suffix("RETURNING \"id\"")
+	builder := r.genSQL.Insert(tokenTable).
+		Columns("user_id", "hash", "client_type", "device_name", "ip", "expires_at").
+		Values(
+			userID,
+			hash,
+			squirrel.Expr("(SELECT id FROM "+clientTypeTable+" WHERE name = ?)", session.ClientType),
+			session.DeviceName,
+			session.IP,
+			session.ExpiresAt.UTC(),
+		).
+		Suffix("RETURNING \"id\"")
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -151,7 +262,8 @@ func (r *Repository) AuthenticationGetTokenHash(ctxr context.Context, userID str
 	var tokenID string
 
 	builder := r.genSQL.Select("id").From(tokenTable).
-		Where(squirrel.Eq{"user_id": userID, "hash": hash, "expired": false})
+		Where(squirrel.Eq{"user_id": userID, "hash": hash, "expired": false}).
+		Where(squirrel.Or{squirrel.Eq{"expires_at": nil}, squirrel.Expr("expires_at > now()")})
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -166,21 +278,129 @@ func (r *Repository) AuthenticationGetTokenHash(ctxr context.Context, userID str
 	return tokenID, nil
 }
 
-// AuthenticationUpdateTokenHash updates token hash in database.
-func (r *Repository) AuthenticationUpdateTokenHash(ctxr context.Context, tokenID string, hash string) error {
+// AuthenticationUpdateTokenHash rotates token hash in database and keeps the previous one in token family.
+// The hash is rotated only if it has not been changed by a concurrent refresh, sql.ErrNoRows is returned otherwise.
+func (r *Repository) AuthenticationUpdateTokenHash(ctxr context.Context, tokenID string, previousHash string, hash string, session token.SessionInput) error { //nolint:lll
 	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
 
 	if r.isTracingOn {
-		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationGetTokenHash")
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationUpdateTokenHash")
 		defer span.End()
 
 		ctx = context.New(ctxt)
 	}
 
-	builder := r.genSQL.Update(tokenTable).
+	qryUpdate, argsUpdate, err := r.genSQL.Update(tokenTable).
 		Set("hash", hash).
 		Set("expired", false).
+		Set("ip", session.IP).
+		Set("expires_at", session.ExpiresAt.UTC()).
+		Set("last_used_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": tokenID, "hash": previousHash, "expired": false}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	qryRotated, argsRotated, err := r.genSQL.Insert(rotatedTokenTable).
+		Columns("token_id", "hash").
+		Values(tokenID, previousHash).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return errors.Wrap(err, "transaction begin error")
+	}
+
+	// the row stays locked until commit, so a concurrent refresh with the same hash updates nothing
+	result, err := trx.ExecContext(ctx, qryUpdate, argsUpdate...)
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "token rollback error")
+		}
+
+		return errors.Wrap(err, "unable to update token")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "token rollback error")
+		}
+
+		return errors.Wrap(err, "unable to get affected rows")
+	}
+
+	if affected == 0 {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "token rollback error")
+		}
+
+		return errors.Wrap(sql.ErrNoRows, "token hash has already been rotated")
+	}
+
+	if _, err = trx.ExecContext(ctx, qryRotated, argsRotated...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "rotated token rollback error")
+		}
+
+		return errors.Wrap(err, "rotated token insert query error")
+	}
+
+	return errors.Wrap(trx.Commit(), "token transaction commit error")
+}
+
+// AuthenticationGetTokenFamily returns id of the token which has already rotated the hash.
+func (r *Repository) AuthenticationGetTokenFamily(ctxr context.Context, userID string, hash string) (string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationGetTokenFamily")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var tokenID string
+
+	builder := r.genSQL.Select("tw.id").
+		From(rotatedTokenTable + " rh").
+		InnerJoin(tokenTable + " tw ON tw.id = rh.token_id").
+		Where(squirrel.Eq{"tw.user_id": userID, "rh.hash": hash}).
+		Limit(1)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &tokenID, qry, args...)
+	if err != nil {
+		return "", errors.Wrap(err, "token family select query error")
+	}
+
+	return tokenID, nil
+}
+
+// AuthenticationRevokeTokenFamily marks the whole token family as expired in database.
+func (r *Repository) AuthenticationRevokeTokenFamily(ctxr context.Context, tokenID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationRevokeTokenFamily")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(tokenTable).
+		Set("expired", true).
 		Where(squirrel.Eq{"id": tokenID})
 
 	qry, args, err := builder.ToSql()
@@ -189,9 +409,472 @@ func (r *Repository) AuthenticationUpdateTokenHash(ctxr context.Context, tokenID
 	}
 
 	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "token family revoke query error")
+}
+
+// AuthenticationGetSessions selects active sessions of the user from database.
+func (r *Repository) AuthenticationGetSessions(ctxr context.Context, userID string) ([]token.Session, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationGetSessions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var sessions []token.Session
+
+	builder := r.genSQL.Select(
+		"tw.id", "ct.name AS client_type", "tw.device_name", "tw.ip", "tw.created_at", "tw.last_used_at",
+		"COALESCE(tw.expires_at, tw.created_at) AS expires_at",
+	).
+		From(tokenTable + " tw").
+		InnerJoin(clientTypeTable + " ct ON ct.id = tw.client_type").
+		Where(squirrel.Eq{"tw.user_id": userID, "tw.expired": false}).
+		Where(squirrel.Or{squirrel.Eq{"tw.expires_at": nil}, squirrel.Expr("tw.expires_at > now()")}).
+		OrderBy("tw.last_used_at DESC")
+
+	qry, args, err := builder.ToSql()
 	if err != nil {
-		return errors.Wrap(err, "unable to update token")
+		return nil, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &sessions, qry, args...)
+
+	return sessions, errors.Wrap(err, "sessions select query error")
+}
+
+// AuthenticationRevokeSession marks users session as expired in database, sql.ErrNoRows is returned if the user
+// has no such active session.
+func (r *Repository) AuthenticationRevokeSession(ctxr context.Context, userID string, sessionID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationRevokeSession")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(tokenTable).
+		Set("expired", true).
+		Where(squirrel.Eq{"id": sessionID, "user_id": userID, "expired": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	result, err := r.database.ExecContext(ctx, qry, args...)
+	if err != nil {
+		return errors.Wrap(err, "session revoke query error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "session revoke query error")
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
 	}
 
 	return nil
 }
+
+// AuthenticationRevokeSessions marks all users sessions as expired in database.
+func (r *Repository) AuthenticationRevokeSessions(ctxr context.Context, userID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationRevokeSessions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(tokenTable).
+		Set("expired", true).
+		Where(squirrel.Eq{"user_id": userID, "expired": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "sessions revoke query error")
+}
+
+// AuthenticationGetSigningKeys selects not retired signing keys from database.
+func (r *Repository) AuthenticationGetSigningKeys(ctxr context.Context) ([]token.SigningKey, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationGetSigningKeys")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var keys []token.SigningKey
+
+	builder := r.genSQL.Select("id", "algorithm", "private_key", "created_at", "expires_at", "retired_at").
+		From(signingKeyTable).
+		Where(squirrel.Expr("retired_at > now()")).
+		OrderBy("created_at DESC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &keys, qry, args...)
+
+	return keys, errors.Wrap(err, "signing keys select query error")
+}
+
+// AuthenticationCreateSigningKey inserts new signing key into database and stops signing with the previous ones.
+// Instances rotate keys under an advisory lock, the key is not inserted if another instance has already created a key
+// allowed for signing with the same algorithm. Returns true if the key is inserted.
+func (r *Repository) AuthenticationCreateSigningKey(ctxr context.Context, key token.SigningKey) (bool, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationCreateSigningKey")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	qryLock, argsLock, err := r.genSQL.Select().Column(squirrel.Expr("pg_advisory_xact_lock(?)", signingKeyLock)).ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to build a query string")
+	}
+
+	qryCurrent, argsCurrent, err := r.genSQL.Select("COUNT(*)").
+		From(signingKeyTable).
+		Where(squirrel.Eq{"algorithm": key.Algorithm}).
+		Where(squirrel.Gt{"expires_at": key.CreatedAt.UTC()}).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to build a query string")
+	}
+
+	qryExpire, argsExpire, err := r.genSQL.Update(signingKeyTable).
+		Set("expires_at", key.CreatedAt.UTC()).
+		Where(squirrel.Gt{"expires_at": key.CreatedAt.UTC()}).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to build a query string")
+	}
+
+	qryInsert, argsInsert, err := r.genSQL.Insert(signingKeyTable).
+		Columns("id", "algorithm", "private_key", "created_at", "expires_at", "retired_at").
+		Values(key.ID, key.Algorithm, key.PrivateKey, key.CreatedAt.UTC(), key.ExpiresAt.UTC(), key.RetiredAt.UTC()).
+		ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to build a query string")
+	}
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return false, errors.Wrap(err, "transaction begin error")
+	}
+
+	if _, err = trx.ExecContext(ctx, qryLock, argsLock...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return false, errors.Wrap(errRollback, "signing key lock rollback error")
+		}
+
+		return false, errors.Wrap(err, "signing key lock query error")
+	}
+
+	var current int
+
+	if err = trx.QueryRowContext(ctx, qryCurrent, argsCurrent...).Scan(&current); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return false, errors.Wrap(errRollback, "signing keys rollback error")
+		}
+
+		return false, errors.Wrap(err, "signing keys select query error")
+	}
+
+	if current > 0 {
+		return false, errors.Wrap(trx.Rollback(), "signing keys rollback error")
+	}
+
+	if _, err = trx.ExecContext(ctx, qryExpire, argsExpire...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return false, errors.Wrap(errRollback, "signing keys rollback error")
+		}
+
+		return false, errors.Wrap(err, "signing keys expire query error")
+	}
+
+	if _, err = trx.ExecContext(ctx, qryInsert, argsInsert...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return false, errors.Wrap(errRollback, "signing key rollback error")
+		}
+
+		return false, errors.Wrap(err, "signing key insert query error")
+	}
+
+	if err = trx.Commit(); err != nil {
+		return false, errors.Wrap(err, "signing key transaction commit error")
+	}
+
+	return true, nil
+}
+
+// AuthenticationGetTwoFactor returns two-factor settings of the user.
+func (r *Repository) AuthenticationGetTwoFactor(ctxr context.Context, userID string) (user.TwoFactor, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationGetTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var twoFactor user.TwoFactor
+
+	builder := r.genSQL.Select("user_id", "secret", "enabled").
+		From(twoFactorTable).
+		Where(squirrel.Eq{"user_id": userID})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return twoFactor, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &twoFactor, qry, args...)
+
+	return twoFactor, errors.Wrap(err, "two-factor select error")
+}
+
+// AuthenticationSetTwoFactorSecret stores new not confirmed TOTP secret of the user.
+func (r *Repository) AuthenticationSetTwoFactorSecret(ctxr context.Context, userID string, secret string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationSetTwoFactorSecret")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Insert(twoFactorTable).
+		Columns("user_id", "secret", "enabled", "created_at").
+		Values(userID, secret, false, time.Now().UTC()).
+		Suffix("ON CONFLICT (user_id) DO UPDATE SET secret = EXCLUDED.secret, enabled = FALSE, " +
+			"created_at = EXCLUDED.created_at, enabled_at = NULL")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "two-factor insert query error")
+}
+
+// AuthenticationEnableTwoFactor confirms TOTP secret of the user and stores backup codes.
+func (r *Repository) AuthenticationEnableTwoFactor(ctxr context.Context, userID string, codeHashes []string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationEnableTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return errors.Wrap(err, "transaction begin error")
+	}
+
+	builder := r.genSQL.Update(twoFactorTable).
+		Set("enabled", true).
+		Set("enabled_at", time.Now().UTC()).
+		Where(squirrel.Eq{"user_id": userID})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "transaction rollback error")
+		}
+
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	if _, err = trx.ExecContext(ctx, qry, args...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "two-factor rollback error")
+		}
+
+		return errors.Wrap(err, "two-factor enable query error")
+	}
+
+	if err = r.replaceBackupCodes(ctx, trx, userID, codeHashes); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "backup codes rollback error")
+		}
+
+		return err
+	}
+
+	return errors.Wrap(trx.Commit(), "two-factor transaction commit error")
+}
+
+// AuthenticationReplaceBackupCodes replaces backup codes of the user.
+func (r *Repository) AuthenticationReplaceBackupCodes(ctxr context.Context, userID string, codeHashes []string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationReplaceBackupCodes")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return errors.Wrap(err, "transaction begin error")
+	}
+
+	if err = r.replaceBackupCodes(ctx, trx, userID, codeHashes); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "backup codes rollback error")
+		}
+
+		return err
+	}
+
+	return errors.Wrap(trx.Commit(), "backup codes transaction commit error")
+}
+
+// replaceBackupCodes deletes old and inserts new backup codes within the transaction.
+func (r *Repository) replaceBackupCodes(ctx context.Context, trx *sql.Tx, userID string, codeHashes []string) error {
+	qry, args, err := r.genSQL.Delete(backupCodeTable).Where(squirrel.Eq{"user_id": userID}).ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	if _, err = trx.ExecContext(ctx, qry, args...); err != nil {
+		return errors.Wrap(err, "backup codes delete query error")
+	}
+
+	if len(codeHashes) == 0 {
+		return nil
+	}
+
+	builder := r.genSQL.Insert(backupCodeTable).Columns("user_id", "code_hash")
+
+	for _, hash := range codeHashes {
+		builder = builder.Values(userID, hash)
+	}
+
+	qry, args, err = builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = trx.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "backup codes insert query error")
+}
+
+// AuthenticationUseBackupCode marks not used backup code as used, returns false if there is no such code.
+func (r *Repository) AuthenticationUseBackupCode(ctxr context.Context, userID string, codeHash string) (bool, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationUseBackupCode")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(backupCodeTable).
+		Set("used_at", time.Now().UTC()).
+		Where(squirrel.Eq{"user_id": userID, "code_hash": codeHash, "used_at": nil})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to build a query string")
+	}
+
+	result, err := r.database.ExecContext(ctx, qry, args...)
+	if err != nil {
+		return false, errors.Wrap(err, "backup code update query error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to get affected rows")
+	}
+
+	return affected == 1, nil
+}
+
+// AuthenticationDisableTwoFactor deletes two-factor settings and backup codes of the user.
+func (r *Repository) AuthenticationDisableTwoFactor(ctxr context.Context, userID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationDisableTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return errors.Wrap(err, "transaction begin error")
+	}
+
+	if err = r.replaceBackupCodes(ctx, trx, userID, nil); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "backup codes rollback error")
+		}
+
+		return err
+	}
+
+	qry, args, err := r.genSQL.Delete(twoFactorTable).Where(squirrel.Eq{"user_id": userID}).ToSql()
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "transaction rollback error")
+		}
+
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	if _, err = trx.ExecContext(ctx, qry, args...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "two-factor rollback error")
+		}
+
+		return errors.Wrap(err, "two-factor delete query error")
+	}
+
+	return errors.Wrap(trx.Commit(), "two-factor transaction commit error")
+}
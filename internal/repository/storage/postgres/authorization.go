@@ -1,7 +1,12 @@
 package postgres
 
 import (
+	"database/sql"
+
 	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/member"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
 	"github.com/pkg/errors"
@@ -36,3 +41,155 @@ func (r *Repository) AuthorizationGetUserRole(ctxr context.Context, userID strin
 
 	return name, errors.Wrap(err, "role name select error")
 }
+
+// AuthorizationGetUserStatus returns status of the user.
+func (r *Repository) AuthorizationGetUserStatus(ctxr context.Context, userID string) (user.AccountStatus, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthorizationGetUserStatus")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var status user.AccountStatus
+
+	builder := r.genSQL.Select("st.name AS status", "us.suspended_until").
+		From(userTable + " us").
+		InnerJoin(statusTable + " st ON st.id = us.status_id").
+		Where(squirrel.Eq{"us.id": userID, "us.is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return status, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &status, qry, args...)
+
+	return status, errors.Wrap(err, "user status select error")
+}
+
+// AuthorizationGetRoleAssignments returns roles of all active members in their organizations.
+func (r *Repository) AuthorizationGetRoleAssignments(ctxr context.Context) ([]role.Assignment, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthorizationGetRoleAssignments")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var assignments []role.Assignment
+
+	builder := r.genSQL.Select("om.user_id", "om.organization_id", "ro.name AS role").
+		From(memberTable + " om").
+		InnerJoin(roleTable + " ro ON ro.id = om.role_id").
+		Where(squirrel.Eq{"om.status": member.StatusActive})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return assignments, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &assignments, qry, args...)
+
+	return assignments, errors.Wrap(err, "role assignments select error")
+}
+
+// AuthorizationGetOrganizationRoles returns roles of users in the organization.
+func (r *Repository) AuthorizationGetOrganizationRoles(ctxr context.Context, organizationID string) ([]role.Assignment, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthorizationGetOrganizationRoles")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var assignments []role.Assignment
+
+	builder := r.genSQL.Select("om.user_id", "om.organization_id", "ro.name AS role").
+		From(memberTable + " om").
+		InnerJoin(roleTable + " ro ON ro.id = om.role_id").
+		Where(squirrel.Eq{"om.organization_id": organizationID}).
+		OrderBy("om.joined_at ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return assignments, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &assignments, qry, args...)
+
+	return assignments, errors.Wrap(err, "organization roles select error")
+}
+
+// AuthorizationSetOrganizationRole assigns role of the user in the organization, the previous role is replaced.
+func (r *Repository) AuthorizationSetOrganizationRole(ctxr context.Context, organizationID string, userID string, roleName string) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthorizationSetOrganizationRole")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Insert(memberTable).
+		Columns("organization_id", "user_id", "role_id").
+		Values(organizationID, userID, squirrel.Expr("(SELECT id FROM "+roleTable+" WHERE name = ?)", roleName)).
+		Suffix("ON CONFLICT (organization_id, user_id) DO UPDATE SET role_id = EXCLUDED.role_id")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "organization role insert query error")
+}
+
+// AuthorizationDeleteOrganizationRole removes role of the user in the organization.
+func (r *Repository) AuthorizationDeleteOrganizationRole(ctxr context.Context, organizationID string, userID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthorizationDeleteOrganizationRole")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Delete(memberTable).
+		Where(squirrel.Eq{"organization_id": organizationID, "user_id": userID})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	result, err := r.database.ExecContext(ctx, qry, args...)
+	if err != nil {
+		return errors.Wrap(err, "organization role delete query error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "organization role delete query error")
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
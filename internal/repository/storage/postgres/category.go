@@ -76,9 +76,7 @@ func (r *Repository) categoryGetAllQuery(meta query.MetaData, params queryparame
 		})
 	}
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	if len(params.Sorts) > 0 {
 		builder = builder.OrderBy(params.Sorts.Parsing(mappingSortCategory)...)
@@ -121,9 +119,7 @@ func (r *Repository) CategoryGetOne(ctxr context.Context, meta query.MetaData, c
 		From(categoryTable).
 		Where(squirrel.Eq{"is_deleted": false, "id": categoryID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -211,9 +207,7 @@ func (r *Repository) CategoryUpdate(ctxr context.Context, meta query.MetaData, i
 		Set("updated_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": *input.ID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -243,9 +237,7 @@ func (r *Repository) CategoryDelete(ctxr context.Context, meta query.MetaData, c
 		Set("deleted_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": categoryID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
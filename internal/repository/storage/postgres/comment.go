@@ -71,9 +71,7 @@ func (r *Repository) commentGetAllQuery(meta query.MetaData, params queryparamet
 		})
 	}
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	if len(params.Sorts) > 0 {
 		builder = builder.OrderBy(params.Sorts.Parsing(mappingSortComment)...)
@@ -116,9 +114,7 @@ func (r *Repository) CommentGetOne(ctxr context.Context, meta query.MetaData, co
 		From(commentTable).
 		Where(squirrel.Eq{"is_deleted": false, "id": commentID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -199,9 +195,7 @@ func (r *Repository) CommentUpdate(ctxr context.Context, meta query.MetaData, in
 		Set("updated_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": *input.ID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -231,9 +225,7 @@ func (r *Repository) CommentDelete(ctxr context.Context, meta query.MetaData, co
 		Set("deleted_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": commentID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
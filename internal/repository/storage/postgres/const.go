@@ -14,11 +14,33 @@ const (
 	orderItemTable     = "orders_items"
 	imageTable         = "images"
 	commentTable       = "comments"
+	commentStatusTable = "comments_statuses"
 	specificationTable = "specification"
 	favoriteTable      = "users_favorites"
 	ruleTable          = "casbin_rule"
 	tokenTable         = "token_whitelist"
+	clientTypeTable    = "client_types"
+	rotatedTokenTable  = "token_rotated_hashes"
+	signingKeyTable    = "signing_keys"
+	twoFactorTable     = "users_two_factor"
+	backupCodeTable    = "users_backup_codes"
+	apiKeyTable        = "api_keys"
+	identityTable      = "users_identities"
+	statusHistoryTable = "users_status_history"
+	invitationTable    = "invitations"
+	memberTable        = "organizations_members"
+	auditTable         = "audit_log"
+	settingTable       = "organizations_settings"
 	// categoryItemTable = "categories_items".
 
-	vendorRole = "vendor"
+	vendorRole   = "vendor"
+	customerRole = "customer"
+	adminRole    = "admin"
+
+	// groupingPolicyType is a casbin rule type of role inheritance, groupings in allDomains apply in all organizations.
+	groupingPolicyType = "g"
+	allDomains         = "*"
+
+	// signingKeyLock is a postgres advisory lock id taken by instances rotating signing keys.
+	signingKeyLock = 7_201_028
 )
@@ -53,9 +53,7 @@ func (r *Repository) FavoriteDelete(ctxr context.Context, meta query.MetaData, i
 
 	builder := r.genSQL.Delete(favoriteTable).Where(squirrel.Eq{"user_id": meta.UserID, "item_id": itemID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
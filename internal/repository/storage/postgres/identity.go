@@ -0,0 +1,183 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// AuthenticationGetIdentityUser returns id of the user linked to the external identity.
+func (r *Repository) AuthenticationGetIdentityUser(ctxr context.Context, provider string, subject string) (string, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationGetIdentityUser")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var userID string
+
+	builder := r.genSQL.Select("ui.user_id").
+		From(identityTable + " ui").
+		InnerJoin(userTable + " us ON us.id = ui.user_id").
+		Where(squirrel.Eq{"ui.provider": provider, "ui.subject": subject, "us.is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &userID, qry, args...)
+
+	return userID, errors.Wrap(err, "identity select error")
+}
+
+// AuthenticationLinkIdentity links the external identity to the user and updates its last login time.
+func (r *Repository) AuthenticationLinkIdentity(ctxr context.Context, userID string, provider string, subject string) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationLinkIdentity")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Insert(identityTable).
+		Columns("user_id", "provider", "subject").
+		Values(userID, provider, subject).
+		Suffix("ON CONFLICT (provider, subject) DO UPDATE SET last_login_at = ?", time.Now().UTC())
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "identity insert query error")
+}
+
+// AuthenticationCreateExternalUser inserts active user with the role and links the external identity to it.
+func (r *Repository) AuthenticationCreateExternalUser(ctxr context.Context, input user.CreateUserInput, roleName string, provider string, subject string) (string, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationCreateExternalUser")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var userID string
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return "", errors.Wrap(err, "transaction begin error")
+	}
+
+	createUserQuery, args, err := r.genSQL.Insert(userTable).
+		Columns("phone", "password", "first_name", "last_name", "status_id").
+		Values(
+			input.Phone,
+			input.Password,
+			input.FirstName,
+			input.LastName,
+			squirrel.Expr("(SELECT id FROM "+statusTable+" WHERE name = ?)", user.StatusActive),
+		).
+		Suffix("RETURNING \"id\"").
+		ToSql()
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
+		return "", errors.Wrap(err, "unable to build a query string")
+	}
+
+	if err = trx.QueryRowContext(ctx, createUserQuery, args...).Scan(&userID); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
+		return "", errors.Wrap(err, "user id scan error")
+	}
+
+	createUsersRoleQuery, args, err := r.genSQL.Insert(userRoleTable).
+		Columns("user_id", "role_id").
+		Values(userID, squirrel.Expr("(SELECT id FROM "+roleTable+" WHERE name = ?)", roleName)).
+		ToSql()
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
+		return "", errors.Wrap(err, "unable to build a query string")
+	}
+
+	if _, err = trx.ExecContext(ctx, createUsersRoleQuery, args...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "role table rollback error")
+		}
+
+		return "", errors.Wrap(err, "role insert query error")
+	}
+
+	createIdentityQuery, args, err := r.genSQL.Insert(identityTable).
+		Columns("user_id", "provider", "subject").
+		Values(userID, provider, subject).
+		ToSql()
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
+		return "", errors.Wrap(err, "unable to build a query string")
+	}
+
+	if _, err = trx.ExecContext(ctx, createIdentityQuery, args...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "identity table rollback error")
+		}
+
+		return "", errors.Wrap(err, "identity insert query error")
+	}
+
+	return userID, errors.Wrap(trx.Commit(), "transaction commit error")
+}
+
+// AuthenticationIsMember checks if the user has a role in any organization.
+func (r *Repository) AuthenticationIsMember(ctxr context.Context, userID string) (bool, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.AuthenticationIsMember")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var isMember bool
+
+	builder := r.genSQL.Select().
+		Column(squirrel.Expr("EXISTS (SELECT 1 FROM "+memberTable+" WHERE user_id = ?)", userID))
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &isMember, qry, args...)
+
+	return isMember, errors.Wrap(err, "memberships select query error")
+}
@@ -61,9 +61,7 @@ func (r *Repository) imageGetAllQuery(meta query.MetaData, params queryparameter
 		})
 	}
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	if len(params.Sorts) > 0 {
 		builder = builder.OrderBy(params.Sorts.Parsing(mappingSortImage)...)
@@ -106,9 +104,7 @@ func (r *Repository) ImageGetOne(ctxr context.Context, meta query.MetaData, imag
 		From(imageTable).
 		Where(squirrel.Eq{"is_deleted": false, "id": imageID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -198,9 +194,7 @@ func (r *Repository) ImageUpdate(ctxr context.Context, meta query.MetaData, inpu
 		Set("updated_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": *input.ID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -230,9 +224,7 @@ func (r *Repository) ImageDelete(ctxr context.Context, meta query.MetaData, imag
 		Set("deleted_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": imageID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -0,0 +1,368 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/invitation"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+var invitationColumns = []string{
+	"iv.id", "iv.organization_id", "iv.phone", "ro.name AS role", "iv.user_created", "iv.created_at", "iv.expires_at",
+	"iv.accepted_by", "iv.accepted_at",
+	"CASE WHEN iv.accepted_at IS NOT NULL THEN '" + invitation.StatusAccepted + "'" +
+		" WHEN iv.revoked_at IS NOT NULL THEN '" + invitation.StatusRevoked + "'" +
+		" WHEN iv.expires_at <= now() THEN '" + invitation.StatusExpired + "'" +
+		" ELSE '" + invitation.StatusPending + "' END AS status",
+}
+
+// InvitationGetAll returns invitations of the organization from database.
+func (r *Repository) InvitationGetAll(ctxr context.Context, organizationID string) ([]invitation.Invitation, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.InvitationGetAll")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var invitations []invitation.Invitation
+
+	builder := r.genSQL.Select(invitationColumns...).
+		From(invitationTable + " iv").
+		InnerJoin(roleTable + " ro ON ro.id = iv.role_id").
+		Where(squirrel.Eq{"iv.organization_id": organizationID}).
+		OrderBy("iv.created_at DESC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return invitations, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &invitations, qry, args...)
+
+	return invitations, errors.Wrap(err, "invitations select query error")
+}
+
+// InvitationGetOne returns invitation by id from database.
+func (r *Repository) InvitationGetOne(ctxr context.Context, invitationID string) (invitation.Invitation, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.InvitationGetOne")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var invite invitation.Invitation
+
+	builder := r.genSQL.Select(invitationColumns...).
+		From(invitationTable + " iv").
+		InnerJoin(roleTable + " ro ON ro.id = iv.role_id").
+		Where(squirrel.Eq{"iv.id": invitationID})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return invite, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &invite, qry, args...)
+
+	return invite, errors.Wrap(err, "invitation select query error")
+}
+
+// InvitationGetByHash returns invitation by token hash from database.
+func (r *Repository) InvitationGetByHash(ctxr context.Context, hash string) (invitation.Invitation, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.InvitationGetByHash")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var invite invitation.Invitation
+
+	builder := r.genSQL.Select(invitationColumns...).
+		From(invitationTable + " iv").
+		InnerJoin(roleTable + " ro ON ro.id = iv.role_id").
+		Where(squirrel.Eq{"iv.token_hash": hash})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return invite, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &invite, qry, args...)
+
+	return invite, errors.Wrap(err, "invitation select query error")
+}
+
+// InvitationCreate inserts invitation into database.
+func (r *Repository) InvitationCreate(ctxr context.Context, userID string, input invitation.CreateInvitationInput, hash string, expiresAt time.Time) (string, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.InvitationCreate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var invitationID string
+
+	builder := r.genSQL.Insert(invitationTable).
+		Columns("organization_id", "phone", "role_id", "token_hash", "user_created", "expires_at").
+		Values(
+			input.OrganizationID,
+			input.Phone,
+			squirrel.Expr("(SELECT id FROM "+roleTable+" WHERE name = ?)", input.Role),
+			hash,
+			userID,
+			expiresAt,
+		).
+		Suffix("RETURNING \"id\"")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return invitationID, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &invitationID, qry, args...)
+
+	return invitationID, errors.Wrap(err, "invitation insert query error")
+}
+
+// InvitationRevoke revokes pending invitation by id in database.
+func (r *Repository) InvitationRevoke(ctxr context.Context, invitationID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.InvitationRevoke")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(invitationTable).
+		Set("revoked_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": invitationID, "accepted_at": nil, "revoked_at": nil})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "invitation revoke query error")
+}
+
+// InvitationGetUser returns not deleted user by phone number from database.
+func (r *Repository) InvitationGetUser(ctxr context.Context, phone string) (string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.InvitationGetUser")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var userID string
+
+	builder := r.genSQL.Select("id").
+		From(userTable).
+		Where(squirrel.Eq{"phone": phone, "is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return userID, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &userID, qry, args...)
+
+	return userID, errors.Wrap(err, "user select query error")
+}
+
+// InvitationGetOrganizationOwner returns id of the user who owns the organization.
+func (r *Repository) InvitationGetOrganizationOwner(ctxr context.Context, organizationID string) (string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.InvitationGetOrganizationOwner")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var userID string
+
+	builder := r.genSQL.Select("user_id").
+		From(organizationTable).
+		Where(squirrel.Eq{"id": organizationID, "is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return userID, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &userID, qry, args...)
+
+	return userID, errors.Wrap(err, "organization owner select query error")
+}
+
+// InvitationAccept marks pending invitation as accepted and attaches the user to the organization.
+// If userID is empty, a new active customer is created from the input first.
+// The invited role is granted in the organization only, global role of the user is not changed.
+func (r *Repository) InvitationAccept(ctxr context.Context, invitationID string, userID string, input user.SignUpInput) (string, error) { //nolint:lll,cyclop
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.InvitationAccept")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return "", errors.Wrap(err, "transaction begin error")
+	}
+
+	if userID == "" {
+		createUserQuery, args, err := r.genSQL.Insert(userTable).
+			Columns("phone", "password", "first_name", "last_name", "status_id").
+			Values(
+				input.Phone,
+				input.Password,
+				input.FirstName,
+				input.LastName,
+				squirrel.Expr("(SELECT id FROM "+statusTable+" WHERE name = ?)", user.StatusActive),
+			).
+			Suffix("RETURNING \"id\"").
+			ToSql()
+		if err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return "", errors.Wrap(errRollback, "transaction rollback error")
+			}
+
+			return "", errors.Wrap(err, "unable to build a query string")
+		}
+
+		if err = trx.QueryRowContext(ctx, createUserQuery, args...).Scan(&userID); err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return "", errors.Wrap(errRollback, "transaction rollback error")
+			}
+
+			return "", errors.Wrap(err, "user id scan error")
+		}
+
+		createUsersRoleQuery, args, err := r.genSQL.Insert(userRoleTable).
+			Columns("user_id", "role_id").
+			Values(userID, squirrel.Expr("(SELECT id FROM "+roleTable+" WHERE name = ?)", customerRole)).
+			ToSql()
+		if err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return "", errors.Wrap(errRollback, "transaction rollback error")
+			}
+
+			return "", errors.Wrap(err, "unable to build a query string")
+		}
+
+		if _, err = trx.ExecContext(ctx, createUsersRoleQuery, args...); err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return "", errors.Wrap(errRollback, "role table rollback error")
+			}
+
+			return "", errors.Wrap(err, "role insert query error")
+		}
+	} else {
+		// the code was delivered to the invited phone, so pending user is verified by accepting it
+		activateQuery, args, err := r.genSQL.Update(userTable).
+			Set("status_id", squirrel.Expr("(SELECT id FROM "+statusTable+" WHERE name = ?)", user.StatusActive)).
+			Where(squirrel.Eq{"id": userID}).
+			Where(squirrel.Expr("status_id = (SELECT id FROM "+statusTable+" WHERE name = ?)", user.StatusPending)).
+			ToSql()
+		if err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return "", errors.Wrap(errRollback, "transaction rollback error")
+			}
+
+			return "", errors.Wrap(err, "unable to build a query string")
+		}
+
+		if _, err = trx.ExecContext(ctx, activateQuery, args...); err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return "", errors.Wrap(errRollback, "user table rollback error")
+			}
+
+			return "", errors.Wrap(err, "user activate query error")
+		}
+	}
+
+	var organizationID string
+
+	var roleID int
+
+	acceptQuery, args, err := r.genSQL.Update(invitationTable).
+		Set("accepted_at", time.Now().UTC()).
+		Set("accepted_by", userID).
+		Where(squirrel.Eq{"id": invitationID, "accepted_at": nil, "revoked_at": nil}).
+		Where(squirrel.Expr("expires_at > now()")).
+		Suffix("RETURNING organization_id, role_id").
+		ToSql()
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
+		return "", errors.Wrap(err, "unable to build a query string")
+	}
+
+	if err = trx.QueryRowContext(ctx, acceptQuery, args...).Scan(&organizationID, &roleID); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "invitation table rollback error")
+		}
+
+		return "", errors.Wrap(err, "invitation accept query error")
+	}
+
+	memberQuery, args, err := r.genSQL.Insert(memberTable).
+		Columns("organization_id", "user_id", "role_id").
+		Values(organizationID, userID, roleID).
+		Suffix("ON CONFLICT (organization_id, user_id) DO UPDATE SET role_id = EXCLUDED.role_id").
+		ToSql()
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
+		return "", errors.Wrap(err, "unable to build a query string")
+	}
+
+	if _, err = trx.ExecContext(ctx, memberQuery, args...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "member table rollback error")
+		}
+
+		return "", errors.Wrap(err, "member insert query error")
+	}
+
+	return userID, errors.Wrap(trx.Commit(), "transaction commit error")
+}
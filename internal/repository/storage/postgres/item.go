@@ -117,9 +117,7 @@ func (r *Repository) itemGetAllQuery(meta query.MetaData, params queryparameter.
 		})
 	}
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	if len(params.Sorts) > 0 {
 		builder = builder.OrderBy(params.Sorts.Parsing(mappingSortItem)...)
@@ -165,9 +163,7 @@ func (r *Repository) ItemGetOne(ctxr context.Context, meta query.MetaData, itemI
 		From(itemTable).
 		Where(squirrel.Eq{"is_deleted": false, "id": itemID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -339,9 +335,7 @@ func (r *Repository) ItemUpdate(ctxr context.Context, meta query.MetaData, input
 		Set("updated_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": *input.ID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -371,9 +365,7 @@ func (r *Repository) ItemDelete(ctxr context.Context, meta query.MetaData, itemI
 		Set("deleted_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": itemID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -0,0 +1,219 @@
+package postgres
+
+import (
+	"database/sql"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/member"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+var memberColumns = []string{
+	"om.user_id", "om.organization_id", "us.phone", "us.first_name", "us.last_name", "ro.name AS role", "om.status",
+	"om.joined_at",
+}
+
+// MemberGetAll returns members of the organization from database.
+func (r *Repository) MemberGetAll(ctxr context.Context, organizationID string) ([]member.Member, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.MemberGetAll")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var members []member.Member
+
+	builder := r.genSQL.Select(memberColumns...).
+		From(memberTable + " om").
+		InnerJoin(userTable + " us ON us.id = om.user_id").
+		InnerJoin(roleTable + " ro ON ro.id = om.role_id").
+		Where(squirrel.Eq{"om.organization_id": organizationID, "us.is_deleted": false}).
+		OrderBy("om.joined_at ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return members, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &members, qry, args...)
+
+	return members, errors.Wrap(err, "members select query error")
+}
+
+// MemberGetOne returns member of the organization by user id from database.
+func (r *Repository) MemberGetOne(ctxr context.Context, organizationID string, userID string) (member.Member, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.MemberGetOne")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var mbr member.Member
+
+	builder := r.genSQL.Select(memberColumns...).
+		From(memberTable + " om").
+		InnerJoin(userTable + " us ON us.id = om.user_id").
+		InnerJoin(roleTable + " ro ON ro.id = om.role_id").
+		Where(squirrel.Eq{"om.organization_id": organizationID, "om.user_id": userID, "us.is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return mbr, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &mbr, qry, args...)
+
+	return mbr, errors.Wrap(err, "member select query error")
+}
+
+// MemberCreate inserts member of the organization into database.
+func (r *Repository) MemberCreate(ctxr context.Context, organizationID string, input member.AddMemberInput) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.MemberCreate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Insert(memberTable).
+		Columns("organization_id", "user_id", "role_id", "status").
+		Values(
+			organizationID,
+			input.UserID,
+			squirrel.Expr("(SELECT id FROM "+roleTable+" WHERE name = ?)", input.Role),
+			member.StatusActive,
+		)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "member insert query error")
+}
+
+// MemberUpdate changes role or status of the member in database.
+func (r *Repository) MemberUpdate(ctxr context.Context, organizationID string, userID string, input member.UpdateMemberInput) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.MemberUpdate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Update(memberTable)
+
+	if input.Role != nil {
+		builder = builder.Set("role_id", squirrel.Expr("(SELECT id FROM "+roleTable+" WHERE name = ?)", *input.Role))
+	}
+
+	if input.Status != nil {
+		builder = builder.Set("status", *input.Status)
+	}
+
+	builder = builder.Where(squirrel.Eq{"organization_id": organizationID, "user_id": userID})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	result, err := r.database.ExecContext(ctx, qry, args...)
+	if err != nil {
+		return errors.Wrap(err, "member update query error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "member update query error")
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// MemberDelete removes member from the organization in database.
+func (r *Repository) MemberDelete(ctxr context.Context, organizationID string, userID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.MemberDelete")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Delete(memberTable).
+		Where(squirrel.Eq{"organization_id": organizationID, "user_id": userID})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	result, err := r.database.ExecContext(ctx, qry, args...)
+	if err != nil {
+		return errors.Wrap(err, "member delete query error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "member delete query error")
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// MemberGetOrganizationOwner returns id of the user who owns the organization.
+func (r *Repository) MemberGetOrganizationOwner(ctxr context.Context, organizationID string) (string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.MemberGetOrganizationOwner")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var userID string
+
+	builder := r.genSQL.Select("user_id").
+		From(organizationTable).
+		Where(squirrel.Eq{"id": organizationID, "is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return userID, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &userID, qry, args...)
+
+	return userID, errors.Wrap(err, "organization owner select query error")
+}
@@ -61,8 +61,10 @@ func (r *Repository) orderGetAllQuery(meta query.MetaData, params queryparameter
 		})
 	}
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
+	builder = builder.Where(organizationFilter(meta))
+
+	if meta.OwnerID != "" {
+		builder = builder.Where(squirrel.Eq{"user_id": meta.OwnerID})
 	}
 
 	if len(params.Sorts) > 0 {
@@ -106,9 +108,7 @@ func (r *Repository) OrderGetOne(ctxr context.Context, meta query.MetaData, orde
 		From(orderTable).
 		Where(squirrel.Eq{"is_deleted": false, "id": orderID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -223,9 +223,7 @@ func (r *Repository) OrderUpdate(ctxr context.Context, meta query.MetaData, inpu
 		Set("updated_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": *input.ID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -298,9 +296,7 @@ func (r *Repository) OrderDelete(ctxr context.Context, meta query.MetaData, orde
 		Set("deleted_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": orderID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/member"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/organization"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -38,7 +39,7 @@ func (r *Repository) OrganizationGetAll(ctxr context.Context, meta query.MetaDat
 
 // organizationGetAllQuery creates sql query.
 func (r *Repository) organizationGetAllQuery(meta query.MetaData, params queryparameter.QueryParameter) (string, []interface{}, error) { //nolint:lll
-	builder := r.genSQL.Select("id", "name", "user_id", "address", "phone").From(organizationTable)
+	builder := r.genSQL.Select("id", "name", "slug", "user_id", "address", "phone").From(organizationTable)
 
 	if params.Search != "" {
 		search := "%" + params.Search + "%"
@@ -73,8 +74,16 @@ func (r *Repository) organizationGetAllQuery(meta query.MetaData, params querypa
 		})
 	}
 
-	if meta.RoleName != vendorRole {
-		builder = builder.Where(squirrel.Eq{"user_id": meta.UserID})
+	// organizations the user owns or works in as an active member
+	if meta.RoleName != adminRole {
+		builder = builder.Where(squirrel.Or{
+			squirrel.Eq{"user_id": meta.UserID},
+			squirrel.Expr(
+				"id IN (SELECT organization_id FROM "+memberTable+" WHERE user_id = ? AND status = ?)",
+				meta.UserID,
+				member.StatusActive,
+			),
+		})
 	}
 
 	if len(params.Sorts) > 0 {
@@ -113,7 +122,7 @@ func (r *Repository) OrganizationGetOne(ctxr context.Context, meta query.MetaDat
 
 	var org organization.Organization
 
-	builder := r.genSQL.Select("id", "name", "user_id", "address", "phone").From(organizationTable).
+	builder := r.genSQL.Select("id", "name", "slug", "user_id", "address", "phone").From(organizationTable).
 		Where(squirrel.Eq{"is_deleted": false, "id": organizationID})
 
 	if meta.RoleName != vendorRole {
@@ -130,7 +139,7 @@ func (r *Repository) OrganizationGetOne(ctxr context.Context, meta query.MetaDat
 	return org, errors.Wrap(err, "organization select query error")
 }
 
-// OrganizationCreate insert organization into database.
+// OrganizationCreate insert organization into database, the owner becomes its member.
 func (r *Repository) OrganizationCreate(ctxr context.Context, meta query.MetaData, input organization.CreateOrganizationInput) (string, error) { //nolint:lll
 	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
 	defer ctx.Cancel()
@@ -144,21 +153,65 @@ func (r *Repository) OrganizationCreate(ctxr context.Context, meta query.MetaDat
 
 	var organizationID string
 
-	builder := r.genSQL.Insert(organizationTable).
-		Columns("name", "user_id", "address", "phone", "user_created").
-		Values(input.Name, meta.UserID, input.Address, input.Phone, meta.UserID).
-		Suffix("RETURNING \"id\"")
+	trx, err := r.database.Begin()
+	if err != nil {
+		return "", errors.Wrap(err, "transaction begin error")
+	}
 
-	qry, args, err := builder.ToSql()
+	qry, args, err := r.genSQL.Insert(organizationTable).
+		Columns("name", "slug", "user_id", "address", "phone", "user_created").
+		Values(input.Name, input.Slug, meta.UserID, input.Address, input.Phone, meta.UserID).
+		Suffix("RETURNING \"id\"").
+		ToSql()
 	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
+
 		return "", errors.Wrap(err, "unable to build a query string")
 	}
 
-	row := r.database.QueryRowContext(ctx, qry, args...)
+	if err = trx.QueryRowContext(ctx, qry, args...).Scan(&organizationID); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return "", errors.Wrap(errRollback, "transaction rollback error")
+		}
 
-	err = row.Scan(&organizationID)
+		if isUniqueViolation(err) {
+			return "", organization.ErrSlugTaken
+		}
 
-	return organizationID, errors.Wrap(err, "organization create query error")
+		return "", errors.Wrap(err, "organization create query error")
+	}
+
+	// the owner manages the staff of the organization as its vendor
+	if meta.RoleName != adminRole {
+		memberQuery, args, err := r.genSQL.Insert(memberTable).
+			Columns("organization_id", "user_id", "role_id", "status").
+			Values(
+				organizationID,
+				meta.UserID,
+				squirrel.Expr("(SELECT id FROM "+roleTable+" WHERE name = ?)", vendorRole),
+				member.StatusActive,
+			).
+			ToSql()
+		if err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return "", errors.Wrap(errRollback, "transaction rollback error")
+			}
+
+			return "", errors.Wrap(err, "unable to build a query string")
+		}
+
+		if _, err = trx.ExecContext(ctx, memberQuery, args...); err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return "", errors.Wrap(errRollback, "member table rollback error")
+			}
+
+			return "", errors.Wrap(err, "member insert query error")
+		}
+	}
+
+	return organizationID, errors.Wrap(trx.Commit(), "transaction commit error")
 }
 
 // OrganizationUpdate updates organization by id in database.
@@ -179,6 +232,10 @@ func (r *Repository) OrganizationUpdate(ctxr context.Context, meta query.MetaDat
 		builder = builder.Set("name", *input.Name)
 	}
 
+	if input.Slug != nil {
+		builder = builder.Set("slug", *input.Slug)
+	}
+
 	if input.Address != nil {
 		builder = builder.Set("address", *input.Address)
 	}
@@ -201,6 +258,9 @@ func (r *Repository) OrganizationUpdate(ctxr context.Context, meta query.MetaDat
 	}
 
 	_, err = r.database.ExecContext(ctx, qry, args...)
+	if isUniqueViolation(err) {
+		return organization.ErrSlugTaken
+	}
 
 	return errors.Wrap(err, "organization update query error")
 }
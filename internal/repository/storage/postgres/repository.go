@@ -4,9 +4,15 @@ import (
 	"time"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/pkg/errors"
 )
 
+// uniqueViolation is a postgres error code of unique constraint violation.
+const uniqueViolation = "23505"
+
 type Repository struct {
 	genSQL      squirrel.StatementBuilderType
 	database    *sqlx.DB
@@ -26,3 +32,25 @@ func New(database *sqlx.DB, options Options, isTracingOn bool) *Repository {
 		isTracingOn: isTracingOn,
 	}
 }
+
+// isUniqueViolation checks if the query failed because of unique constraint.
+func isUniqueViolation(err error) bool {
+	var pqErr *pq.Error
+
+	return errors.As(err, &pqErr) && pqErr.Code == uniqueViolation
+}
+
+// organizationFilter returns condition limiting rows to the organization of the request. Admins and customers,
+// who belong to no organization, are not limited if the organization is not chosen, requests of any other role
+// without an organization match no rows.
+func organizationFilter(meta query.MetaData) squirrel.Sqlizer {
+	if meta.OrganizationID != "" {
+		return squirrel.Eq{"organization_id": meta.OrganizationID}
+	}
+
+	if meta.RoleName == adminRole || meta.RoleName == customerRole {
+		return squirrel.And{}
+	}
+
+	return squirrel.Expr("FALSE")
+}
@@ -1,6 +1,8 @@
 package postgres
 
 import (
+	"database/sql"
+
 	"github.com/Masterminds/squirrel"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
@@ -143,7 +145,7 @@ func (r *Repository) RuleUpdate(ctxr context.Context, _ query.MetaData, input ru
 		ctx = context.New(ctxt)
 	}
 
-	builder := r.genSQL.Update(tableTable)
+	builder := r.genSQL.Update(ruleTable)
 
 	if input.Ptype != nil {
 		builder = builder.Set("ptype", *input.Ptype)
@@ -208,3 +210,247 @@ func (r *Repository) RuleDelete(ctxr context.Context, _ query.MetaData, ruleID s
 
 	return errors.Wrap(err, "rule delete query error")
 }
+
+// RuleGetRoleParents selects roles the role inherits directly.
+func (r *Repository) RuleGetRoleParents(ctxr context.Context, roleName string) ([]string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.RuleGetRoleParents")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var parents []string
+
+	builder := r.genSQL.Select("v1").
+		From(ruleTable).
+		Where(squirrel.Eq{"ptype": groupingPolicyType, "v0": roleName, "v2": allDomains}).
+		OrderBy("v1 ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &parents, qry, args...)
+
+	return parents, errors.Wrap(err, "role parents select query error")
+}
+
+// RuleRoleExists checks if the role exists in database.
+func (r *Repository) RuleRoleExists(ctxr context.Context, roleName string) (bool, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.RuleRoleExists")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var exists bool
+
+	builder := r.genSQL.Select("COUNT(*) > 0").From(roleTable).Where(squirrel.Eq{"name": roleName})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return false, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &exists, qry, args...)
+
+	return exists, errors.Wrap(err, "role select query error")
+}
+
+// RuleCreateRoleParent inserts grouping of the role into the parent role in all organizations.
+func (r *Repository) RuleCreateRoleParent(ctxr context.Context, roleName string, parent string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.RuleCreateRoleParent")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Insert(ruleTable).
+		Columns("ptype", "v0", "v1", "v2").
+		Values(groupingPolicyType, roleName, parent, allDomains)
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "role parent create query error")
+}
+
+// RuleDeleteRoleParent deletes grouping of the role into the parent role.
+func (r *Repository) RuleDeleteRoleParent(ctxr context.Context, roleName string, parent string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.RuleDeleteRoleParent")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Delete(ruleTable).
+		Where(squirrel.Eq{"ptype": groupingPolicyType, "v0": roleName, "v1": parent, "v2": allDomains})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	result, err := r.database.ExecContext(ctx, qry, args...)
+	if err != nil {
+		return errors.Wrap(err, "role parent delete query error")
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "role parent delete query error")
+	}
+
+	if affected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+// RuleGetRoleNames selects names of all roles from database.
+func (r *Repository) RuleGetRoleNames(ctxr context.Context) ([]string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.RuleGetRoleNames")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var names []string
+
+	qry, args, err := r.genSQL.Select("name").From(roleTable).OrderBy("name ASC").ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &names, qry, args...)
+
+	return names, errors.Wrap(err, "role names select query error")
+}
+
+// RuleGetPolicy selects the full policy set from database.
+func (r *Repository) RuleGetPolicy(ctxr context.Context) ([]rule.PolicyRule, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.RuleGetPolicy")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var rules []rule.PolicyRule
+
+	builder := r.genSQL.Select("ptype", "v0", "v1", "v2", "v3", "v4", "v5").
+		From(ruleTable).
+		OrderBy("ptype DESC", "v0 ASC", "v1 ASC", "v2 ASC", "v3 ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &rules, qry, args...)
+
+	return rules, errors.Wrap(err, "policy select query error")
+}
+
+// RulePatchPolicy deletes and inserts policy rules in one transaction.
+func (r *Repository) RulePatchPolicy(ctxr context.Context, added []rule.PolicyRule, removed []rule.PolicyRule) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.RulePatchPolicy")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return errors.Wrap(err, "transaction begin error")
+	}
+
+	for _, rle := range removed {
+		qry, args, err := r.genSQL.Delete(ruleTable).
+			Where(squirrel.Eq{
+				"ptype": rle.Ptype,
+				"v0":    rle.V0,
+				"v1":    rle.V1,
+				"v2":    rle.V2,
+				"v3":    rle.V3,
+				"v4":    rle.V4,
+				"v5":    rle.V5,
+			}).
+			ToSql()
+		if err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return errors.Wrap(errRollback, "policy rollback error")
+			}
+
+			return errors.Wrap(err, "unable to build a query string")
+		}
+
+		if _, err = trx.ExecContext(ctx, qry, args...); err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return errors.Wrap(errRollback, "policy rollback error")
+			}
+
+			return errors.Wrap(err, "policy delete query error")
+		}
+	}
+
+	if len(added) > 0 {
+		builder := r.genSQL.Insert(ruleTable).Columns("ptype", "v0", "v1", "v2", "v3", "v4", "v5")
+
+		for _, rle := range added {
+			builder = builder.Values(rle.Ptype, rle.V0, rle.V1, rle.V2, rle.V3, rle.V4, rle.V5)
+		}
+
+		qry, args, err := builder.ToSql()
+		if err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return errors.Wrap(errRollback, "policy rollback error")
+			}
+
+			return errors.Wrap(err, "unable to build a query string")
+		}
+
+		if _, err = trx.ExecContext(ctx, qry, args...); err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return errors.Wrap(errRollback, "policy rollback error")
+			}
+
+			return errors.Wrap(err, "policy insert query error")
+		}
+	}
+
+	return errors.Wrap(trx.Commit(), "transaction commit error")
+}
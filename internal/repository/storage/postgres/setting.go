@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// SettingGetOne selects settings of the organization from database.
+func (r *Repository) SettingGetOne(ctxr context.Context, organizationID string) (setting.Settings, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.SettingGetOne")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var settings setting.Settings
+
+	builder := r.genSQL.Select(
+		"organization_id", "currency", "timezone", "locales", "default_locale", "working_hours", "exceptions", "tax",
+		"order_prefix",
+	).
+		From(settingTable).
+		Where(squirrel.Eq{"organization_id": organizationID})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return settings, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &settings, qry, args...)
+
+	return settings, errors.Wrap(err, "settings select query error")
+}
+
+// SettingUpdate inserts or replaces settings of the organization in database.
+func (r *Repository) SettingUpdate(ctxr context.Context, meta query.MetaData, settings setting.Settings) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.SettingUpdate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	builder := r.genSQL.Insert(settingTable).
+		Columns(
+			"organization_id", "currency", "timezone", "locales", "default_locale", "working_hours", "exceptions", "tax",
+			"order_prefix", "user_updated", "updated_at",
+		).
+		Values(
+			settings.OrganizationID,
+			settings.Currency,
+			settings.Timezone,
+			settings.Locales,
+			settings.DefaultLocale,
+			settings.WorkingHours,
+			settings.Exceptions,
+			settings.Tax,
+			settings.OrderPrefix,
+			meta.UserID,
+			time.Now().UTC(),
+		).
+		Suffix("ON CONFLICT (organization_id) DO UPDATE SET " +
+			"currency = EXCLUDED.currency, timezone = EXCLUDED.timezone, locales = EXCLUDED.locales, " +
+			"default_locale = EXCLUDED.default_locale, working_hours = EXCLUDED.working_hours, " +
+			"exceptions = EXCLUDED.exceptions, tax = EXCLUDED.tax, order_prefix = EXCLUDED.order_prefix, " +
+			"user_updated = EXCLUDED.user_updated, updated_at = EXCLUDED.updated_at")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	_, err = r.database.ExecContext(ctx, qry, args...)
+
+	return errors.Wrap(err, "settings update query error")
+}
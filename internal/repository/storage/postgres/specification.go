@@ -57,9 +57,7 @@ func (r *Repository) specificationGetAllQuery(meta query.MetaData, params queryp
 		})
 	}
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	if len(params.Sorts) > 0 {
 		builder = builder.OrderBy(params.Sorts.Parsing(mappingSortSpecification)...)
@@ -102,9 +100,7 @@ func (r *Repository) SpecificationGetOne(ctxr context.Context, meta query.MetaDa
 		From(specificationTable).
 		Where(squirrel.Eq{"id": specificationID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -210,9 +206,7 @@ func (r *Repository) SpecificationUpdate(ctxr context.Context, meta query.MetaDa
 
 	builder = builder.Where(squirrel.Eq{"id": *input.ID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -238,9 +232,7 @@ func (r *Repository) SpecificationDelete(ctxr context.Context, meta query.MetaDa
 
 	builder := r.genSQL.Delete(specificationTable).Where(squirrel.Eq{"id": specificationID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
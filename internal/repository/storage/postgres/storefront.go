@@ -0,0 +1,230 @@
+package postgres
+
+import (
+	"github.com/Masterminds/squirrel"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/comment"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/item"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/storefront"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+)
+
+var storefrontItemColumns = []string{
+	"id", "name_tm", "name_ru", "name_tr", "name_en", "description_tm", "description_ru", "description_tr",
+	"description_en", "internal_id", "price", "rating", "comments_qty", "category_id", "organization_id", "brand_id",
+	"created_at",
+}
+
+// StorefrontGetOrganization selects not deleted organization by slug from database.
+func (r *Repository) StorefrontGetOrganization(ctxr context.Context, slug string) (storefront.Organization, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.StorefrontGetOrganization")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var org storefront.Organization
+
+	builder := r.genSQL.Select("id", "slug", "name", "address", "phone").
+		From(organizationTable).
+		Where(squirrel.Eq{"slug": slug, "is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return org, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.GetContext(ctx, &org, qry, args...)
+
+	return org, errors.Wrap(err, "organization select query error")
+}
+
+// StorefrontGetCategories selects not deleted categories of the organization from database, parents first.
+func (r *Repository) StorefrontGetCategories(ctxr context.Context, organizationID string) ([]storefront.Category, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.StorefrontGetCategories")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var categories []storefront.Category
+
+	builder := r.genSQL.Select(
+		"id", "name_tm", "name_ru", "name_tr", "name_en", "COALESCE(parent_id, '') AS parent_id", "level").
+		From(categoryTable).
+		Where(squirrel.Eq{"organization_id": organizationID, "is_deleted": false}).
+		OrderBy("level ASC", "name_en ASC")
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return categories, errors.Wrap(err, "unable to build a query string")
+	}
+
+	err = r.database.SelectContext(ctx, &categories, qry, args...)
+
+	return categories, errors.Wrap(err, "categories select query error")
+}
+
+// StorefrontGetItems selects not deleted items of the organization with their main images from database.
+func (r *Repository) StorefrontGetItems(ctxr context.Context, organizationID string, filter storefront.ItemFilter) ([]item.Item, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.StorefrontGetItems")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var items []item.Item
+
+	builder := r.genSQL.Select(storefrontItemColumns...).
+		From(itemTable).
+		Where(squirrel.Eq{"organization_id": organizationID, "is_deleted": false}).
+		OrderBy("created_at DESC")
+
+	if filter.CategoryID != "" {
+		builder = builder.Where(squirrel.Eq{"category_id": filter.CategoryID})
+	}
+
+	if filter.Limit > 0 {
+		builder = builder.Limit(filter.Limit)
+	}
+
+	if filter.Offset > 0 {
+		builder = builder.Offset(filter.Offset)
+	}
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to build a query string")
+	}
+
+	if err = r.database.SelectContext(ctx, &items, qry, args...); err != nil {
+		return nil, errors.Wrap(err, "items select query error")
+	}
+
+	egroup := &errgroup.Group{}
+
+	for i := 0; i < len(items); i++ {
+		index := i
+
+		egroup.Go(func() error {
+			builder := r.genSQL.Select(
+				"id", "object_id", "type", "origin", "middle", "small", "organization_id", "is_main").
+				From(imageTable).
+				Where(squirrel.Eq{"is_main": true, "is_deleted": false, "object_id": items[index].ID})
+
+			qryImages, argsImages, err := builder.ToSql()
+			if err != nil {
+				return errors.Wrap(err, "unable to build a query string")
+			}
+
+			err = r.database.SelectContext(ctx, &items[index].Images, qryImages, argsImages...)
+
+			return errors.Wrap(err, "images select query error")
+		})
+	}
+
+	err = egroup.Wait()
+
+	return items, errors.Wrap(err, "items select query error")
+}
+
+// StorefrontGetItem selects not deleted item of the organization with its images, specifications and approved
+// comments from database. Authors of the comments are not selected.
+func (r *Repository) StorefrontGetItem(ctxr context.Context, organizationID string, itemID string) (item.Item, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.StorefrontGetItem")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var itm item.Item
+
+	builder := r.genSQL.Select(storefrontItemColumns...).
+		From(itemTable).
+		Where(squirrel.Eq{"id": itemID, "organization_id": organizationID, "is_deleted": false})
+
+	qry, args, err := builder.ToSql()
+	if err != nil {
+		return itm, errors.Wrap(err, "unable to build a query string")
+	}
+
+	if err = r.database.GetContext(ctx, &itm, qry, args...); err != nil {
+		return itm, errors.Wrap(err, "item select query error")
+	}
+
+	egroup := &errgroup.Group{}
+
+	egroup.Go(func() error {
+		builderImages := r.genSQL.Select(
+			"id", "object_id", "type", "origin", "middle", "small", "organization_id", "is_main").
+			From(imageTable).
+			Where(squirrel.Eq{"object_id": itm.ID, "is_deleted": false}).
+			OrderBy("is_main DESC")
+
+		qryImages, argsImages, err := builderImages.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "unable to build a query string")
+		}
+
+		err = r.database.SelectContext(ctx, &itm.Images, qryImages, argsImages...)
+
+		return errors.Wrap(err, "images select query error")
+	})
+
+	egroup.Go(func() error {
+		builderSpecifications := r.genSQL.Select(
+			"id", "item_id", "organization_id", "name_tm", "name_ru", "name_tr", "name_en",
+			"description_tm", "description_ru", "description_tr", "description_en", "value").
+			From(specificationTable).
+			Where(squirrel.Eq{"item_id": itm.ID})
+
+		qrySpecifications, argsSpecifications, err := builderSpecifications.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "unable to build a query string")
+		}
+
+		err = r.database.SelectContext(ctx, &itm.Specification, qrySpecifications, argsSpecifications...)
+
+		return errors.Wrap(err, "specification select query error")
+	})
+
+	egroup.Go(func() error {
+		builderComments := r.genSQL.Select(
+			"id", "item_id", "organization_id", "content", "status_id", "rating", "created_at").
+			From(commentTable).
+			Where(squirrel.Eq{"is_deleted": false, "item_id": itm.ID}).
+			Where(squirrel.Expr("status_id = (SELECT id FROM "+commentStatusTable+" WHERE name = ?)", comment.StatusApproved)).
+			OrderBy("created_at DESC")
+
+		qryComments, argsComments, err := builderComments.ToSql()
+		if err != nil {
+			return errors.Wrap(err, "unable to build a query string")
+		}
+
+		err = r.database.SelectContext(ctx, &itm.Comments, qryComments, argsComments...)
+
+		return errors.Wrap(err, "comments select query error")
+	})
+
+	err = egroup.Wait()
+
+	return itm, errors.Wrap(err, "item select query error")
+}
@@ -69,9 +69,7 @@ func (r *Repository) tableGetAllQuery(meta query.MetaData, params queryparameter
 		})
 	}
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	if len(params.Sorts) > 0 {
 		builder = builder.OrderBy(params.Sorts.Parsing(mappingSortTable)...)
@@ -112,9 +110,7 @@ func (r *Repository) TableGetOne(ctxr context.Context, meta query.MetaData, tabl
 	builder := r.genSQL.Select("id", "name", "organization_id").From(tableTable).
 		Where(squirrel.Eq{"is_deleted": false, "id": tableID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -182,9 +178,7 @@ func (r *Repository) TableUpdate(ctxr context.Context, meta query.MetaData, inpu
 		Set("updated_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": *input.ID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
@@ -214,9 +208,7 @@ func (r *Repository) TableDelete(ctxr context.Context, meta query.MetaData, tabl
 		Set("deleted_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": tableID})
 
-	if meta.OrganizationID != "" {
-		builder = builder.Where(squirrel.Eq{"organization_id": meta.OrganizationID})
-	}
+	builder = builder.Where(organizationFilter(meta))
 
 	qry, args, err := builder.ToSql()
 	if err != nil {
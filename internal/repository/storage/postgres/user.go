@@ -1,6 +1,7 @@
 package postgres
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/Masterminds/squirrel"
@@ -38,9 +39,10 @@ func (r *Repository) UserGetAll(ctxr context.Context, meta query.MetaData, param
 }
 
 // userGetAllQuery creates sql query.
-func (r *Repository) userGetAllQuery(_ query.MetaData, params queryparameter.QueryParameter) (string, []interface{}, error) { //nolint:lll
+func (r *Repository) userGetAllQuery(meta query.MetaData, params queryparameter.QueryParameter) (string, []interface{}, error) { //nolint:lll
 	builder := r.genSQL.Select(
 		"us.id", "us.phone", "us.first_name", "us.last_name", "ro.name AS role", "st.name AS status",
+		"us.status_reason", "us.suspended_until",
 	).From(userTable + " us").
 		InnerJoin(userRoleTable + " ur ON ur.user_id = us.id").
 		InnerJoin(roleTable + " ro ON ur.role_id = ro.id").
@@ -63,6 +65,10 @@ func (r *Repository) userGetAllQuery(_ query.MetaData, params queryparameter.Que
 		builder = builder.Where(squirrel.Eq{"us.is_deleted": false})
 	}
 
+	if meta.OwnerID != "" {
+		builder = builder.Where(squirrel.Eq{"us.id": meta.OwnerID})
+	}
+
 	switch {
 	case !params.StartDate.IsZero() && params.EndDate.IsZero():
 		builder = builder.Where(squirrel.And{
@@ -173,6 +179,7 @@ func (r *Repository) UserGetOne(ctxr context.Context, _ query.MetaData, userID s
 
 	builder := r.genSQL.Select(
 		"us.id", "us.phone", "us.first_name", "us.last_name", "ro.name AS role", "st.name AS status",
+		"us.status_reason", "us.suspended_until",
 	).From(userTable + " us").
 		InnerJoin(userRoleTable + " ur ON ur.user_id = us.id").
 		InnerJoin(roleTable + " ro ON ur.role_id = ro.id").
@@ -270,10 +277,6 @@ func (r *Repository) UserUpdate(ctxr context.Context, meta query.MetaData, input
 		builder = builder.Set("last_name", *input.LastName)
 	}
 
-	if input.Password != nil {
-		builder = builder.Set("password", *input.Password)
-	}
-
 	builder = builder.Set("user_updated", meta.UserID).
 		Set("updated_at", time.Now().UTC()).
 		Where(squirrel.Eq{"is_deleted": false, "id": *input.ID})
@@ -315,3 +318,89 @@ func (r *Repository) UserDelete(ctxr context.Context, meta query.MetaData, userI
 
 	return errors.Wrap(err, "user delete query error")
 }
+
+// UserSetStatus changes status of the user, records it in the status history and,
+// unless the user is reactivated, revokes all sessions of the user.
+func (r *Repository) UserSetStatus(ctxr context.Context, meta query.MetaData, userID string, status string, input user.ChangeStatusInput) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Database.UserSetStatus")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	statusID := squirrel.Expr("(SELECT id FROM "+statusTable+" WHERE name = ?)", status)
+
+	trx, err := r.database.Begin()
+	if err != nil {
+		return errors.Wrap(err, "transaction begin error")
+	}
+
+	updateQuery, args, err := r.genSQL.Update(userTable).
+		Set("status_id", statusID).
+		Set("status_reason", input.Reason).
+		Set("suspended_until", input.Until).
+		Set("user_updated", meta.UserID).
+		Set("updated_at", time.Now().UTC()).
+		Where(squirrel.Eq{"id": userID, "is_deleted": false}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	result, err := trx.ExecContext(ctx, updateQuery, args...)
+	if err == nil {
+		var affected int64
+
+		if affected, err = result.RowsAffected(); err == nil && affected == 0 {
+			err = sql.ErrNoRows
+		}
+	}
+
+	if err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "user status rollback error")
+		}
+
+		return errors.Wrap(err, "user status update query error")
+	}
+
+	historyQuery, args, err := r.genSQL.Insert(statusHistoryTable).
+		Columns("user_id", "status_id", "reason", "suspended_until", "user_created").
+		Values(userID, statusID, input.Reason, input.Until, meta.UserID).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "unable to build a query string")
+	}
+
+	if _, err = trx.ExecContext(ctx, historyQuery, args...); err != nil {
+		if errRollback := trx.Rollback(); errRollback != nil {
+			return errors.Wrap(errRollback, "user status history rollback error")
+		}
+
+		return errors.Wrap(err, "user status history insert query error")
+	}
+
+	if status != user.StatusActive {
+		revokeQuery, args, err := r.genSQL.Update(tokenTable).
+			Set("expired", true).
+			Where(squirrel.Eq{"user_id": userID, "expired": false}).
+			ToSql()
+		if err != nil {
+			return errors.Wrap(err, "unable to build a query string")
+		}
+
+		if _, err = trx.ExecContext(ctx, revokeQuery, args...); err != nil {
+			if errRollback := trx.Rollback(); errRollback != nil {
+				return errors.Wrap(errRollback, "sessions rollback error")
+			}
+
+			return errors.Wrap(err, "sessions revoke query error")
+		}
+	}
+
+	return errors.Wrap(trx.Commit(), "transaction commit error")
+}
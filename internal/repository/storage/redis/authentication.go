@@ -0,0 +1,317 @@
+package redis
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/mailru/easyjson"
+	"github.com/pkg/errors"
+)
+
+// AuthenticationSetOTP sets one-time code hash into cache, attempts of the phone number are kept.
+func (r *Repository) AuthenticationSetOTP(ctxr context.Context, purpose string, phone string, hash string, ttl time.Duration) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationSetOTP")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	err := r.client.Set(ctx, otpKey+purpose+"."+phone, hash, ttl).Err()
+
+	return errors.Wrap(err, "unable to set one-time code into cache")
+}
+
+// AuthenticationGetOTP gets one-time code hash from cache.
+func (r *Repository) AuthenticationGetOTP(ctxr context.Context, purpose string, phone string) (string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationGetOTP")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	hash, err := r.client.Get(ctx, otpKey+purpose+"."+phone).Result()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get one-time code from cache")
+	}
+
+	return hash, nil
+}
+
+// AuthenticationIncrOTPAttempts increments verification attempts of one-time codes sent to the phone number
+// within the window, including codes sent before.
+func (r *Repository) AuthenticationIncrOTPAttempts(ctxr context.Context, purpose string, phone string, window time.Duration) (int64, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationIncrOTPAttempts")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	key := otpAttemptsKey + purpose + "." + phone
+
+	pipe := r.client.TxPipeline()
+	attempts := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errors.Wrap(err, "unable to increment one-time code attempts")
+	}
+
+	return attempts.Val(), nil
+}
+
+// AuthenticationDeleteOTP deletes one-time code from cache, resetAttempts also deletes attempts of the phone number.
+func (r *Repository) AuthenticationDeleteOTP(ctxr context.Context, purpose string, phone string, resetAttempts bool) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationDeleteOTP")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	keys := []string{otpKey + purpose + "." + phone}
+	if resetAttempts {
+		keys = append(keys, otpAttemptsKey+purpose+"."+phone)
+	}
+
+	err := r.client.Del(ctx, keys...).Err()
+
+	return errors.Wrap(err, "unable to delete one-time code from cache")
+}
+
+// AuthenticationLockOTPResend locks sending of a new one-time code for ttl, returns false if already locked.
+func (r *Repository) AuthenticationLockOTPResend(ctxr context.Context, purpose string, phone string, ttl time.Duration) (bool, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationLockOTPResend")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	locked, err := r.client.SetNX(ctx, otpResendKey+purpose+"."+phone, 1, ttl).Result()
+
+	return locked, errors.Wrap(err, "unable to lock one-time code resend")
+}
+
+// AuthenticationIncrLoginFailures increments failed sign in attempts of the subject within the window.
+func (r *Repository) AuthenticationIncrLoginFailures(ctxr context.Context, subject string, window time.Duration) (int64, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationIncrLoginFailures")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	key := loginFailuresKey + subject
+
+	pipe := r.client.TxPipeline()
+	failures := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, window)
+
+	if _, err := pipe.Exec(ctx); err != nil {
+		return 0, errors.Wrap(err, "unable to increment failed sign in attempts")
+	}
+
+	return failures.Val(), nil
+}
+
+// AuthenticationLockLogin locks sign in of the subject for ttl.
+func (r *Repository) AuthenticationLockLogin(ctxr context.Context, subject string, ttl time.Duration) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationLockLogin")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	err := r.client.Set(ctx, loginLockKey+subject, 1, ttl).Err()
+
+	return errors.Wrap(err, "unable to lock sign in")
+}
+
+// AuthenticationGetLoginLock returns remaining sign in lock time of the subject, zero if it is not locked.
+func (r *Repository) AuthenticationGetLoginLock(ctxr context.Context, subject string) (time.Duration, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationGetLoginLock")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	ttl, err := r.client.PTTL(ctx, loginLockKey+subject).Result()
+	if err != nil {
+		return 0, errors.Wrap(err, "unable to get sign in lock from cache")
+	}
+
+	// negative values mean there is no key or no expiration
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+// AuthenticationResetLoginFailures deletes failed sign in attempts and lock of the subject.
+func (r *Repository) AuthenticationResetLoginFailures(ctxr context.Context, subject string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationResetLoginFailures")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	err := r.client.Del(ctx, loginFailuresKey+subject, loginLockKey+subject).Err()
+
+	return errors.Wrap(err, "unable to reset failed sign in attempts")
+}
+
+// AuthenticationSetChallenge stores user id of the two-factor sign in challenge.
+func (r *Repository) AuthenticationSetChallenge(ctxr context.Context, challenge string, userID string, ttl time.Duration) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationSetChallenge")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	err := r.client.Set(ctx, challengeKey+challenge, userID, ttl).Err()
+
+	return errors.Wrap(err, "unable to set challenge into cache")
+}
+
+// AuthenticationGetChallenge returns user id of the two-factor sign in challenge.
+func (r *Repository) AuthenticationGetChallenge(ctxr context.Context, challenge string) (string, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationGetChallenge")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := r.client.Get(ctx, challengeKey+challenge).Result()
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get challenge from cache")
+	}
+
+	return userID, nil
+}
+
+// AuthenticationDeleteChallenge deletes two-factor sign in challenge.
+func (r *Repository) AuthenticationDeleteChallenge(ctxr context.Context, challenge string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationDeleteChallenge")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	err := r.client.Del(ctx, challengeKey+challenge).Err()
+
+	return errors.Wrap(err, "unable to delete challenge from cache")
+}
+
+// AuthenticationUseTOTPStep marks TOTP time step of the user as used, returns false if it was already used.
+func (r *Repository) AuthenticationUseTOTPStep(ctxr context.Context, userID string, step int64, ttl time.Duration) (bool, error) { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationUseTOTPStep")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	unused, err := r.client.SetNX(ctx, totpUsedKey+userID+"."+strconv.FormatInt(step, 10), 1, ttl).Result()
+
+	return unused, errors.Wrap(err, "unable to mark totp step as used")
+}
+
+// AuthenticationSetOIDCState stores pending OpenID Connect login by its state hash.
+func (r *Repository) AuthenticationSetOIDCState(ctxr context.Context, state string, value user.OIDCState, ttl time.Duration) error { //nolint:lll
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationSetOIDCState")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	bytes, err := easyjson.Marshal(value)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal json")
+	}
+
+	err = r.client.Set(ctx, oidcStateKey+state, bytes, ttl).Err()
+
+	return errors.Wrap(err, "unable to set oidc state into cache")
+}
+
+// AuthenticationPopOIDCState gets and deletes pending OpenID Connect login, so that the state can be used once.
+func (r *Repository) AuthenticationPopOIDCState(ctxr context.Context, state string) (user.OIDCState, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthenticationPopOIDCState")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var value user.OIDCState
+
+	bytes, err := r.client.GetDel(ctx, oidcStateKey+state).Bytes()
+	if err != nil {
+		return value, errors.Wrap(err, "unable to get oidc state from cache")
+	}
+
+	if err = easyjson.Unmarshal(bytes, &value); err != nil {
+		return value, errors.Wrap(err, "unable to unmarshal")
+	}
+
+	return value, nil
+}
@@ -1,8 +1,11 @@
 package redis
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/mailru/easyjson"
+	"github.com/pkg/errors"
 )
 
 // AuthorizationGetUserRole gets users role name from cache
@@ -41,3 +44,51 @@ func (r *Repository) AuthorizationSetUserRole(ctxr context.Context, userID strin
 
 	return err
 }
+
+// AuthorizationGetUserStatus gets users status from cache.
+func (r *Repository) AuthorizationGetUserStatus(ctxr context.Context, userID string) (user.AccountStatus, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthorizationGetUserStatus")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var status user.AccountStatus
+
+	bytes, err := r.client.Get(ctx, userStatusKey+userID).Bytes()
+	if err != nil {
+		return status, errors.Wrap(err, "unable to get user status from cache")
+	}
+
+	if err = easyjson.Unmarshal(bytes, &status); err != nil {
+		return status, errors.Wrap(err, "unable to unmarshal")
+	}
+
+	return status, nil
+}
+
+// AuthorizationSetUserStatus sets users status into cache.
+func (r *Repository) AuthorizationSetUserStatus(ctxr context.Context, userID string, status user.AccountStatus) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.AuthorizationSetUserStatus")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	bytes, err := easyjson.Marshal(status)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal json")
+	}
+
+	err = r.client.Set(ctx, userStatusKey+userID, bytes, r.options.Ttl).Err()
+
+	return errors.Wrap(err, "unable to set user status into cache")
+}
@@ -23,4 +23,14 @@ const (
 	imagesKey         = "images."
 	orderKey          = "order."
 	ordersKey         = "orders."
+	otpKey            = "otp.code."
+	otpAttemptsKey    = "otp.attempts."
+	otpResendKey      = "otp.resend."
+	loginFailuresKey  = "login.failures."
+	loginLockKey      = "login.lock."
+	challengeKey      = "twofactor.challenge."
+	totpUsedKey       = "twofactor.used."
+	oidcStateKey      = "oidc.state."
+	userStatusKey     = "user.status."
+	settingsKey       = "settings."
 )
@@ -24,7 +24,7 @@ func (r *Repository) OrganizationGetAll(ctxr context.Context, meta query.MetaDat
 
 	organizations := &organization.ListOrganization{}
 
-	bytes, err := r.client.Get(ctx, organizationsKey).Bytes()
+	bytes, err := r.client.Get(ctx, organizationsKey+"u."+meta.UserID).Bytes()
 	if err != nil {
 		return *organizations, errors.Wrap(err, "unable to get organizations from cache")
 	}
@@ -55,7 +55,7 @@ func (r *Repository) OrganizationSetAll(ctxr context.Context, meta query.MetaDat
 		return errors.Wrap(err, "unable to marshal json")
 	}
 
-	err = r.client.Set(ctx, organizationsKey, bytes, r.options.Ttl).Err()
+	err = r.client.Set(ctx, organizationsKey+"u."+meta.UserID, bytes, r.options.Ttl).Err()
 
 	return err
 }
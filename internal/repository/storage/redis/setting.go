@@ -0,0 +1,57 @@
+package redis
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/mailru/easyjson"
+	"github.com/pkg/errors"
+)
+
+// SettingGetOne gets settings of the organization from cache.
+func (r *Repository) SettingGetOne(ctxr context.Context, organizationID string) (setting.Settings, error) {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.SettingGetOne")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var settings setting.Settings
+
+	bytes, err := r.client.Get(ctx, settingsKey+organizationID).Bytes()
+	if err != nil {
+		return settings, errors.Wrap(err, "unable to get settings from cache")
+	}
+
+	if err = easyjson.Unmarshal(bytes, &settings); err != nil {
+		return settings, errors.Wrap(err, "unable to unmarshal")
+	}
+
+	return settings, nil
+}
+
+// SettingSet sets settings of the organization into cache.
+func (r *Repository) SettingSet(ctxr context.Context, settings setting.Settings) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.SettingSet")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	bytes, err := easyjson.Marshal(settings)
+	if err != nil {
+		return errors.Wrap(err, "unable to marshal json")
+	}
+
+	err = r.client.Set(ctx, settingsKey+settings.OrganizationID, bytes, r.options.Ttl).Err()
+
+	return errors.Wrap(err, "unable to set settings into cache")
+}
@@ -220,3 +220,20 @@ func (r *Repository) UserSetAllRoles(ctxr context.Context, meta query.MetaData,
 
 	return err
 }
+
+// UserDeleteStatus deletes cached status of the user after it has been changed.
+func (r *Repository) UserDeleteStatus(ctxr context.Context, userID string) error {
+	ctx := ctxr.CopyWithTimeout(r.options.Timeout)
+	defer ctx.Cancel()
+
+	if r.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctxr, "Cache.UserDeleteStatus")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	err := r.client.Del(ctx, userStatusKey+userID).Err()
+
+	return errors.Wrap(err, "unable to delete user status from cache")
+}
@@ -1,6 +1,8 @@
 package cache
 
 import (
+	"time"
+
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/category"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/comment"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/image"
@@ -9,6 +11,7 @@ import (
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/organization"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/specification"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/table"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
@@ -32,15 +35,34 @@ type Cache struct {
 	Specification
 	Favorite
 	Rule
+	Setting
 }
 
 // Authentication interface.
-type Authentication interface{}
+type Authentication interface {
+	AuthenticationSetOTP(ctx context.Context, purpose string, phone string, hash string, ttl time.Duration) error
+	AuthenticationGetOTP(ctx context.Context, purpose string, phone string) (string, error)
+	AuthenticationIncrOTPAttempts(ctx context.Context, purpose string, phone string, window time.Duration) (int64, error)
+	AuthenticationDeleteOTP(ctx context.Context, purpose string, phone string, resetAttempts bool) error
+	AuthenticationLockOTPResend(ctx context.Context, purpose string, phone string, ttl time.Duration) (bool, error)
+	AuthenticationIncrLoginFailures(ctx context.Context, subject string, window time.Duration) (int64, error)
+	AuthenticationLockLogin(ctx context.Context, subject string, ttl time.Duration) error
+	AuthenticationGetLoginLock(ctx context.Context, subject string) (time.Duration, error)
+	AuthenticationResetLoginFailures(ctx context.Context, subject string) error
+	AuthenticationSetChallenge(ctx context.Context, challenge string, userID string, ttl time.Duration) error
+	AuthenticationGetChallenge(ctx context.Context, challenge string) (string, error)
+	AuthenticationDeleteChallenge(ctx context.Context, challenge string) error
+	AuthenticationUseTOTPStep(ctx context.Context, userID string, step int64, ttl time.Duration) (bool, error)
+	AuthenticationSetOIDCState(ctx context.Context, state string, value user.OIDCState, ttl time.Duration) error
+	AuthenticationPopOIDCState(ctx context.Context, state string) (user.OIDCState, error)
+}
 
 // Authorization interface.
 type Authorization interface {
 	AuthorizationGetUserRole(ctx context.Context, userID string) (string, error)
 	AuthorizationSetUserRole(ctx context.Context, userID string, role string) error
+	AuthorizationGetUserStatus(ctx context.Context, userID string) (user.AccountStatus, error)
+	AuthorizationSetUserStatus(ctx context.Context, userID string, status user.AccountStatus) error
 }
 
 // User interface.
@@ -52,6 +74,7 @@ type User interface {
 	UserUpdate(ctx context.Context, user user.User) error
 	UserDelete(ctx context.Context, userID string) error
 	UserInvalidate(ctx context.Context) error
+	UserDeleteStatus(ctx context.Context, userID string) error
 
 	UserGetAllRoles(ctx context.Context, meta query.MetaData, params queryparameter.QueryParameter) ([]role.Role, error)
 	UserSetAllRoles(ctx context.Context, meta query.MetaData, params queryparameter.QueryParameter, roles []role.Role) error
@@ -158,3 +181,9 @@ type Rule interface {
 	RuleDelete(ctx context.Context, ruleID string) error
 	RuleInvalidate(ctx context.Context) error
 }
+
+// Setting interface.
+type Setting interface {
+	SettingGetOne(ctx context.Context, organizationID string) (setting.Settings, error)
+	SettingSet(ctx context.Context, settings setting.Settings) error
+}
@@ -0,0 +1,13 @@
+package identity
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+)
+
+// IdentityProvider interface.
+type IdentityProvider interface {
+	HasProvider(provider string) bool
+	AuthCodeURL(ctx context.Context, provider string, state string, nonce string, codeChallenge string) (string, error)
+	Exchange(ctx context.Context, provider string, code string, codeVerifier string, nonce string) (user.ExternalIdentity, error) //nolint:lll
+}
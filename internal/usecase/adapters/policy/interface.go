@@ -0,0 +1,18 @@
+package policy
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+)
+
+// PolicyEnforcer interface.
+type PolicyEnforcer interface {
+	Enforce(ctx context.Context, sub string, dom string, obj string, act string) (bool, error)
+	GetDomainRoles(userID string, dom string) []string
+	GetUserDomains(userID string) []string
+	GetRoles(role string) ([]string, error)
+	GetPermissions(role string) ([][]string, error)
+	GetPolicies() [][]string
+	Evaluate(ctx context.Context, rules []rule.PolicyRule, role string, obj string, act string) (rule.Evaluation, error)
+	Reload(ctx context.Context) error
+}
@@ -0,0 +1,8 @@
+package sms
+
+import "github.com/evgeniy-dammer/marketplace-api/pkg/context"
+
+// SMSSender interface.
+type SMSSender interface {
+	Send(ctx context.Context, phone string, message string) error
+}
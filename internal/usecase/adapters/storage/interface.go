@@ -1,17 +1,26 @@
 package storage
 
 import (
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/apikey"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/category"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/comment"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/favorite"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/image"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/invitation"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/item"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/member"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/order"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/organization"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/specification"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/storefront"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/table"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -33,20 +42,50 @@ type Storage struct {
 	Specification
 	Favorite
 	Rule
+	APIKey
+	Invitation
+	Audit
+	Member
+	Setting
+	Storefront
 }
 
 // Authentication interface.
 type Authentication interface {
 	AuthenticationGetUser(ctx context.Context, id string, username string) (user.User, error)
-	AuthenticationCreateUser(ctx context.Context, input user.CreateUserInput) (string, error)
-	AuthenticationCreateTokenHash(ctx context.Context, userID string, hash string) error
+	AuthenticationCreateUser(ctx context.Context, input user.SignUpInput) (string, error)
+	AuthenticationActivateUser(ctx context.Context, phone string) error
+	AuthenticationUpdatePassword(ctx context.Context, userID string, password string) error
+	AuthenticationRehashPassword(ctx context.Context, userID string, oldHash string, newHash string) error
+	AuthenticationGetTwoFactor(ctx context.Context, userID string) (user.TwoFactor, error)
+	AuthenticationSetTwoFactorSecret(ctx context.Context, userID string, secret string) error
+	AuthenticationEnableTwoFactor(ctx context.Context, userID string, codeHashes []string) error
+	AuthenticationReplaceBackupCodes(ctx context.Context, userID string, codeHashes []string) error
+	AuthenticationUseBackupCode(ctx context.Context, userID string, codeHash string) (bool, error)
+	AuthenticationDisableTwoFactor(ctx context.Context, userID string) error
+	AuthenticationGetIdentityUser(ctx context.Context, provider string, subject string) (string, error)
+	AuthenticationLinkIdentity(ctx context.Context, userID string, provider string, subject string) error
+	AuthenticationCreateExternalUser(ctx context.Context, input user.CreateUserInput, roleName string, provider string, subject string) (string, error) //nolint:lll
+	AuthenticationIsMember(ctx context.Context, userID string) (bool, error)
+	AuthenticationCreateTokenHash(ctx context.Context, userID string, hash string, session token.SessionInput) error
 	AuthenticationGetTokenHash(ctx context.Context, userID string, hash string) (string, error)
-	AuthenticationUpdateTokenHash(ctx context.Context, tokenID string, hash string) error
+	AuthenticationUpdateTokenHash(ctx context.Context, tokenID string, previousHash string, hash string, session token.SessionInput) error //nolint:lll
+	AuthenticationGetTokenFamily(ctx context.Context, userID string, hash string) (string, error)
+	AuthenticationRevokeTokenFamily(ctx context.Context, tokenID string) error
+	AuthenticationGetSessions(ctx context.Context, userID string) ([]token.Session, error)
+	AuthenticationRevokeSession(ctx context.Context, userID string, sessionID string) error
+	AuthenticationRevokeSessions(ctx context.Context, userID string) error
+	AuthenticationGetSigningKeys(ctx context.Context) ([]token.SigningKey, error)
+	AuthenticationCreateSigningKey(ctx context.Context, key token.SigningKey) (bool, error)
 }
 
 // Authorization interface.
 type Authorization interface {
 	AuthorizationGetUserRole(ctx context.Context, id string) (string, error)
+	AuthorizationGetUserStatus(ctx context.Context, userID string) (user.AccountStatus, error)
+	AuthorizationGetOrganizationRoles(ctx context.Context, organizationID string) ([]role.Assignment, error)
+	AuthorizationSetOrganizationRole(ctx context.Context, organizationID string, userID string, roleName string) error
+	AuthorizationDeleteOrganizationRole(ctx context.Context, organizationID string, userID string) error
 }
 
 // User interface.
@@ -56,6 +95,7 @@ type User interface {
 	UserCreate(ctx context.Context, meta query.MetaData, input user.CreateUserInput) (string, error)
 	UserUpdate(ctx context.Context, meta query.MetaData, input user.UpdateUserInput) error
 	UserDelete(ctx context.Context, meta query.MetaData, userID string) error
+	UserSetStatus(ctx context.Context, meta query.MetaData, userID string, status string, input user.ChangeStatusInput) error //nolint:lll
 
 	UserGetAllRoles(ctx context.Context, meta query.MetaData, params queryparameter.QueryParameter) ([]role.Role, error)
 }
@@ -145,4 +185,65 @@ type Rule interface {
 	RuleCreate(ctx context.Context, meta query.MetaData, input rule.CreateRuleInput) (string, error)
 	RuleUpdate(ctx context.Context, meta query.MetaData, input rule.UpdateRuleInput) error
 	RuleDelete(ctx context.Context, meta query.MetaData, ruleID string) error
+	RuleGetRoleParents(ctx context.Context, roleName string) ([]string, error)
+	RuleRoleExists(ctx context.Context, roleName string) (bool, error)
+	RuleCreateRoleParent(ctx context.Context, roleName string, parent string) error
+	RuleDeleteRoleParent(ctx context.Context, roleName string, parent string) error
+	RuleGetRoleNames(ctx context.Context) ([]string, error)
+	RuleGetPolicy(ctx context.Context) ([]rule.PolicyRule, error)
+	RulePatchPolicy(ctx context.Context, added []rule.PolicyRule, removed []rule.PolicyRule) error
+}
+
+// APIKey interface.
+type APIKey interface {
+	APIKeyGetAll(ctx context.Context, organizationID string) ([]apikey.APIKey, error)
+	APIKeyGetOne(ctx context.Context, keyID string) (apikey.APIKey, error)
+	APIKeyGetByHash(ctx context.Context, hash string) (apikey.APIKey, error)
+	APIKeyCreate(ctx context.Context, userID string, input apikey.CreateAPIKeyInput, prefix string, hash string) (apikey.APIKey, error) //nolint:lll
+	APIKeyRevoke(ctx context.Context, keyID string) error
+	APIKeyTouch(ctx context.Context, keyID string) error
+	APIKeyGetOrganizationOwner(ctx context.Context, organizationID string) (string, error)
+}
+
+// Invitation interface.
+type Invitation interface {
+	InvitationGetAll(ctx context.Context, organizationID string) ([]invitation.Invitation, error)
+	InvitationGetOne(ctx context.Context, invitationID string) (invitation.Invitation, error)
+	InvitationGetByHash(ctx context.Context, hash string) (invitation.Invitation, error)
+	InvitationCreate(ctx context.Context, userID string, input invitation.CreateInvitationInput, hash string, expiresAt time.Time) (string, error) //nolint:lll
+	InvitationRevoke(ctx context.Context, invitationID string) error
+	InvitationGetUser(ctx context.Context, phone string) (string, error)
+	InvitationGetOrganizationOwner(ctx context.Context, organizationID string) (string, error)
+	InvitationAccept(ctx context.Context, invitationID string, userID string, input user.SignUpInput) (string, error)
+}
+
+// Audit interface.
+type Audit interface {
+	AuditCreate(ctx context.Context, entry audit.Entry) error
+	AuditGetAll(ctx context.Context, filter audit.Filter, params queryparameter.QueryParameter) ([]audit.Entry, error)
+	AuditDeleteBefore(ctx context.Context, before time.Time) (int64, error)
+}
+
+// Member interface.
+type Member interface {
+	MemberGetAll(ctx context.Context, organizationID string) ([]member.Member, error)
+	MemberGetOne(ctx context.Context, organizationID string, userID string) (member.Member, error)
+	MemberCreate(ctx context.Context, organizationID string, input member.AddMemberInput) error
+	MemberUpdate(ctx context.Context, organizationID string, userID string, input member.UpdateMemberInput) error
+	MemberDelete(ctx context.Context, organizationID string, userID string) error
+	MemberGetOrganizationOwner(ctx context.Context, organizationID string) (string, error)
+}
+
+// Setting interface.
+type Setting interface {
+	SettingGetOne(ctx context.Context, organizationID string) (setting.Settings, error)
+	SettingUpdate(ctx context.Context, meta query.MetaData, settings setting.Settings) error
+}
+
+// Storefront interface.
+type Storefront interface {
+	StorefrontGetOrganization(ctx context.Context, slug string) (storefront.Organization, error)
+	StorefrontGetCategories(ctx context.Context, organizationID string) ([]storefront.Category, error)
+	StorefrontGetItems(ctx context.Context, organizationID string, filter storefront.ItemFilter) ([]item.Item, error)
+	StorefrontGetItem(ctx context.Context, organizationID string, itemID string) (item.Item, error)
 }
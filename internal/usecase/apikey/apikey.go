@@ -0,0 +1,177 @@
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/apikey"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const (
+	adminRole       = "admin"
+	keyPrefix       = "mk_"
+	keyPrefixLength = 4
+	keySecretLength = 32
+	auditEntity     = "apikey"
+)
+
+// APIKeyGetAll returns API keys of the organization.
+func (s *UseCase) APIKeyGetAll(ctx context.Context, meta query.MetaData, organizationID string) ([]apikey.APIKey, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.APIKeyGetAll")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkOwner(ctx, meta, organizationID); err != nil {
+		return nil, err
+	}
+
+	keys, err := s.adapterStorage.APIKeyGetAll(ctx, organizationID)
+
+	return keys, errors.Wrap(err, "api keys select error")
+}
+
+// APIKeyCreate generates new API key of the organization, the key is returned only once.
+func (s *UseCase) APIKeyCreate(ctx context.Context, meta query.MetaData, input apikey.CreateAPIKeyInput) (apikey.CreatedAPIKey, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.APIKeyCreate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var created apikey.CreatedAPIKey
+
+	for _, scope := range input.Scopes {
+		if _, _, ok := apikey.ParseScope(scope); !ok {
+			return created, errors.Wrap(usecase.ErrInvalidScope, scope)
+		}
+	}
+
+	if err := s.checkOwner(ctx, meta, input.OrganizationID); err != nil {
+		return created, err
+	}
+
+	prefix, err := randomString(keyPrefixLength)
+	if err != nil {
+		return created, err
+	}
+
+	secret, err := randomString(keySecretLength)
+	if err != nil {
+		return created, err
+	}
+
+	prefix = keyPrefix + prefix
+	created.Key = prefix + "_" + secret
+
+	created.APIKey, err = s.adapterStorage.APIKeyCreate(ctx, meta.UserID, input, prefix, hashKey(created.Key))
+	if err != nil {
+		return apikey.CreatedAPIKey{}, errors.Wrap(err, "api key create error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, created.APIKey.ID, nil, created.APIKey)
+
+	return created, nil
+}
+
+// APIKeyRevoke revokes API key by id.
+func (s *UseCase) APIKeyRevoke(ctx context.Context, meta query.MetaData, keyID string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.APIKeyRevoke")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	key, err := s.adapterStorage.APIKeyGetOne(ctx, keyID)
+	if err != nil {
+		return errors.Wrap(err, "api key select error")
+	}
+
+	if err = s.checkOwner(ctx, meta, key.OrganizationID); err != nil {
+		return err
+	}
+
+	if err = s.adapterStorage.APIKeyRevoke(ctx, keyID); err != nil {
+		return errors.Wrap(err, "api key revoke error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, keyID, key, nil)
+
+	return nil
+}
+
+// APIKeyAuthenticate returns not revoked API key by the secret key and updates its last usage.
+func (s *UseCase) APIKeyAuthenticate(ctx context.Context, key string) (apikey.APIKey, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.APIKeyAuthenticate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	apiKey, err := s.adapterStorage.APIKeyGetByHash(ctx, hashKey(key))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return apiKey, usecase.ErrInvalidAPIKey
+		}
+
+		return apiKey, errors.Wrap(err, "api key select error")
+	}
+
+	if err = s.adapterStorage.APIKeyTouch(ctx, apiKey.ID); err != nil {
+		logger.Logger.Error("unable to update api key last usage", zap.String("error", err.Error()))
+	}
+
+	return apiKey, nil
+}
+
+// checkOwner checks if the user may manage API keys of the organization.
+func (s *UseCase) checkOwner(ctx context.Context, meta query.MetaData, organizationID string) error {
+	if meta.RoleName == adminRole {
+		return nil
+	}
+
+	ownerID, err := s.adapterStorage.APIKeyGetOrganizationOwner(ctx, organizationID)
+	if err != nil {
+		return errors.Wrap(err, "organization owner select error")
+	}
+
+	if ownerID != meta.UserID {
+		return usecase.ErrNotOrganizationOwner
+	}
+
+	return nil
+}
+
+// randomString returns url safe random string of n bytes.
+func randomString(n int) (string, error) {
+	bytes := make([]byte, n)
+
+	if _, err := rand.Read(bytes); err != nil {
+		return "", errors.Wrap(err, "can not generate api key")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// hashKey returns hex encoded sha256 hash of the key.
+func hashKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,162 @@
+package apikey
+
+import (
+	"database/sql"
+	"encoding/base64"
+	"strings"
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/apikey"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testOwnerID        = "49c9b955-8511-4b53-81ef-82e3d0259fed"
+	testOrganizationID = "5f2b4c1e-8d3a-4b6f-9e7c-1a2b3c4d5e6f"
+	testKeyID          = "7d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+)
+
+var errStorage = errors.New("connection refused")
+
+func newUseCase() (*UseCase, *mockStorage.APIKey) {
+	storageRepo := new(mockStorage.APIKey)
+	auditRepo := new(mockStorage.Audit)
+
+	storageRepo.On("APIKeyGetOrganizationOwner", mock.Anything, testOrganizationID).Return(testOwnerID, nil)
+	storageRepo.On("APIKeyGetOrganizationOwner", mock.Anything, mock.Anything).
+		Return("", errors.Wrap(sql.ErrNoRows, "organization owner select query error"))
+	storageRepo.On("APIKeyCreate", mock.Anything, testOwnerID, mock.Anything, mock.Anything, mock.Anything).
+		Return(apikey.APIKey{ID: testKeyID, OrganizationID: testOrganizationID}, nil)
+	storageRepo.On("APIKeyGetOne", mock.Anything, testKeyID).
+		Return(apikey.APIKey{ID: testKeyID, OrganizationID: testOrganizationID}, nil)
+	storageRepo.On("APIKeyRevoke", mock.Anything, testKeyID).Return(nil)
+	auditRepo.On("AuditCreate", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(nil)
+
+	return New(storageRepo, auditRepo, false), storageRepo
+}
+
+func TestAPIKeyCreate(t *testing.T) {
+	_ = logger.InitLogger()
+
+	owner := query.MetaData{UserID: testOwnerID, RoleName: "vendor"}
+	input := apikey.CreateAPIKeyInput{OrganizationID: testOrganizationID, Name: "POS", Scopes: []string{"item:read"}}
+
+	t.Run("APIKeyCreate", func(t *testing.T) {
+		ucAPIKey, storageRepo := newUseCase()
+
+		created, err := ucAPIKey.APIKeyCreate(context.Empty(), owner, input)
+		assert.NoError(t, err)
+		assert.Equal(t, testKeyID, created.APIKey.ID)
+		assert.True(t, strings.HasPrefix(created.Key, keyPrefix))
+
+		prefix := created.Key[:len(keyPrefix)+base64.RawURLEncoding.EncodedLen(keyPrefixLength)]
+		storageRepo.AssertCalled(t, "APIKeyCreate", mock.Anything, testOwnerID, input, prefix, hashKey(created.Key))
+	})
+
+	t.Run("APIKeyCreateInvalidScope", func(t *testing.T) {
+		ucAPIKey, storageRepo := newUseCase()
+
+		for _, scope := range []string{"item", "item:", ":read"} {
+			_, err := ucAPIKey.APIKeyCreate(context.Empty(), owner, apikey.CreateAPIKeyInput{
+				OrganizationID: testOrganizationID, Name: "POS", Scopes: []string{"item:read", scope},
+			})
+			assert.ErrorIs(t, err, usecase.ErrInvalidScope, scope)
+		}
+
+		storageRepo.AssertNotCalled(t, "APIKeyCreate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("APIKeyCreateNotOwner", func(t *testing.T) {
+		ucAPIKey, storageRepo := newUseCase()
+
+		_, err := ucAPIKey.APIKeyCreate(context.Empty(), query.MetaData{UserID: "another", RoleName: "vendor"}, input)
+		assert.ErrorIs(t, err, usecase.ErrNotOrganizationOwner)
+
+		storageRepo.AssertNotCalled(t, "APIKeyCreate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("APIKeyCreateUnknownOrganization", func(t *testing.T) {
+		ucAPIKey, _ := newUseCase()
+
+		_, err := ucAPIKey.APIKeyCreate(context.Empty(), owner, apikey.CreateAPIKeyInput{
+			OrganizationID: "unknown", Name: "POS", Scopes: []string{"item:read"},
+		})
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	})
+
+	t.Run("APIKeyCreateWithError", func(t *testing.T) {
+		storageRepo := new(mockStorage.APIKey)
+		storageRepo.On("APIKeyCreate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+			Return(apikey.APIKey{}, errStorage)
+
+		ucAPIKey := New(storageRepo, nil, false)
+
+		created, err := ucAPIKey.APIKeyCreate(context.Empty(), query.MetaData{UserID: testOwnerID, RoleName: adminRole}, input)
+		assert.ErrorIs(t, err, errStorage)
+		assert.Empty(t, created.Key)
+
+		storageRepo.AssertNotCalled(t, "APIKeyGetOrganizationOwner", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAPIKeyRevoke(t *testing.T) {
+	_ = logger.InitLogger()
+
+	t.Run("APIKeyRevoke", func(t *testing.T) {
+		ucAPIKey, storageRepo := newUseCase()
+
+		assert.NoError(t, ucAPIKey.APIKeyRevoke(context.Empty(), query.MetaData{UserID: testOwnerID}, testKeyID))
+		storageRepo.AssertCalled(t, "APIKeyRevoke", mock.Anything, testKeyID)
+	})
+
+	t.Run("APIKeyRevokeNotOwner", func(t *testing.T) {
+		ucAPIKey, storageRepo := newUseCase()
+
+		err := ucAPIKey.APIKeyRevoke(context.Empty(), query.MetaData{UserID: "another"}, testKeyID)
+		assert.ErrorIs(t, err, usecase.ErrNotOrganizationOwner)
+
+		storageRepo.AssertNotCalled(t, "APIKeyRevoke", mock.Anything, mock.Anything)
+	})
+}
+
+func TestAPIKeyAuthenticate(t *testing.T) {
+	_ = logger.InitLogger()
+
+	const key = "mk_abcdef_secret"
+
+	storageRepo := new(mockStorage.APIKey)
+
+	storageRepo.On("APIKeyGetByHash", mock.Anything, hashKey(key)).
+		Return(apikey.APIKey{ID: testKeyID, Scopes: []string{"item:read"}}, nil)
+	storageRepo.On("APIKeyGetByHash", mock.Anything, hashKey("revoked")).
+		Return(apikey.APIKey{}, errors.Wrap(sql.ErrNoRows, "api key select query error"))
+	storageRepo.On("APIKeyGetByHash", mock.Anything, mock.Anything).Return(apikey.APIKey{}, errStorage)
+	storageRepo.On("APIKeyTouch", mock.Anything, testKeyID).Return(errStorage)
+
+	ucAPIKey := New(storageRepo, nil, false)
+
+	t.Run("APIKeyAuthenticate", func(t *testing.T) {
+		apiKey, err := ucAPIKey.APIKeyAuthenticate(context.Empty(), key)
+		assert.NoError(t, err, "failed last usage update must not deny the key")
+		assert.True(t, apiKey.Allows("item", "read"))
+		assert.False(t, apiKey.Allows("item", "delete"))
+	})
+
+	t.Run("APIKeyAuthenticateRevoked", func(t *testing.T) {
+		_, err := ucAPIKey.APIKeyAuthenticate(context.Empty(), "revoked")
+		assert.ErrorIs(t, err, usecase.ErrInvalidAPIKey)
+	})
+
+	t.Run("APIKeyAuthenticateWithError", func(t *testing.T) {
+		_, err := ucAPIKey.APIKeyAuthenticate(context.Empty(), "failing")
+		assert.ErrorIs(t, err, errStorage)
+		assert.NotErrorIs(t, err, usecase.ErrInvalidAPIKey)
+	})
+}
@@ -0,0 +1,17 @@
+package apikey
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
+)
+
+// UseCase is an API key usecase.
+type UseCase struct {
+	adapterStorage storage.APIKey
+	adapterAudit   storage.Audit
+	isTracingOn    bool
+}
+
+// New is a constructor for UseCase.
+func New(storage storage.APIKey, audit storage.Audit, isTracingOn bool) *UseCase {
+	return &UseCase{adapterStorage: storage, adapterAudit: audit, isTracingOn: isTracingOn}
+}
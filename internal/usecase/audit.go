@@ -0,0 +1,106 @@
+package usecase
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// auditedSecrets are fields never written to the audit log.
+var auditedSecrets = map[string]bool{"password": true, "key": true, "token": true}
+
+// RecordChange records the change of the entity made by the request, before is nil for created entities and after is nil
+// for deleted ones. Failures are logged only, the change is already made.
+func RecordChange(ctx context.Context, adapter storage.Audit, meta query.MetaData, action string, entity string, entityID string, before interface{}, after interface{}) { //nolint:lll
+	changes, err := AuditChanges(before, after)
+	if err != nil {
+		logger.Logger.Error("unable to compare audited entity", zap.String("entity", entity), zap.String("error", err.Error()))
+	}
+
+	entry := audit.Entry{
+		ActorID:        meta.UserID,
+		Role:           meta.RoleName,
+		OrganizationID: meta.OrganizationID,
+		Entity:         entity,
+		EntityID:       entityID,
+		Action:         action,
+		Changes:        changes,
+		RequestID:      meta.RequestID,
+		IP:             meta.IP,
+	}
+
+	if err = adapter.AuditCreate(ctx, entry); err != nil {
+		logger.Logger.Error(
+			"unable to record audit entry",
+			zap.String("entity", entity),
+			zap.String("id", entityID),
+			zap.String("error", err.Error()),
+		)
+	}
+}
+
+// AuditChanges returns fields of the entity with different json values before and after the change.
+func AuditChanges(before interface{}, after interface{}) (audit.Changes, error) {
+	beforeFields, err := auditFields(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterFields, err := auditFields(after)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(beforeFields)+len(afterFields))
+
+	for name := range beforeFields {
+		names = append(names, name)
+	}
+
+	for name := range afterFields {
+		if _, ok := beforeFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	changes := audit.Changes{}
+
+	for _, name := range names {
+		if auditedSecrets[name] || bytes.Equal(beforeFields[name], afterFields[name]) {
+			continue
+		}
+
+		changes = append(changes, audit.Change{Field: name, Before: beforeFields[name], After: afterFields[name]})
+	}
+
+	return changes, nil
+}
+
+// auditFields returns json values of the entity fields.
+func auditFields(entity interface{}) (map[string]json.RawMessage, error) {
+	if entity == nil {
+		return nil, nil //nolint:nilnil
+	}
+
+	data, err := json.Marshal(entity)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal entity")
+	}
+
+	var fields map[string]json.RawMessage
+	if err = json.Unmarshal(data, &fields); err != nil {
+		return nil, errors.Wrap(err, "entity is not a json object")
+	}
+
+	return fields, nil
+}
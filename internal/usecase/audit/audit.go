@@ -0,0 +1,64 @@
+package audit
+
+import (
+	stdContext "context"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/queryparameter"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+// defaultCleanupInterval is used if audit.cleanup_interval is not configured.
+const defaultCleanupInterval = 24 * time.Hour
+
+// AuditGetAll returns audit entries matching the filter, the newest first.
+func (s *UseCase) AuditGetAll(ctx context.Context, filter audit.Filter, params queryparameter.QueryParameter) ([]audit.Entry, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuditGetAll")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	entries, err := s.adapterStorage.AuditGetAll(ctx, filter, params)
+
+	return entries, errors.Wrap(err, "audit entries select error")
+}
+
+// AuditRunRetention deletes audit entries older than audit.retention days periodically until ctx is done,
+// entries are kept forever if the retention is not configured.
+func (s *UseCase) AuditRunRetention(ctx stdContext.Context) {
+	retention := time.Duration(viper.GetInt("audit.retention")) * 24 * time.Hour
+	if retention <= 0 {
+		return
+	}
+
+	interval := time.Duration(viper.GetInt("audit.cleanup_interval")) * time.Hour
+	if interval <= 0 {
+		interval = defaultCleanupInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		deleted, err := s.adapterStorage.AuditDeleteBefore(context.New(ctx), time.Now().UTC().Add(-retention))
+		if err != nil {
+			logger.Logger.Error("unable to delete expired audit entries", zap.String("error", err.Error()))
+		} else if deleted > 0 {
+			logger.Logger.Info("expired audit entries deleted", zap.Int64("count", deleted))
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,16 @@
+package audit
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
+)
+
+// UseCase is an audit log usecase.
+type UseCase struct {
+	adapterStorage storage.Audit
+	isTracingOn    bool
+}
+
+// New is a constructor for UseCase.
+func New(storage storage.Audit, isTracingOn bool) *UseCase {
+	return &UseCase{adapterStorage: storage, isTracingOn: isTracingOn}
+}
@@ -0,0 +1,43 @@
+package usecase
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuditChanges(t *testing.T) {
+	t.Parallel()
+
+	type entity struct {
+		Name     string `json:"name"`
+		Phone    string `json:"phone"`
+		Password string `json:"password"`
+	}
+
+	before := entity{Name: "old", Phone: "123", Password: "old hash"}
+	after := entity{Name: "new", Phone: "123", Password: "new hash"}
+
+	changes, err := AuditChanges(before, after)
+	require.NoError(t, err)
+	require.Equal(t, audit.Changes{
+		{Field: "name", Before: json.RawMessage(`"old"`), After: json.RawMessage(`"new"`)},
+	}, changes)
+
+	changes, err = AuditChanges(nil, after)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Equal(t, "name", changes[0].Field)
+	require.Nil(t, changes[0].Before)
+	require.Equal(t, "phone", changes[1].Field)
+
+	changes, err = AuditChanges(before, nil)
+	require.NoError(t, err)
+	require.Len(t, changes, 2)
+	require.Nil(t, changes[1].After)
+
+	_, err = AuditChanges([]string{"not an object"}, nil)
+	require.Error(t, err)
+}
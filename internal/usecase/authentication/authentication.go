@@ -1,17 +1,20 @@
 package authentication
 
 import (
+	"database/sql"
 	"time"
 
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
 	"github.com/golang-jwt/jwt"
 	"github.com/google/uuid"
 	"github.com/pkg/errors"
 	"github.com/spf13/viper"
+	"go.uber.org/zap"
 )
 
 // AuthenticationGenerateToken generates authorization token.
@@ -29,22 +32,26 @@ func (s *UseCase) AuthenticationGenerateToken(ctx context.Context, userID string
 
 	var err error
 
-	usr, err = s.adapterStorage.AuthenticationGetUser(ctx, userID, username)
-	if err != nil {
-		return usr, tokens, errors.Wrap(err, "can not get user")
-	}
-
 	if username != "" {
-		match, err := usecase.ComparePasswordAndHash(password, usr.Password)
+		usr, err = s.checkCredentials(ctx, username, password)
 		if err != nil {
 			return usr, tokens, err
 		}
 
-		if !match {
-			return usr, tokens, usecase.ErrInvalidPassword
+		userID = usr.ID
+	} else {
+		usr, err = s.adapterStorage.AuthenticationGetUser(ctx, userID, "")
+		if err != nil {
+			return usr, tokens, errors.Wrap(err, "can not get user")
 		}
+	}
 
-		userID = usr.ID
+	if usr.Status == user.StatusPending {
+		return usr, tokens, usecase.ErrUserNotVerified
+	}
+
+	if err = usecase.CheckAccountStatus(user.AccountStatus{Status: usr.Status, SuspendedUntil: usr.SuspendedUntil}); err != nil {
+		return usr, tokens, err
 	}
 
 	usr.Password = ""
@@ -58,8 +65,7 @@ func (s *UseCase) AuthenticationGenerateToken(ctx context.Context, userID string
 	expiresAt := time.Now().Add(accessTokenTTL).Unix()
 	refreshExpiresAt := time.Now().Add(refreshTokenTTL).Unix()
 
-	tkn := usecase.CreateNewToken(userID, expiresAt, issuedAt, "")
-	tokens.AccessToken, err = tkn.SignedString([]byte(viper.GetString("JWT_KEY")))
+	tokens.AccessToken, err = s.signToken(ctx, usecase.CreateNewClaims(userID, expiresAt, issuedAt, ""))
 
 	if err != nil {
 		return usr, tokens, errors.Wrap(err, "can not get access token")
@@ -73,9 +79,9 @@ func (s *UseCase) AuthenticationGenerateToken(ctx context.Context, userID string
 		return usr, tokens, errors.Wrap(err, "can not create new hash")
 	}
 
-	refreshToken := usecase.CreateNewToken(userID, refreshExpiresAt, issuedAt, hash.String())
 	tokens.RefreshTokenHash = hash.String()
-	tokens.RefreshToken, err = refreshToken.SignedString([]byte(viper.GetString("JWT_KEY")))
+	tokens.RefreshTokenExpires = refreshExpiresAt
+	tokens.RefreshToken, err = s.signToken(ctx, usecase.CreateNewClaims(userID, refreshExpiresAt, issuedAt, hash.String()))
 
 	if err != nil {
 		return usr, tokens, errors.Wrap(err, "can not get refresh token")
@@ -84,21 +90,59 @@ func (s *UseCase) AuthenticationGenerateToken(ctx context.Context, userID string
 	return usr, tokens, nil
 }
 
+// checkCredentials returns the user if the password matches.
+func (s *UseCase) checkCredentials(ctx context.Context, username string, password string) (user.User, error) {
+	usr, err := s.adapterStorage.AuthenticationGetUser(ctx, "", username)
+	if err != nil {
+		return usr, errors.Wrap(err, "can not get user")
+	}
+
+	match, err := usecase.ComparePasswordAndHash(password, usr.Password)
+	if err != nil {
+		return usr, err
+	}
+
+	if !match {
+		return usr, usecase.ErrInvalidPassword
+	}
+
+	s.rehashPassword(ctx, usr, password)
+
+	return usr, nil
+}
+
+// rehashPassword replaces the password hash generated with outdated params. Errors are only logged,
+// the old hash remains valid.
+func (s *UseCase) rehashPassword(ctx context.Context, usr user.User, password string) {
+	params := usecase.PasswordParams()
+
+	if !usecase.NeedsRehash(usr.Password, params) {
+		return
+	}
+
+	pass, err := usecase.GeneratePasswordHash(password, params)
+	if err != nil {
+		logger.Logger.Error("unable to rehash password", zap.String("error", err.Error()))
+
+		return
+	}
+
+	if err = s.adapterStorage.AuthenticationRehashPassword(ctx, usr.ID, usr.Password, pass); err != nil {
+		logger.Logger.Error("unable to update rehashed password", zap.String("error", err.Error()))
+	}
+}
+
 // AuthenticationParseToken checks access token and returns user id.
 func (s *UseCase) AuthenticationParseToken(ctx context.Context, accessToken string) (string, string, error) {
 	if s.isTracingOn {
 		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationParseToken")
 		defer span.End()
 
-		_ = context.New(ctxt)
+		ctx = context.New(ctxt)
 	}
 
-	tkn, err := jwt.ParseWithClaims(accessToken, &token.Claims{}, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, usecase.ErrInvalidSigningMethod
-		}
-
-		return []byte(viper.GetString("JWT_KEY")), nil
+	tkn, err := jwt.ParseWithClaims(accessToken, &token.Claims{}, func(tkn *jwt.Token) (interface{}, error) {
+		return s.verificationKey(ctx, tkn)
 	})
 	if err != nil {
 		return "", "", errors.Wrap(err, "can not parse token")
@@ -113,8 +157,8 @@ func (s *UseCase) AuthenticationParseToken(ctx context.Context, accessToken stri
 	return claims.UserID, claims.Hash, nil
 }
 
-// AuthenticationCreateUser hashes the password and insert User into system.
-func (s *UseCase) AuthenticationCreateUser(ctx context.Context, input user.CreateUserInput) (string, error) {
+// AuthenticationCreateUser hashes the password and insert User with the customer role into system.
+func (s *UseCase) AuthenticationCreateUser(ctx context.Context, input user.SignUpInput) (string, error) {
 	if s.isTracingOn {
 		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationCreateUser")
 		defer span.End()
@@ -122,7 +166,7 @@ func (s *UseCase) AuthenticationCreateUser(ctx context.Context, input user.Creat
 		ctx = context.New(ctxt)
 	}
 
-	pass, err := usecase.GeneratePasswordHash(input.Password, usecase.Params)
+	pass, err := usecase.GeneratePasswordHash(input.Password, usecase.PasswordParams())
 	if err != nil {
 		return "", errors.Wrap(err, "can not generate password hash")
 	}
@@ -130,12 +174,19 @@ func (s *UseCase) AuthenticationCreateUser(ctx context.Context, input user.Creat
 	input.Password = pass
 
 	userID, err := s.adapterStorage.AuthenticationCreateUser(ctx, input)
+	if err != nil {
+		return "", errors.Wrap(err, "can not create user")
+	}
 
-	return userID, errors.Wrap(err, "can not create user")
+	if err = s.sendOTP(ctx, otpPurposeSignUp, input.Phone, signUpOTPMessage); err != nil {
+		logger.Logger.Error("unable to send verification code", zap.String("error", err.Error()))
+	}
+
+	return userID, nil
 }
 
 // AuthenticationCreateTokenHash creates token hash in database
-func (s *UseCase) AuthenticationCreateTokenHash(ctx context.Context, userID string, hash string) error {
+func (s *UseCase) AuthenticationCreateTokenHash(ctx context.Context, userID string, hash string, session token.SessionInput) error { //nolint:lll
 	if s.isTracingOn {
 		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationCreateTokenHash")
 		defer span.End()
@@ -143,12 +194,17 @@ func (s *UseCase) AuthenticationCreateTokenHash(ctx context.Context, userID stri
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.AuthenticationCreateTokenHash(ctx, userID, hash)
+	if session.ClientType == "" {
+		session.ClientType = token.ClientTypeMobile
+	}
+
+	err := s.adapterStorage.AuthenticationCreateTokenHash(ctx, userID, hash, session)
 
 	return errors.Wrap(err, "token create failed")
 }
 
-// AuthenticationGetTokenHash returns token hash id from database
+// AuthenticationGetTokenHash returns token hash id from database.
+// Replaying an already rotated hash revokes the whole token family.
 func (s *UseCase) AuthenticationGetTokenHash(ctx context.Context, userID string, hash string) (string, error) {
 	if s.isTracingOn {
 		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationGetTokenHash")
@@ -158,12 +214,25 @@ func (s *UseCase) AuthenticationGetTokenHash(ctx context.Context, userID string,
 	}
 
 	tokenID, err := s.adapterStorage.AuthenticationGetTokenHash(ctx, userID, hash)
+	if err == nil {
+		return tokenID, nil
+	}
 
-	return tokenID, errors.Wrap(err, "token id select error")
+	if !errors.Is(err, sql.ErrNoRows) {
+		return "", errors.Wrap(err, "token id select error")
+	}
+
+	familyID, err := s.adapterStorage.AuthenticationGetTokenFamily(ctx, userID, hash)
+	if err != nil {
+		return "", errors.Wrap(err, "token family select error")
+	}
+
+	return "", s.revokeTokenFamily(ctx, userID, familyID)
 }
 
 // AuthenticationUpdateTokenHash updates token hash in database.
-func (s *UseCase) AuthenticationUpdateTokenHash(ctx context.Context, tokenID string, hash string) error {
+// The hash rotated by a concurrent refresh is a reuse of the token and revokes the whole token family.
+func (s *UseCase) AuthenticationUpdateTokenHash(ctx context.Context, userID string, tokenID string, previousHash string, hash string, session token.SessionInput) error { //nolint:lll
 	if s.isTracingOn {
 		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationUpdateTokenHash")
 		defer span.End()
@@ -171,7 +240,69 @@ func (s *UseCase) AuthenticationUpdateTokenHash(ctx context.Context, tokenID str
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.AuthenticationUpdateTokenHash(ctx, tokenID, hash)
+	err := s.adapterStorage.AuthenticationUpdateTokenHash(ctx, tokenID, previousHash, hash, session)
+	if errors.Is(err, sql.ErrNoRows) {
+		return s.revokeTokenFamily(ctx, userID, tokenID)
+	}
 
 	return errors.Wrap(err, "token update failed")
 }
+
+// revokeTokenFamily revokes the token family whose refresh token is reused.
+func (s *UseCase) revokeTokenFamily(ctx context.Context, userID string, familyID string) error {
+	logger.Logger.Warn("security event: refresh token reuse detected, revoking token family",
+		zap.String("user_id", userID),
+		zap.String("token_id", familyID),
+	)
+
+	if err := s.adapterStorage.AuthenticationRevokeTokenFamily(ctx, familyID); err != nil {
+		return errors.Wrap(err, "token family revoke failed")
+	}
+
+	return usecase.ErrRefreshTokenReused
+}
+
+// AuthenticationGetSessions returns active sessions of the user.
+func (s *UseCase) AuthenticationGetSessions(ctx context.Context, userID string) ([]token.Session, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationGetSessions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	sessions, err := s.adapterStorage.AuthenticationGetSessions(ctx, userID)
+	if err != nil {
+		return nil, errors.Wrap(err, "sessions select failed")
+	}
+
+	return sessions, nil
+}
+
+// AuthenticationRevokeSession revokes one session of the user.
+func (s *UseCase) AuthenticationRevokeSession(ctx context.Context, userID string, sessionID string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationRevokeSession")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	err := s.adapterStorage.AuthenticationRevokeSession(ctx, userID, sessionID)
+
+	return errors.Wrap(err, "session revoke failed")
+}
+
+// AuthenticationRevokeSessions revokes all sessions of the user.
+func (s *UseCase) AuthenticationRevokeSessions(ctx context.Context, userID string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationRevokeSessions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	err := s.adapterStorage.AuthenticationRevokeSessions(ctx, userID)
+
+	return errors.Wrap(err, "sessions revoke failed")
+}
@@ -0,0 +1,158 @@
+package authentication
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testUserID  = "49c9b955-8511-4b53-81ef-82e3d0259fed"
+	testTokenID = "7d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+)
+
+var errStorage = errors.New("connection refused")
+
+func TestRefreshTokenRotation(t *testing.T) {
+	_ = logger.InitLogger()
+
+	storageRepo := new(mockStorage.Authentication)
+
+	storageRepo.On("AuthenticationGetTokenHash", mock.Anything, testUserID, "current").Return(testTokenID, nil)
+	storageRepo.On("AuthenticationGetTokenHash", mock.Anything, testUserID, mock.Anything).
+		Return("", errors.Wrap(sql.ErrNoRows, "token select query error"))
+	storageRepo.On("AuthenticationGetTokenHash", mock.Anything, "broken", mock.Anything).Return("", errStorage)
+
+	storageRepo.On("AuthenticationGetTokenFamily", mock.Anything, testUserID, "rotated").Return(testTokenID, nil)
+	storageRepo.On("AuthenticationGetTokenFamily", mock.Anything, testUserID, "unknown").
+		Return("", errors.Wrap(sql.ErrNoRows, "token family select query error"))
+	storageRepo.On("AuthenticationGetTokenFamily", mock.Anything, testUserID, "failing").Return("", errStorage)
+
+	storageRepo.On("AuthenticationRevokeTokenFamily", mock.Anything, testTokenID).Return(nil)
+
+	storageRepo.On("AuthenticationUpdateTokenHash", mock.Anything, testTokenID, "current", "next", mock.Anything).
+		Return(nil)
+	storageRepo.On("AuthenticationUpdateTokenHash", mock.Anything, testTokenID, "raced", "next", mock.Anything).
+		Return(errors.Wrap(sql.ErrNoRows, "token hash has already been rotated"))
+	storageRepo.On("AuthenticationUpdateTokenHash", mock.Anything, testTokenID, "failing", "next", mock.Anything).
+		Return(errStorage)
+
+	ucAuthentication := New(storageRepo, nil, nil, nil, false, false)
+	assertion := assert.New(t)
+	session := token.SessionInput{IP: "127.0.0.1", ExpiresAt: time.Now().Add(time.Hour)}
+
+	t.Run("AuthenticationGetTokenHash", func(t *testing.T) {
+		tokenID, err := ucAuthentication.AuthenticationGetTokenHash(context.Empty(), testUserID, "current")
+		assertion.NoError(err)
+		assertion.Equal(testTokenID, tokenID)
+	})
+
+	t.Run("AuthenticationGetTokenHashRotated", func(t *testing.T) {
+		_, err := ucAuthentication.AuthenticationGetTokenHash(context.Empty(), testUserID, "rotated")
+		assertion.ErrorIs(err, usecase.ErrRefreshTokenReused)
+		storageRepo.AssertCalled(t, "AuthenticationRevokeTokenFamily", mock.Anything, testTokenID)
+	})
+
+	t.Run("AuthenticationGetTokenHashUnknown", func(t *testing.T) {
+		_, err := ucAuthentication.AuthenticationGetTokenHash(context.Empty(), testUserID, "unknown")
+		assertion.ErrorIs(err, sql.ErrNoRows)
+		assertion.NotErrorIs(err, usecase.ErrRefreshTokenReused)
+	})
+
+	t.Run("AuthenticationGetTokenHashWithError", func(t *testing.T) {
+		_, err := ucAuthentication.AuthenticationGetTokenHash(context.Empty(), "broken", "current")
+		assertion.ErrorIs(err, errStorage)
+
+		_, err = ucAuthentication.AuthenticationGetTokenHash(context.Empty(), testUserID, "failing")
+		assertion.ErrorIs(err, errStorage)
+		assertion.NotErrorIs(err, sql.ErrNoRows)
+	})
+
+	t.Run("AuthenticationUpdateTokenHash", func(t *testing.T) {
+		err := ucAuthentication.AuthenticationUpdateTokenHash(
+			context.Empty(), testUserID, testTokenID, "current", "next", session)
+		assertion.NoError(err)
+	})
+
+	t.Run("AuthenticationUpdateTokenHashConcurrent", func(t *testing.T) {
+		err := ucAuthentication.AuthenticationUpdateTokenHash(
+			context.Empty(), testUserID, testTokenID, "raced", "next", session)
+		assertion.ErrorIs(err, usecase.ErrRefreshTokenReused)
+	})
+
+	t.Run("AuthenticationUpdateTokenHashWithError", func(t *testing.T) {
+		err := ucAuthentication.AuthenticationUpdateTokenHash(
+			context.Empty(), testUserID, testTokenID, "failing", "next", session)
+		assertion.ErrorIs(err, errStorage)
+	})
+
+	storageRepo.AssertNumberOfCalls(t, "AuthenticationRevokeTokenFamily", 2)
+}
+
+func TestSessions(t *testing.T) {
+	_ = logger.InitLogger()
+
+	sessions := []token.Session{
+		{ID: testTokenID, ClientType: token.ClientTypeWeb, DeviceName: "Firefox", IP: "127.0.0.1"},
+	}
+
+	storageRepo := new(mockStorage.Authentication)
+
+	storageRepo.On("AuthenticationGetSessions", mock.Anything, testUserID).Return(sessions, nil)
+	storageRepo.On("AuthenticationGetSessions", mock.Anything, mock.Anything).Return(nil, errStorage)
+
+	storageRepo.On("AuthenticationRevokeSession", mock.Anything, testUserID, testTokenID).Return(nil)
+	storageRepo.On("AuthenticationRevokeSession", mock.Anything, testUserID, "foreign").
+		Return(errors.Wrap(sql.ErrNoRows, "session revoke query error"))
+	storageRepo.On("AuthenticationRevokeSession", mock.Anything, mock.Anything, mock.Anything).Return(errStorage)
+
+	storageRepo.On("AuthenticationCreateTokenHash", mock.Anything, testUserID, "hash", mock.Anything).Return(nil)
+
+	ucAuthentication := New(storageRepo, nil, nil, nil, false, false)
+	assertion := assert.New(t)
+
+	t.Run("AuthenticationGetSessions", func(t *testing.T) {
+		result, err := ucAuthentication.AuthenticationGetSessions(context.Empty(), testUserID)
+		assertion.NoError(err)
+		assertion.Equal(sessions, result)
+	})
+
+	t.Run("AuthenticationGetSessionsWithError", func(t *testing.T) {
+		result, err := ucAuthentication.AuthenticationGetSessions(context.Empty(), "broken")
+		assertion.ErrorIs(err, errStorage)
+		assertion.Nil(result)
+	})
+
+	t.Run("AuthenticationRevokeSession", func(t *testing.T) {
+		assertion.NoError(ucAuthentication.AuthenticationRevokeSession(context.Empty(), testUserID, testTokenID))
+	})
+
+	t.Run("AuthenticationRevokeSessionOfAnotherUser", func(t *testing.T) {
+		err := ucAuthentication.AuthenticationRevokeSession(context.Empty(), testUserID, "foreign")
+		assertion.ErrorIs(err, sql.ErrNoRows)
+	})
+
+	t.Run("AuthenticationRevokeSessionWithError", func(t *testing.T) {
+		err := ucAuthentication.AuthenticationRevokeSession(context.Empty(), "broken", testTokenID)
+		assertion.ErrorIs(err, errStorage)
+		assertion.NotErrorIs(err, sql.ErrNoRows)
+	})
+
+	t.Run("AuthenticationCreateTokenHashDefaultClientType", func(t *testing.T) {
+		err := ucAuthentication.AuthenticationCreateTokenHash(
+			context.Empty(), testUserID, "hash", token.SessionInput{DeviceName: "Pixel"})
+		assertion.NoError(err)
+
+		storageRepo.AssertCalled(t, "AuthenticationCreateTokenHash", mock.Anything, testUserID, "hash",
+			token.SessionInput{ClientType: token.ClientTypeMobile, DeviceName: "Pixel"})
+	})
+}
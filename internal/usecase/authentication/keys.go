@@ -0,0 +1,472 @@
+package authentication
+
+import (
+	stdContext "context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/golang-jwt/jwt"
+	"github.com/google/uuid"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	rsaKeyBits         = 2048
+	pemPrivateKeyBlock = "PRIVATE KEY"
+	minReloadInterval  = 5 * time.Second
+
+	keyEncryptionKeyLength = 32
+	encryptedKeyPrefix     = "aes-gcm:"
+)
+
+// signingKey is a parsed signing key pair.
+type signingKey struct {
+	id        string
+	method    jwt.SigningMethod
+	private   crypto.PrivateKey
+	public    crypto.PublicKey
+	expiresAt time.Time
+	retiredAt time.Time
+}
+
+// keySet is an in-memory set of not retired signing keys shared between requests.
+type keySet struct {
+	keys     []signingKey
+	loadedAt time.Time
+	mutex    sync.RWMutex
+}
+
+// signingAlgorithm returns configured token signing algorithm.
+func signingAlgorithm() string {
+	if alg := viper.GetString("authentication.signing_algorithm"); alg != "" {
+		return alg
+	}
+
+	return token.AlgorithmHS256
+}
+
+// signToken signs claims with the current signing key.
+func (s *UseCase) signToken(ctx context.Context, claims jwt.Claims) (string, error) {
+	if signingAlgorithm() == token.AlgorithmHS256 {
+		tkn := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+
+		signed, err := tkn.SignedString([]byte(viper.GetString("JWT_KEY")))
+
+		return signed, errors.Wrap(err, "can not sign token")
+	}
+
+	key, err := s.currentKey(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	tkn := jwt.NewWithClaims(key.method, claims)
+	tkn.Header["kid"] = key.id
+
+	signed, err := tkn.SignedString(key.private)
+
+	return signed, errors.Wrap(err, "can not sign token")
+}
+
+// verificationKey returns key for verifying the token signature.
+func (s *UseCase) verificationKey(ctx context.Context, tkn *jwt.Token) (interface{}, error) {
+	if signingAlgorithm() == token.AlgorithmHS256 {
+		if _, ok := tkn.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, usecase.ErrInvalidSigningMethod
+		}
+
+		return []byte(viper.GetString("JWT_KEY")), nil
+	}
+
+	kid, ok := tkn.Header["kid"].(string)
+	if !ok || kid == "" {
+		return nil, usecase.ErrUnknownSigningKey
+	}
+
+	key, found := s.keys.find(kid)
+	if !found && s.keys.age() > minReloadInterval {
+		if err := s.loadKeys(ctx); err != nil {
+			return nil, err
+		}
+
+		key, found = s.keys.find(kid)
+	}
+
+	if !found || !key.retiredAt.After(time.Now()) {
+		return nil, usecase.ErrUnknownSigningKey
+	}
+
+	if tkn.Method.Alg() != key.method.Alg() {
+		return nil, usecase.ErrInvalidSigningMethod
+	}
+
+	return key.public, nil
+}
+
+// currentKey returns the newest key allowed for signing, creating one if there is none.
+func (s *UseCase) currentKey(ctx context.Context) (signingKey, error) {
+	if key, ok := s.keys.current(); ok {
+		return key, nil
+	}
+
+	if err := s.loadKeys(ctx); err != nil {
+		return signingKey{}, err
+	}
+
+	if key, ok := s.keys.current(); ok {
+		return key, nil
+	}
+
+	if err := s.AuthenticationRotateKeys(ctx); err != nil {
+		return signingKey{}, err
+	}
+
+	key, ok := s.keys.current()
+	if !ok {
+		return signingKey{}, usecase.ErrUnknownSigningKey
+	}
+
+	return key, nil
+}
+
+// loadKeys reloads not retired signing keys from storage.
+func (s *UseCase) loadKeys(ctx context.Context) error {
+	stored, err := s.adapterStorage.AuthenticationGetSigningKeys(ctx)
+	if err != nil {
+		return errors.Wrap(err, "can not get signing keys")
+	}
+
+	keys := make([]signingKey, 0, len(stored))
+
+	for _, item := range stored {
+		key, err := parseSigningKey(item)
+		if err != nil {
+			logger.Logger.Error("unable to parse signing key", zap.String("kid", item.ID), zap.String("error", err.Error()))
+
+			continue
+		}
+
+		keys = append(keys, key)
+	}
+
+	s.keys.set(keys)
+
+	return nil
+}
+
+// AuthenticationRotateKeys generates new signing key and stops signing with the previous ones. The key of another
+// instance is used if that instance has rotated keys at the same time.
+func (s *UseCase) AuthenticationRotateKeys(ctx context.Context) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationRotateKeys")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	rotationInterval := time.Duration(viper.GetInt("authentication.key_rotation_interval")) * time.Hour
+	refreshTokenTTL := time.Duration(viper.GetInt("authentication.refresh_token_ttl")) * time.Hour
+
+	privateKey, err := generatePrivateKey(signingAlgorithm())
+	if err != nil {
+		return err
+	}
+
+	encoded, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return errors.Wrap(err, "can not marshal private key")
+	}
+
+	kid := uuid.NewString()
+
+	sealed, err := sealPrivateKey(kid, pem.EncodeToMemory(&pem.Block{Type: pemPrivateKeyBlock, Bytes: encoded}))
+	if err != nil {
+		return err
+	}
+
+	now := time.Now().UTC()
+
+	key := token.SigningKey{
+		ID:         kid,
+		Algorithm:  signingAlgorithm(),
+		PrivateKey: sealed,
+		CreatedAt:  now,
+		ExpiresAt:  now.Add(rotationInterval),
+		RetiredAt:  now.Add(rotationInterval + refreshTokenTTL),
+	}
+
+	created, err := s.adapterStorage.AuthenticationCreateSigningKey(ctx, key)
+	if err != nil {
+		return errors.Wrap(err, "can not create signing key")
+	}
+
+	if created {
+		logger.Logger.Info("signing key rotated", zap.String("kid", key.ID), zap.String("algorithm", key.Algorithm))
+	}
+
+	return s.loadKeys(ctx)
+}
+
+// AuthenticationRunKeyRotation reloads signing keys periodically and rotates the expired ones until ctx is done.
+func (s *UseCase) AuthenticationRunKeyRotation(ctx stdContext.Context) {
+	if signingAlgorithm() == token.AlgorithmHS256 {
+		return
+	}
+
+	reloadInterval := time.Duration(viper.GetInt("authentication.key_reload_interval")) * time.Second
+
+	ticker := time.NewTicker(reloadInterval)
+	defer ticker.Stop()
+
+	for {
+		lctx := context.New(ctx)
+
+		if err := s.loadKeys(lctx); err != nil {
+			logger.Logger.Error("unable to reload signing keys", zap.String("error", err.Error()))
+		} else if _, ok := s.keys.current(); !ok {
+			if err = s.AuthenticationRotateKeys(lctx); err != nil {
+				logger.Logger.Error("unable to rotate signing keys", zap.String("error", err.Error()))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// AuthenticationGetJWKS returns public keys of all not retired signing keys.
+func (s *UseCase) AuthenticationGetJWKS(ctx context.Context) (token.JWKS, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationGetJWKS")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	jwks := token.JWKS{Keys: []token.JWK{}}
+
+	if signingAlgorithm() == token.AlgorithmHS256 {
+		return jwks, nil
+	}
+
+	if s.keys.age() > minReloadInterval {
+		if err := s.loadKeys(ctx); err != nil {
+			return jwks, err
+		}
+	}
+
+	for _, key := range s.keys.all() {
+		jwk := token.JWK{Kid: key.id, Use: "sig", Alg: key.method.Alg()}
+
+		switch public := key.public.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(public.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(public.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(public)
+		default:
+			continue
+		}
+
+		jwks.Keys = append(jwks.Keys, jwk)
+	}
+
+	return jwks, nil
+}
+
+// generatePrivateKey generates new private key for the algorithm.
+func generatePrivateKey(algorithm string) (crypto.PrivateKey, error) {
+	switch algorithm {
+	case token.AlgorithmRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+
+		return key, errors.Wrap(err, "can not generate rsa key")
+	case token.AlgorithmEdDSA:
+		_, key, err := ed25519.GenerateKey(rand.Reader)
+
+		return key, errors.Wrap(err, "can not generate ed25519 key")
+	default:
+		return nil, usecase.ErrInvalidSigningMethod
+	}
+}
+
+// parseSigningKey decrypts and decodes stored signing key.
+func parseSigningKey(stored token.SigningKey) (signingKey, error) {
+	decrypted, err := openPrivateKey(stored.ID, stored.PrivateKey)
+	if err != nil {
+		return signingKey{}, err
+	}
+
+	block, _ := pem.Decode(decrypted)
+	if block == nil || block.Type != pemPrivateKeyBlock {
+		return signingKey{}, usecase.ErrInvalidSigningKey
+	}
+
+	private, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return signingKey{}, errors.Wrap(err, "can not parse private key")
+	}
+
+	key := signingKey{id: stored.ID, private: private, expiresAt: stored.ExpiresAt, retiredAt: stored.RetiredAt}
+
+	switch typed := private.(type) {
+	case *rsa.PrivateKey:
+		key.method = jwt.SigningMethodRS256
+		key.public = &typed.PublicKey
+	case ed25519.PrivateKey:
+		key.method = jwt.SigningMethodEdDSA
+		key.public = typed.Public()
+	default:
+		return signingKey{}, usecase.ErrInvalidSigningKey
+	}
+
+	if key.method.Alg() != stored.Algorithm {
+		return signingKey{}, usecase.ErrInvalidSigningKey
+	}
+
+	return key, nil
+}
+
+// sealPrivateKey encrypts the private key, key id is authenticated so encrypted keys can not be swapped between keys.
+func sealPrivateKey(kid string, private []byte) (string, error) {
+	aead, err := keyEncryptionCipher()
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return "", errors.Wrap(err, "can not generate nonce")
+	}
+
+	return encryptedKeyPrefix + base64.StdEncoding.EncodeToString(aead.Seal(nonce, nonce, private, []byte(kid))), nil
+}
+
+// openPrivateKey decrypts the private key sealed by sealPrivateKey.
+func openPrivateKey(kid string, sealed string) ([]byte, error) {
+	if !strings.HasPrefix(sealed, encryptedKeyPrefix) {
+		return nil, usecase.ErrInvalidSigningKey
+	}
+
+	aead, err := keyEncryptionCipher()
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(sealed, encryptedKeyPrefix))
+	if err != nil || len(data) < aead.NonceSize() {
+		return nil, usecase.ErrInvalidSigningKey
+	}
+
+	private, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(kid))
+	if err != nil {
+		return nil, errors.Wrap(err, "can not decrypt private key")
+	}
+
+	return private, nil
+}
+
+// keyEncryptionCipher returns cipher of the key encryption key read from Vault.
+func keyEncryptionCipher() (cipher.AEAD, error) {
+	kek, err := base64.StdEncoding.DecodeString(viper.GetString("SIGNING_KEY_ENCRYPTION_KEY"))
+	if err != nil || len(kek) != keyEncryptionKeyLength {
+		return nil, usecase.ErrInvalidKeyEncryptionKey
+	}
+
+	block, err := aes.NewCipher(kek)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not create cipher")
+	}
+
+	aead, err := cipher.NewGCM(block)
+
+	return aead, errors.Wrap(err, "can not create cipher")
+}
+
+// set replaces keys in the set.
+func (k *keySet) set(keys []signingKey) {
+	k.mutex.Lock()
+	defer k.mutex.Unlock()
+
+	k.keys = keys
+	k.loadedAt = time.Now()
+}
+
+// all returns not retired keys.
+func (k *keySet) all() []signingKey {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	now := time.Now()
+	keys := make([]signingKey, 0, len(k.keys))
+
+	for _, key := range k.keys {
+		if key.retiredAt.After(now) {
+			keys = append(keys, key)
+		}
+	}
+
+	return keys
+}
+
+// find returns key by id.
+func (k *keySet) find(kid string) (signingKey, bool) {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	for _, key := range k.keys {
+		if key.id == kid {
+			return key, true
+		}
+	}
+
+	return signingKey{}, false
+}
+
+// current returns the newest key allowed for signing with configured algorithm.
+func (k *keySet) current() (signingKey, bool) {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	now := time.Now()
+
+	for _, key := range k.keys {
+		if key.expiresAt.After(now) && key.method.Alg() == signingAlgorithm() {
+			return key, true
+		}
+	}
+
+	return signingKey{}, false
+}
+
+// age returns duration since the last reload.
+func (k *keySet) age() time.Duration {
+	k.mutex.RLock()
+	defer k.mutex.RUnlock()
+
+	return time.Since(k.loadedAt)
+}
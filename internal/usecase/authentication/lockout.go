@@ -0,0 +1,196 @@
+package authentication
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	loginSubjectPhone = "phone."
+	loginSubjectIP    = "ip."
+	maxDelayShift     = 30
+)
+
+// AuthenticationSignIn checks sign in locks and credentials, counts failed attempts per phone and ip,
+// then issues tokens or, if two-factor is enabled or required, the second step challenge.
+func (s *UseCase) AuthenticationSignIn(ctx context.Context, phone string, password string, ip string) (user.User, token.Tokens, *token.Challenge, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationSignIn")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkLoginLock(ctx, phone, ip); err != nil {
+		return user.User{}, token.Tokens{}, nil, err
+	}
+
+	usr, err := s.checkCredentials(ctx, phone, password)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) || errors.Is(err, usecase.ErrInvalidPassword) {
+			s.registerLoginFailure(ctx, phone, ip)
+
+			return user.User{}, token.Tokens{}, nil, usecase.ErrInvalidPassword
+		}
+
+		return user.User{}, token.Tokens{}, nil, err
+	}
+
+	s.resetLoginFailures(ctx, phone)
+
+	if usr.Status == user.StatusPending {
+		return user.User{}, token.Tokens{}, nil, usecase.ErrUserNotVerified
+	}
+
+	if err = usecase.CheckAccountStatus(user.AccountStatus{Status: usr.Status, SuspendedUntil: usr.SuspendedUntil}); err != nil {
+		return user.User{}, token.Tokens{}, nil, err
+	}
+
+	challenge, err := s.twoFactorChallenge(ctx, usr)
+	if err != nil || challenge != nil {
+		return user.User{}, token.Tokens{}, challenge, err
+	}
+
+	usr, tokens, err := s.AuthenticationGenerateToken(ctx, usr.ID, "", "")
+
+	return usr, tokens, nil, err
+}
+
+// AuthenticationUnlock removes sign in lock of the user and, if set, of the ip.
+func (s *UseCase) AuthenticationUnlock(ctx context.Context, userID string, ip string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationUnlock")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	usr, err := s.adapterStorage.AuthenticationGetUser(ctx, userID, "")
+	if err != nil {
+		return errors.Wrap(err, "can not get user")
+	}
+
+	if err = s.adapterCache.AuthenticationResetLoginFailures(ctx, loginSubjectPhone+usr.Phone); err != nil {
+		return errors.Wrap(err, "can not unlock user")
+	}
+
+	if ip != "" {
+		err = s.adapterCache.AuthenticationResetLoginFailures(ctx, loginSubjectIP+ip)
+	}
+
+	return errors.Wrap(err, "can not unlock ip")
+}
+
+// checkLoginLock returns ErrLoginLocked if password checks of the phone or the ip are locked after failures.
+func (s *UseCase) checkLoginLock(ctx context.Context, phone string, ip string) error {
+	for _, subject := range []string{loginSubjectPhone + phone, loginSubjectIP + ip} {
+		lock, err := s.adapterCache.AuthenticationGetLoginLock(ctx, subject)
+		if err != nil {
+			return errors.Wrap(err, "can not get sign in lock")
+		}
+
+		if lock > 0 {
+			failedLogins.WithLabelValues(failureReasonLocked).Inc()
+
+			return errors.Wrapf(usecase.ErrLoginLocked, "retry in %d seconds", int(lock.Seconds())+1)
+		}
+	}
+
+	return nil
+}
+
+// resetLoginFailures resets failed attempts of the phone after the valid password, only the phone counter is reset,
+// otherwise one valid account would unlock the whole ip.
+func (s *UseCase) resetLoginFailures(ctx context.Context, phone string) {
+	if err := s.adapterCache.AuthenticationResetLoginFailures(ctx, loginSubjectPhone+phone); err != nil {
+		logger.Logger.Error("unable to reset failed sign in attempts", zap.String("error", err.Error()))
+	}
+}
+
+// registerLoginFailure counts failed attempt and locks sign in of the phone and ip when needed.
+func (s *UseCase) registerLoginFailure(ctx context.Context, phone string, ip string) {
+	failedLogins.WithLabelValues(failureReasonInvalidCredentials).Inc()
+
+	window := time.Duration(viper.GetInt("login_protection.failure_window")) * time.Minute
+
+	limits := []struct {
+		subject     string
+		delayAfter  int64
+		maxFailures int64
+	}{
+		{
+			subject:     loginSubjectPhone + phone,
+			delayAfter:  viper.GetInt64("login_protection.delay_after"),
+			maxFailures: viper.GetInt64("login_protection.phone_max_failures"),
+		},
+		{
+			// progressive delay is not used for ip, many users may share it
+			subject:     loginSubjectIP + ip,
+			delayAfter:  viper.GetInt64("login_protection.ip_max_failures"),
+			maxFailures: viper.GetInt64("login_protection.ip_max_failures"),
+		},
+	}
+
+	for _, limit := range limits {
+		failures, err := s.adapterCache.AuthenticationIncrLoginFailures(ctx, limit.subject, window)
+		if err != nil {
+			logger.Logger.Error("unable to count failed sign in attempt", zap.String("error", err.Error()))
+
+			continue
+		}
+
+		lock := loginLockDuration(failures, limit.delayAfter, limit.maxFailures)
+		if lock == 0 {
+			continue
+		}
+
+		if failures >= limit.maxFailures {
+			logger.Logger.Warn("security event: sign in locked after repeated failures",
+				zap.String("subject", limit.subject),
+				zap.Int64("failures", failures),
+			)
+		}
+
+		if err = s.adapterCache.AuthenticationLockLogin(ctx, limit.subject, lock); err != nil {
+			logger.Logger.Error("unable to lock sign in", zap.String("error", err.Error()))
+		}
+	}
+}
+
+// loginLockDuration returns lock duration after the failures: doubling delay after delayAfter
+// failures and full lockout after maxFailures. Progressive delay is a short lock refusing the next attempt
+// until the delay passes, requests are not held open, so slowed down clients do not occupy the server.
+func loginLockDuration(failures int64, delayAfter int64, maxFailures int64) time.Duration {
+	if maxFailures > 0 && failures >= maxFailures {
+		return time.Duration(viper.GetInt("login_protection.lockout_duration")) * time.Minute
+	}
+
+	if failures < delayAfter {
+		return 0
+	}
+
+	baseDelay := time.Duration(viper.GetInt("login_protection.base_delay")) * time.Second
+	maxDelay := time.Duration(viper.GetInt("login_protection.max_delay")) * time.Second
+
+	shift := failures - delayAfter
+	if shift > maxDelayShift {
+		shift = maxDelayShift
+	}
+
+	delay := baseDelay << shift
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	return delay
+}
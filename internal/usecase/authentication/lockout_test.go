@@ -0,0 +1,157 @@
+package authentication
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	mockCache "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockredis"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// initLockoutTest sets limits of sign in failures used by the tests.
+func initLockoutTest() {
+	initPasswordTest()
+
+	viper.Set("login_protection.failure_window", 15)
+	viper.Set("login_protection.delay_after", 3)
+	viper.Set("login_protection.base_delay", 1)
+	viper.Set("login_protection.max_delay", 60)
+	viper.Set("login_protection.phone_max_failures", 10)
+	viper.Set("login_protection.ip_max_failures", 50)
+	viper.Set("login_protection.lockout_duration", 15)
+}
+
+func TestLoginLockDuration(t *testing.T) {
+	initLockoutTest()
+
+	tests := []struct {
+		name        string
+		failures    int64
+		delayAfter  int64
+		maxFailures int64
+		expected    time.Duration
+	}{
+		{name: "no failures", failures: 0, delayAfter: 3, maxFailures: 10, expected: 0},
+		{name: "below delay threshold", failures: 2, delayAfter: 3, maxFailures: 10, expected: 0},
+		{name: "delay threshold", failures: 3, delayAfter: 3, maxFailures: 10, expected: time.Second},
+		{name: "doubled delay", failures: 4, delayAfter: 3, maxFailures: 10, expected: 2 * time.Second},
+		{name: "twice doubled delay", failures: 5, delayAfter: 3, maxFailures: 10, expected: 4 * time.Second},
+		{name: "max delay", failures: 9, delayAfter: 3, maxFailures: 10, expected: time.Minute},
+		{name: "lockout", failures: 10, delayAfter: 3, maxFailures: 10, expected: 15 * time.Minute},
+		{name: "after lockout", failures: 11, delayAfter: 3, maxFailures: 10, expected: 15 * time.Minute},
+		{name: "lockout disabled", failures: 100, delayAfter: 3, maxFailures: 0, expected: time.Minute},
+		{name: "ip below lockout", failures: 49, delayAfter: 50, maxFailures: 50, expected: 0},
+		{name: "ip lockout", failures: 50, delayAfter: 50, maxFailures: 50, expected: 15 * time.Minute},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, loginLockDuration(test.failures, test.delayAfter, test.maxFailures), test.name)
+	}
+}
+
+func TestSignInLockout(t *testing.T) {
+	initLockoutTest()
+
+	hash, err := usecase.GeneratePasswordHash(testPassword, usecase.PasswordParams())
+	assert.NoError(t, err)
+
+	phoneSubject := loginSubjectPhone + testPhone
+	ipSubject := loginSubjectIP + testIP
+
+	newUseCase := func(phoneLock time.Duration, ipLock time.Duration, phoneFailures int64, ipFailures int64) (
+		*UseCase, *mockStorage.Authentication, *mockCache.Authentication,
+	) {
+		storageRepo := new(mockStorage.Authentication)
+		cacheRepo := new(mockCache.Authentication)
+
+		storageRepo.On("AuthenticationGetUser", mock.Anything, "", testPhone).
+			Return(user.User{ID: testUserID, Phone: testPhone, Password: hash, Status: user.StatusPending}, nil)
+		storageRepo.On("AuthenticationGetUser", mock.Anything, "", mock.Anything).
+			Return(user.User{}, errors.Wrap(sql.ErrNoRows, "user select query error"))
+		cacheRepo.On("AuthenticationGetLoginLock", mock.Anything, phoneSubject).Return(phoneLock, nil)
+		cacheRepo.On("AuthenticationGetLoginLock", mock.Anything, mock.Anything).Return(ipLock, nil)
+		cacheRepo.On("AuthenticationIncrLoginFailures", mock.Anything, phoneSubject, mock.Anything).Return(phoneFailures, nil)
+		cacheRepo.On("AuthenticationIncrLoginFailures", mock.Anything, mock.Anything, mock.Anything).Return(ipFailures, nil)
+		cacheRepo.On("AuthenticationLockLogin", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+		cacheRepo.On("AuthenticationResetLoginFailures", mock.Anything, mock.Anything).Return(nil)
+
+		return New(storageRepo, cacheRepo, nil, nil, false, false), storageRepo, cacheRepo
+	}
+
+	t.Run("AuthenticationSignInPhoneLocked", func(t *testing.T) {
+		ucAuthentication, storageRepo, _ := newUseCase(time.Minute, 0, 0, 0)
+
+		_, _, _, err := ucAuthentication.AuthenticationSignIn(context.Empty(), testPhone, testPassword, testIP)
+		assert.ErrorIs(t, err, usecase.ErrLoginLocked)
+
+		storageRepo.AssertNotCalled(t, "AuthenticationGetUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AuthenticationSignInIPLocked", func(t *testing.T) {
+		ucAuthentication, storageRepo, _ := newUseCase(0, time.Minute, 0, 0)
+
+		_, _, _, err := ucAuthentication.AuthenticationSignIn(context.Empty(), testPhone, testPassword, testIP)
+		assert.ErrorIs(t, err, usecase.ErrLoginLocked)
+
+		storageRepo.AssertNotCalled(t, "AuthenticationGetUser", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AuthenticationSignInPhoneDelay", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo := newUseCase(0, 0, 3, 3)
+
+		_, _, _, err := ucAuthentication.AuthenticationSignIn(context.Empty(), testPhone, "wrong", testIP)
+		assert.ErrorIs(t, err, usecase.ErrInvalidPassword)
+
+		cacheRepo.AssertCalled(t, "AuthenticationLockLogin", mock.Anything, phoneSubject, time.Second)
+		cacheRepo.AssertNotCalled(t, "AuthenticationLockLogin", mock.Anything, ipSubject, mock.Anything)
+	})
+
+	t.Run("AuthenticationSignInPhoneLockout", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo := newUseCase(0, 0, 10, 10)
+
+		_, _, _, err := ucAuthentication.AuthenticationSignIn(context.Empty(), testPhone, "wrong", testIP)
+		assert.ErrorIs(t, err, usecase.ErrInvalidPassword)
+
+		cacheRepo.AssertCalled(t, "AuthenticationLockLogin", mock.Anything, phoneSubject, 15*time.Minute)
+		cacheRepo.AssertNotCalled(t, "AuthenticationLockLogin", mock.Anything, ipSubject, mock.Anything)
+	})
+
+	t.Run("AuthenticationSignInIPLockout", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo := newUseCase(0, 0, 1, 50)
+
+		_, _, _, err := ucAuthentication.AuthenticationSignIn(context.Empty(), testPhone, "wrong", testIP)
+		assert.ErrorIs(t, err, usecase.ErrInvalidPassword)
+
+		cacheRepo.AssertCalled(t, "AuthenticationLockLogin", mock.Anything, ipSubject, 15*time.Minute)
+		cacheRepo.AssertNumberOfCalls(t, "AuthenticationLockLogin", 1)
+	})
+
+	t.Run("AuthenticationSignInUnknownPhone", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo := newUseCase(0, 0, 1, 1)
+
+		_, _, _, err := ucAuthentication.AuthenticationSignIn(context.Empty(), "99361999999", testPassword, testIP)
+		assert.ErrorIs(t, err, usecase.ErrInvalidPassword)
+
+		cacheRepo.AssertCalled(t, "AuthenticationIncrLoginFailures", mock.Anything, loginSubjectPhone+"99361999999", mock.Anything)
+		cacheRepo.AssertCalled(t, "AuthenticationIncrLoginFailures", mock.Anything, ipSubject, mock.Anything)
+	})
+
+	t.Run("AuthenticationSignInResetsPhoneFailures", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo := newUseCase(0, 0, 0, 0)
+
+		_, _, _, err := ucAuthentication.AuthenticationSignIn(context.Empty(), testPhone, testPassword, testIP)
+		assert.ErrorIs(t, err, usecase.ErrUserNotVerified)
+
+		cacheRepo.AssertCalled(t, "AuthenticationResetLoginFailures", mock.Anything, phoneSubject)
+		cacheRepo.AssertNotCalled(t, "AuthenticationResetLoginFailures", mock.Anything, ipSubject)
+		cacheRepo.AssertNotCalled(t, "AuthenticationIncrLoginFailures", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
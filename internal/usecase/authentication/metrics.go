@@ -0,0 +1,18 @@
+package authentication
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const (
+	failureReasonInvalidCredentials = "invalid_credentials"
+	failureReasonLocked             = "locked"
+)
+
+// failedLogins counts failed sign in attempts by reason.
+var failedLogins = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "marketplace",
+	Name:      "failed_logins_total",
+	Help:      "Number of failed sign in attempts.",
+}, []string{"reason"})
@@ -0,0 +1,209 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+)
+
+const (
+	oidcRandomLength    = 32
+	customerRole        = "customer"
+	defaultOIDCRole     = customerRole
+	defaultOIDCStateTTL = 10
+)
+
+// AuthenticationOIDCStart starts OpenID Connect login and returns provider authorization URL.
+func (s *UseCase) AuthenticationOIDCStart(ctx context.Context, provider string) (string, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationOIDCStart")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if !s.adapterIdentity.HasProvider(provider) {
+		return "", usecase.ErrUnknownIdentityProvider
+	}
+
+	state, err := randomURLString()
+	if err != nil {
+		return "", err
+	}
+
+	nonce, err := randomURLString()
+	if err != nil {
+		return "", err
+	}
+
+	verifier, err := randomURLString()
+	if err != nil {
+		return "", err
+	}
+
+	ttl := time.Duration(viper.GetInt("oidc.state_ttl")) * time.Minute
+	if ttl <= 0 {
+		ttl = defaultOIDCStateTTL * time.Minute
+	}
+
+	pending := user.OIDCState{Provider: provider, CodeVerifier: verifier, Nonce: nonce}
+
+	if err = s.adapterCache.AuthenticationSetOIDCState(ctx, hashToken(state), pending, ttl); err != nil {
+		return "", errors.Wrap(err, "can not store login state")
+	}
+
+	authURL, err := s.adapterIdentity.AuthCodeURL(ctx, provider, state, nonce, codeChallenge(verifier))
+
+	return authURL, errors.Wrap(err, "can not build authorization url")
+}
+
+// AuthenticationOIDCCallback finishes OpenID Connect login, links the identity to the user and issues tokens.
+// Second factor is left to the identity provider.
+func (s *UseCase) AuthenticationOIDCCallback(ctx context.Context, provider string, code string, state string) (user.User, token.Tokens, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationOIDCCallback")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	pending, err := s.adapterCache.AuthenticationPopOIDCState(ctx, hashToken(state))
+	if err != nil || pending.Provider != provider {
+		return user.User{}, token.Tokens{}, usecase.ErrInvalidOIDCState
+	}
+
+	identity, err := s.adapterIdentity.Exchange(ctx, provider, code, pending.CodeVerifier, pending.Nonce)
+	if err != nil {
+		return user.User{}, token.Tokens{}, errors.Wrap(err, "can not exchange authorization code")
+	}
+
+	userID, err := s.linkIdentity(ctx, identity)
+	if err != nil {
+		return user.User{}, token.Tokens{}, err
+	}
+
+	return s.AuthenticationGenerateToken(ctx, userID, "", "")
+}
+
+// linkIdentity returns the user linked to the identity. Unknown identities are linked by verified phone number
+// to customers without organization roles or get a new active user. The role mapped from provider claims is given
+// only to new users, roles of existing users are not changed on login.
+func (s *UseCase) linkIdentity(ctx context.Context, identity user.ExternalIdentity) (string, error) {
+	userID, err := s.adapterStorage.AuthenticationGetIdentityUser(ctx, identity.Provider, identity.Subject)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return "", errors.Wrap(err, "can not get identity")
+	}
+
+	if userID == "" {
+		if identity.Phone == "" || !identity.PhoneVerified {
+			return "", usecase.ErrIdentityNotLinkable
+		}
+
+		usr, err := s.adapterStorage.AuthenticationGetUser(ctx, "", identity.Phone)
+		if err != nil && !errors.Is(err, sql.ErrNoRows) {
+			return "", errors.Wrap(err, "can not get user")
+		}
+
+		if usr.ID == "" {
+			return s.createExternalUser(ctx, identity)
+		}
+
+		if err = s.checkLinkable(ctx, usr); err != nil {
+			return "", err
+		}
+
+		if usr.Status == user.StatusPending {
+			if err = s.adapterStorage.AuthenticationActivateUser(ctx, identity.Phone); err != nil {
+				return "", errors.Wrap(err, "can not activate user")
+			}
+		}
+
+		userID = usr.ID
+	}
+
+	if err = s.adapterStorage.AuthenticationLinkIdentity(ctx, userID, identity.Provider, identity.Subject); err != nil {
+		return "", errors.Wrap(err, "can not link identity")
+	}
+
+	return userID, nil
+}
+
+// checkLinkable checks if the existing user may be linked to an identity by phone number. Staff accounts are not
+// linked automatically, so a phone number claimed by the provider does not give access to them.
+func (s *UseCase) checkLinkable(ctx context.Context, usr user.User) error {
+	if usr.RoleName != customerRole {
+		return usecase.ErrIdentityLinkRefused
+	}
+
+	isMember, err := s.adapterStorage.AuthenticationIsMember(ctx, usr.ID)
+	if err != nil {
+		return errors.Wrap(err, "can not get memberships")
+	}
+
+	if isMember {
+		return usecase.ErrIdentityLinkRefused
+	}
+
+	return nil
+}
+
+// createExternalUser creates active user for the identity with a random password.
+func (s *UseCase) createExternalUser(ctx context.Context, identity user.ExternalIdentity) (string, error) {
+	password, err := randomURLString()
+	if err != nil {
+		return "", err
+	}
+
+	hash, err := usecase.GeneratePasswordHash(password, usecase.PasswordParams())
+	if err != nil {
+		return "", errors.Wrap(err, "can not generate password hash")
+	}
+
+	roleName := identity.RoleName
+	if roleName == "" {
+		roleName = viper.GetString("oidc.default_role")
+	}
+
+	if roleName == "" {
+		roleName = defaultOIDCRole
+	}
+
+	input := user.CreateUserInput{
+		Phone:     identity.Phone,
+		Password:  hash,
+		FirstName: identity.FirstName,
+		LastName:  identity.LastName,
+	}
+
+	userID, err := s.adapterStorage.AuthenticationCreateExternalUser(ctx, input, roleName, identity.Provider, identity.Subject)
+
+	return userID, errors.Wrap(err, "can not create user")
+}
+
+// codeChallenge returns S256 PKCE code challenge of the verifier.
+func codeChallenge(verifier string) string {
+	sum := sha256.Sum256([]byte(verifier))
+
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// randomURLString returns random base64url encoded string.
+func randomURLString() (string, error) {
+	raw := make([]byte, oidcRandomLength)
+
+	if _, err := rand.Read(raw); err != nil {
+		return "", errors.Wrap(err, "can not generate random string")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(raw), nil
+}
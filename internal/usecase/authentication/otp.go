@@ -0,0 +1,184 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	otpPurposeSignUp = "signup"
+	otpPurposeReset  = "reset"
+	otpDigits        = 10
+	defaultOTPLength = 6
+	// defaultOTPAttemptsWindow is minutes during which failed attempts of the phone number are counted.
+	defaultOTPAttemptsWindow = 60
+	signUpOTPMessage         = "Your marketplace verification code: %s"
+	resetOTPMessage          = "Your marketplace password reset code: %s"
+)
+
+// AuthenticationRequestOTP sends new phone verification code to the user waiting for verification.
+func (s *UseCase) AuthenticationRequestOTP(ctx context.Context, phone string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationRequestOTP")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	usr, err := s.adapterStorage.AuthenticationGetUser(ctx, "", phone)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return errors.Wrap(err, "can not get user")
+	}
+
+	if usr.Status != user.StatusPending {
+		return nil
+	}
+
+	return s.sendOTP(ctx, otpPurposeSignUp, phone, signUpOTPMessage)
+}
+
+// AuthenticationVerifyPhone checks phone verification code and activates the user.
+func (s *UseCase) AuthenticationVerifyPhone(ctx context.Context, phone string, code string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationVerifyPhone")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkOTP(ctx, otpPurposeSignUp, phone, code); err != nil {
+		return err
+	}
+
+	err := s.adapterStorage.AuthenticationActivateUser(ctx, phone)
+
+	return errors.Wrap(err, "can not activate user")
+}
+
+// sendOTP generates, stores and sends new one-time code.
+func (s *UseCase) sendOTP(ctx context.Context, purpose string, phone string, message string) error {
+	if err := s.lockOTPResend(ctx, purpose, phone); err != nil {
+		return err
+	}
+
+	return s.deliverOTP(ctx, purpose, phone, message)
+}
+
+// lockOTPResend forbids sending another one-time code to the phone number until resend interval passes.
+func (s *UseCase) lockOTPResend(ctx context.Context, purpose string, phone string) error {
+	resendInterval := time.Duration(viper.GetInt("otp.resend_interval")) * time.Second
+
+	locked, err := s.adapterCache.AuthenticationLockOTPResend(ctx, purpose, phone, resendInterval)
+	if err != nil {
+		return errors.Wrap(err, "can not lock one-time code resend")
+	}
+
+	if !locked {
+		return usecase.ErrOTPResendTooEarly
+	}
+
+	return nil
+}
+
+// deliverOTP generates, stores and sends new one-time code without checking resend interval.
+func (s *UseCase) deliverOTP(ctx context.Context, purpose string, phone string, message string) error {
+	ttl := time.Duration(viper.GetInt("otp.ttl")) * time.Minute
+
+	code, err := generateOTP(viper.GetInt("otp.length"))
+	if err != nil {
+		return err
+	}
+
+	if err = s.adapterCache.AuthenticationSetOTP(ctx, purpose, phone, hashOTP(phone, code), ttl); err != nil {
+		return errors.Wrap(err, "can not store one-time code")
+	}
+
+	err = s.adapterSMS.Send(ctx, phone, fmt.Sprintf(message, code))
+
+	return errors.Wrap(err, "can not send one-time code")
+}
+
+// checkOTP verifies one-time code and deletes it after success or too many attempts. Attempts are counted per phone
+// number across resent codes and reset only after success or when the attempts window passes.
+func (s *UseCase) checkOTP(ctx context.Context, purpose string, phone string, code string) error {
+	window := time.Duration(viper.GetInt("otp.attempts_window")) * time.Minute
+	if window <= 0 {
+		window = defaultOTPAttemptsWindow * time.Minute
+	}
+
+	attempts, err := s.adapterCache.AuthenticationIncrOTPAttempts(ctx, purpose, phone, window)
+	if err != nil {
+		return errors.Wrap(err, "can not count one-time code attempts")
+	}
+
+	if attempts > int64(viper.GetInt("otp.max_attempts")) {
+		if err = s.adapterCache.AuthenticationDeleteOTP(ctx, purpose, phone, false); err != nil {
+			logger.Logger.Error("unable to delete one-time code from cache", zap.String("error", err.Error()))
+		}
+
+		return usecase.ErrOTPAttemptsExceeded
+	}
+
+	stored, err := s.adapterCache.AuthenticationGetOTP(ctx, purpose, phone)
+	if err != nil || subtle.ConstantTimeCompare([]byte(stored), []byte(hashOTP(phone, code))) != 1 {
+		return usecase.ErrInvalidOTP
+	}
+
+	if err = s.adapterCache.AuthenticationDeleteOTP(ctx, purpose, phone, true); err != nil {
+		logger.Logger.Error("unable to delete one-time code from cache", zap.String("error", err.Error()))
+	}
+
+	return nil
+}
+
+// generateOTP generates random numeric code.
+func generateOTP(length int) (string, error) {
+	if length <= 0 {
+		length = defaultOTPLength
+	}
+
+	var builder strings.Builder
+
+	for i := 0; i < length; i++ {
+		digit, err := rand.Int(rand.Reader, big.NewInt(otpDigits))
+		if err != nil {
+			return "", errors.Wrap(err, "can not generate one-time code")
+		}
+
+		builder.WriteString(digit.String())
+	}
+
+	return builder.String(), nil
+}
+
+// hashOTP returns one-time code hash bound to the phone number.
+func hashOTP(phone string, code string) string {
+	return hashToken(phone + ":" + code)
+}
+
+// hashToken returns hex encoded sha256 hash of the value.
+func hashToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,137 @@
+package authentication
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	mockCache "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockredis"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestSignUp(t *testing.T) {
+	initPasswordTest()
+
+	input := user.SignUpInput{Phone: testPhone, Password: testPassword, FirstName: "John", LastName: "Doe"}
+
+	newUseCase := func(locked bool) (*UseCase, *mockStorage.Authentication, *mockCache.Authentication, *smsSender) {
+		storageRepo := new(mockStorage.Authentication)
+		cacheRepo := new(mockCache.Authentication)
+		sender := &smsSender{}
+
+		storageRepo.On("AuthenticationCreateUser", mock.Anything, mock.MatchedBy(func(input user.SignUpInput) bool {
+			return input.Phone == testPhone
+		})).Return(testUserID, nil)
+		storageRepo.On("AuthenticationCreateUser", mock.Anything, mock.Anything).Return("", errStorage)
+		cacheRepo.On("AuthenticationLockOTPResend", mock.Anything, otpPurposeSignUp, mock.Anything, mock.Anything).
+			Return(!locked, nil)
+		cacheRepo.On("AuthenticationSetOTP", mock.Anything, otpPurposeSignUp, testPhone, mock.Anything, mock.Anything).
+			Return(nil)
+
+		return New(storageRepo, cacheRepo, sender, nil, false, false), storageRepo, cacheRepo, sender
+	}
+
+	t.Run("AuthenticationCreateUser", func(t *testing.T) {
+		ucAuthentication, storageRepo, _, sender := newUseCase(false)
+
+		userID, err := ucAuthentication.AuthenticationCreateUser(context.Empty(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, testUserID, userID)
+		assert.Len(t, sender.messages, 1)
+
+		storageRepo.AssertNotCalled(t, "AuthenticationCreateUser", mock.Anything, input)
+	})
+
+	t.Run("AuthenticationCreateUserWithError", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo, sender := newUseCase(false)
+
+		_, err := ucAuthentication.AuthenticationCreateUser(context.Empty(),
+			user.SignUpInput{Phone: "99361999999", Password: testPassword})
+		assert.ErrorIs(t, err, errStorage)
+		assert.Empty(t, sender.messages)
+
+		cacheRepo.AssertNotCalled(t, "AuthenticationLockOTPResend", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AuthenticationCreateUserCodeNotSent", func(t *testing.T) {
+		ucAuthentication, _, _, sender := newUseCase(true)
+
+		userID, err := ucAuthentication.AuthenticationCreateUser(context.Empty(), input)
+		assert.NoError(t, err)
+		assert.Equal(t, testUserID, userID)
+		assert.Empty(t, sender.messages)
+	})
+}
+
+func TestVerifyPhone(t *testing.T) {
+	initPasswordTest()
+
+	newUseCase := func(status string) (*UseCase, *mockStorage.Authentication, *mockCache.Authentication, *smsSender) {
+		storageRepo := new(mockStorage.Authentication)
+		cacheRepo := new(mockCache.Authentication)
+		sender := &smsSender{}
+
+		storageRepo.On("AuthenticationGetUser", mock.Anything, "", testPhone).
+			Return(user.User{ID: testUserID, Phone: testPhone, Status: status}, nil)
+		storageRepo.On("AuthenticationGetUser", mock.Anything, "", mock.Anything).
+			Return(user.User{}, errors.Wrap(sql.ErrNoRows, "user select query error"))
+		storageRepo.On("AuthenticationActivateUser", mock.Anything, testPhone).Return(nil)
+		cacheRepo.On("AuthenticationLockOTPResend", mock.Anything, otpPurposeSignUp, mock.Anything, mock.Anything).
+			Return(true, nil)
+		cacheRepo.On("AuthenticationSetOTP", mock.Anything, otpPurposeSignUp, testPhone, mock.Anything, mock.Anything).
+			Return(nil)
+		cacheRepo.On("AuthenticationIncrOTPAttempts", mock.Anything, otpPurposeSignUp, testPhone, mock.Anything).
+			Return(int64(1), nil)
+		cacheRepo.On("AuthenticationGetOTP", mock.Anything, otpPurposeSignUp, testPhone).
+			Return(hashOTP(testPhone, testCode), nil)
+		cacheRepo.On("AuthenticationDeleteOTP", mock.Anything, otpPurposeSignUp, testPhone, mock.Anything).Return(nil)
+
+		return New(storageRepo, cacheRepo, sender, nil, false, false), storageRepo, cacheRepo, sender
+	}
+
+	t.Run("AuthenticationRequestOTP", func(t *testing.T) {
+		ucAuthentication, _, _, sender := newUseCase(user.StatusPending)
+
+		assert.NoError(t, ucAuthentication.AuthenticationRequestOTP(context.Empty(), testPhone))
+		assert.Len(t, sender.messages, 1)
+	})
+
+	t.Run("AuthenticationRequestOTPActiveUser", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo, sender := newUseCase(user.StatusActive)
+
+		assert.NoError(t, ucAuthentication.AuthenticationRequestOTP(context.Empty(), testPhone))
+		assert.Empty(t, sender.messages)
+
+		cacheRepo.AssertNotCalled(t, "AuthenticationSetOTP", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AuthenticationRequestOTPUnknownPhone", func(t *testing.T) {
+		ucAuthentication, _, _, sender := newUseCase(user.StatusPending)
+
+		assert.NoError(t, ucAuthentication.AuthenticationRequestOTP(context.Empty(), "99361999999"))
+		assert.Empty(t, sender.messages)
+	})
+
+	t.Run("AuthenticationVerifyPhone", func(t *testing.T) {
+		ucAuthentication, storageRepo, cacheRepo, _ := newUseCase(user.StatusPending)
+
+		assert.NoError(t, ucAuthentication.AuthenticationVerifyPhone(context.Empty(), testPhone, testCode))
+
+		storageRepo.AssertCalled(t, "AuthenticationActivateUser", mock.Anything, testPhone)
+		cacheRepo.AssertCalled(t, "AuthenticationDeleteOTP", mock.Anything, otpPurposeSignUp, testPhone, true)
+	})
+
+	t.Run("AuthenticationVerifyPhoneWrongCode", func(t *testing.T) {
+		ucAuthentication, storageRepo, _, _ := newUseCase(user.StatusPending)
+
+		err := ucAuthentication.AuthenticationVerifyPhone(context.Empty(), testPhone, "654321")
+		assert.ErrorIs(t, err, usecase.ErrInvalidOTP)
+
+		storageRepo.AssertNotCalled(t, "AuthenticationActivateUser", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,108 @@
+package authentication
+
+import (
+	"database/sql"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// AuthenticationForgotPassword sends password reset code to the phone number. Resend interval is checked before
+// the user is looked up, so the response does not reveal whether the phone number is registered.
+func (s *UseCase) AuthenticationForgotPassword(ctx context.Context, phone string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationForgotPassword")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.lockOTPResend(ctx, otpPurposeReset, phone); err != nil {
+		return err
+	}
+
+	if _, err := s.adapterStorage.AuthenticationGetUser(ctx, "", phone); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil
+		}
+
+		return errors.Wrap(err, "can not get user")
+	}
+
+	return s.deliverOTP(ctx, otpPurposeReset, phone, resetOTPMessage)
+}
+
+// AuthenticationResetPassword checks password reset code, sets new password and revokes all sessions.
+func (s *UseCase) AuthenticationResetPassword(ctx context.Context, input user.ResetPasswordInput) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationResetPassword")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkOTP(ctx, otpPurposeReset, input.Phone, input.Code); err != nil {
+		return err
+	}
+
+	usr, err := s.adapterStorage.AuthenticationGetUser(ctx, "", input.Phone)
+	if err != nil {
+		return errors.Wrap(err, "can not get user")
+	}
+
+	return s.setPassword(ctx, usr.ID, input.Password)
+}
+
+// AuthenticationChangePassword checks current password, sets new one and revokes all sessions. Wrong current
+// passwords are counted and locked like failed sign in attempts of the phone and ip.
+func (s *UseCase) AuthenticationChangePassword(ctx context.Context, userID string, ip string, input user.ChangePasswordInput) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationChangePassword")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	usr, err := s.adapterStorage.AuthenticationGetUser(ctx, userID, "")
+	if err != nil {
+		return errors.Wrap(err, "can not get user")
+	}
+
+	if err = s.checkLoginLock(ctx, usr.Phone, ip); err != nil {
+		return err
+	}
+
+	match, err := usecase.ComparePasswordAndHash(input.CurrentPassword, usr.Password)
+	if err != nil {
+		return err
+	}
+
+	if !match {
+		s.registerLoginFailure(ctx, usr.Phone, ip)
+
+		return usecase.ErrInvalidPassword
+	}
+
+	s.resetLoginFailures(ctx, usr.Phone)
+
+	return s.setPassword(ctx, userID, input.NewPassword)
+}
+
+// setPassword hashes and stores new password, then revokes all sessions of the user.
+func (s *UseCase) setPassword(ctx context.Context, userID string, password string) error {
+	pass, err := usecase.GeneratePasswordHash(password, usecase.PasswordParams())
+	if err != nil {
+		return errors.Wrap(err, "can not generate password hash")
+	}
+
+	if err = s.adapterStorage.AuthenticationUpdatePassword(ctx, userID, pass); err != nil {
+		return errors.Wrap(err, "can not update password")
+	}
+
+	err = s.adapterStorage.AuthenticationRevokeSessions(ctx, userID)
+
+	return errors.Wrap(err, "sessions revoke failed")
+}
@@ -0,0 +1,222 @@
+package authentication
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	mockCache "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockredis"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testPhone    = "99361000000"
+	testIP       = "10.0.0.1"
+	testPassword = "current password"
+	testCode     = "123456"
+)
+
+// smsSender is a test sender remembering sent messages.
+type smsSender struct {
+	messages []string
+}
+
+func (s *smsSender) Send(_ context.Context, _ string, message string) error {
+	s.messages = append(s.messages, message)
+
+	return nil
+}
+
+// initPasswordTest sets fast password hashing and limits of one-time codes and sign in failures.
+func initPasswordTest() {
+	_ = logger.InitLogger()
+
+	viper.Set("password_hash.memory", 1024)
+	viper.Set("password_hash.iterations", 1)
+	viper.Set("password_hash.parallelism", 1)
+	viper.Set("otp.max_attempts", 3)
+	viper.Set("login_protection.delay_after", 3)
+	viper.Set("login_protection.phone_max_failures", 5)
+	viper.Set("login_protection.ip_max_failures", 20)
+}
+
+func TestChangePassword(t *testing.T) {
+	initPasswordTest()
+
+	hash, err := usecase.GeneratePasswordHash(testPassword, usecase.PasswordParams())
+	assert.NoError(t, err)
+
+	newUseCase := func() (*UseCase, *mockStorage.Authentication, *mockCache.Authentication) {
+		storageRepo := new(mockStorage.Authentication)
+		cacheRepo := new(mockCache.Authentication)
+
+		storageRepo.On("AuthenticationGetUser", mock.Anything, testUserID, "").
+			Return(user.User{ID: testUserID, Phone: testPhone, Password: hash}, nil)
+		storageRepo.On("AuthenticationUpdatePassword", mock.Anything, testUserID, mock.AnythingOfType("string")).
+			Return(nil)
+		storageRepo.On("AuthenticationRevokeSessions", mock.Anything, testUserID).Return(nil)
+		cacheRepo.On("AuthenticationIncrLoginFailures", mock.Anything, mock.Anything, mock.Anything).Return(int64(1), nil)
+		cacheRepo.On("AuthenticationResetLoginFailures", mock.Anything, loginSubjectPhone+testPhone).Return(nil)
+
+		return New(storageRepo, cacheRepo, nil, nil, false, false), storageRepo, cacheRepo
+	}
+
+	t.Run("AuthenticationChangePassword", func(t *testing.T) {
+		ucAuthentication, storageRepo, cacheRepo := newUseCase()
+		cacheRepo.On("AuthenticationGetLoginLock", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+
+		err := ucAuthentication.AuthenticationChangePassword(context.Empty(), testUserID, testIP,
+			user.ChangePasswordInput{CurrentPassword: testPassword, NewPassword: "new password"})
+		assert.NoError(t, err)
+
+		storageRepo.AssertCalled(t, "AuthenticationUpdatePassword", mock.Anything, testUserID, mock.AnythingOfType("string"))
+		storageRepo.AssertCalled(t, "AuthenticationRevokeSessions", mock.Anything, testUserID)
+		cacheRepo.AssertCalled(t, "AuthenticationResetLoginFailures", mock.Anything, loginSubjectPhone+testPhone)
+	})
+
+	t.Run("AuthenticationChangePasswordWrongCurrent", func(t *testing.T) {
+		ucAuthentication, storageRepo, cacheRepo := newUseCase()
+		cacheRepo.On("AuthenticationGetLoginLock", mock.Anything, mock.Anything).Return(time.Duration(0), nil)
+
+		err := ucAuthentication.AuthenticationChangePassword(context.Empty(), testUserID, testIP,
+			user.ChangePasswordInput{CurrentPassword: "wrong", NewPassword: "new password"})
+		assert.ErrorIs(t, err, usecase.ErrInvalidPassword)
+
+		cacheRepo.AssertCalled(t, "AuthenticationIncrLoginFailures", mock.Anything, loginSubjectPhone+testPhone, mock.Anything)
+		cacheRepo.AssertCalled(t, "AuthenticationIncrLoginFailures", mock.Anything, loginSubjectIP+testIP, mock.Anything)
+		storageRepo.AssertNotCalled(t, "AuthenticationUpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+		storageRepo.AssertNotCalled(t, "AuthenticationRevokeSessions", mock.Anything, mock.Anything)
+	})
+
+	t.Run("AuthenticationChangePasswordLocked", func(t *testing.T) {
+		ucAuthentication, storageRepo, cacheRepo := newUseCase()
+		cacheRepo.On("AuthenticationGetLoginLock", mock.Anything, loginSubjectPhone+testPhone).Return(time.Minute, nil)
+
+		err := ucAuthentication.AuthenticationChangePassword(context.Empty(), testUserID, testIP,
+			user.ChangePasswordInput{CurrentPassword: testPassword, NewPassword: "new password"})
+		assert.ErrorIs(t, err, usecase.ErrLoginLocked)
+
+		storageRepo.AssertNotCalled(t, "AuthenticationUpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestResetPassword(t *testing.T) {
+	initPasswordTest()
+
+	input := user.ResetPasswordInput{Phone: testPhone, Code: testCode, Password: "new password"}
+
+	newUseCase := func(attempts int64) (*UseCase, *mockStorage.Authentication, *mockCache.Authentication) {
+		storageRepo := new(mockStorage.Authentication)
+		cacheRepo := new(mockCache.Authentication)
+
+		storageRepo.On("AuthenticationGetUser", mock.Anything, "", testPhone).Return(user.User{ID: testUserID}, nil)
+		storageRepo.On("AuthenticationUpdatePassword", mock.Anything, testUserID, mock.AnythingOfType("string")).
+			Return(nil)
+		storageRepo.On("AuthenticationRevokeSessions", mock.Anything, testUserID).Return(nil)
+		cacheRepo.On("AuthenticationIncrOTPAttempts", mock.Anything, otpPurposeReset, testPhone, mock.Anything).
+			Return(attempts, nil)
+		cacheRepo.On("AuthenticationDeleteOTP", mock.Anything, otpPurposeReset, testPhone, mock.Anything).Return(nil)
+
+		return New(storageRepo, cacheRepo, nil, nil, false, false), storageRepo, cacheRepo
+	}
+
+	t.Run("AuthenticationResetPassword", func(t *testing.T) {
+		ucAuthentication, storageRepo, cacheRepo := newUseCase(1)
+		cacheRepo.On("AuthenticationGetOTP", mock.Anything, otpPurposeReset, testPhone).
+			Return(hashOTP(testPhone, testCode), nil)
+
+		assert.NoError(t, ucAuthentication.AuthenticationResetPassword(context.Empty(), input))
+
+		cacheRepo.AssertCalled(t, "AuthenticationDeleteOTP", mock.Anything, otpPurposeReset, testPhone, true)
+		storageRepo.AssertCalled(t, "AuthenticationRevokeSessions", mock.Anything, testUserID)
+	})
+
+	t.Run("AuthenticationResetPasswordExpiredOrUsedCode", func(t *testing.T) {
+		ucAuthentication, storageRepo, cacheRepo := newUseCase(1)
+		cacheRepo.On("AuthenticationGetOTP", mock.Anything, otpPurposeReset, testPhone).
+			Return("", errors.New("redis: nil"))
+
+		assert.ErrorIs(t, ucAuthentication.AuthenticationResetPassword(context.Empty(), input), usecase.ErrInvalidOTP)
+
+		storageRepo.AssertNotCalled(t, "AuthenticationUpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AuthenticationResetPasswordWrongCode", func(t *testing.T) {
+		ucAuthentication, storageRepo, cacheRepo := newUseCase(1)
+		cacheRepo.On("AuthenticationGetOTP", mock.Anything, otpPurposeReset, testPhone).
+			Return(hashOTP(testPhone, "654321"), nil)
+
+		assert.ErrorIs(t, ucAuthentication.AuthenticationResetPassword(context.Empty(), input), usecase.ErrInvalidOTP)
+
+		cacheRepo.AssertNotCalled(t, "AuthenticationDeleteOTP", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		storageRepo.AssertNotCalled(t, "AuthenticationUpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("AuthenticationResetPasswordAttemptsExceeded", func(t *testing.T) {
+		ucAuthentication, storageRepo, cacheRepo := newUseCase(4)
+
+		err := ucAuthentication.AuthenticationResetPassword(context.Empty(), input)
+		assert.ErrorIs(t, err, usecase.ErrOTPAttemptsExceeded)
+
+		cacheRepo.AssertCalled(t, "AuthenticationDeleteOTP", mock.Anything, otpPurposeReset, testPhone, false)
+		cacheRepo.AssertNotCalled(t, "AuthenticationGetOTP", mock.Anything, mock.Anything, mock.Anything)
+		storageRepo.AssertNotCalled(t, "AuthenticationUpdatePassword", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestForgotPassword(t *testing.T) {
+	initPasswordTest()
+
+	newUseCase := func(locked bool) (*UseCase, *mockStorage.Authentication, *mockCache.Authentication, *smsSender) {
+		storageRepo := new(mockStorage.Authentication)
+		cacheRepo := new(mockCache.Authentication)
+		sender := &smsSender{}
+
+		storageRepo.On("AuthenticationGetUser", mock.Anything, "", testPhone).Return(user.User{ID: testUserID}, nil)
+		storageRepo.On("AuthenticationGetUser", mock.Anything, "", mock.Anything).
+			Return(user.User{}, errors.Wrap(sql.ErrNoRows, "user select query error"))
+		cacheRepo.On("AuthenticationLockOTPResend", mock.Anything, otpPurposeReset, mock.Anything, mock.Anything).
+			Return(!locked, nil)
+		cacheRepo.On("AuthenticationSetOTP", mock.Anything, otpPurposeReset, testPhone, mock.Anything, mock.Anything).
+			Return(nil)
+
+		return New(storageRepo, cacheRepo, sender, nil, false, false), storageRepo, cacheRepo, sender
+	}
+
+	t.Run("AuthenticationForgotPassword", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo, sender := newUseCase(false)
+
+		assert.NoError(t, ucAuthentication.AuthenticationForgotPassword(context.Empty(), testPhone))
+
+		cacheRepo.AssertCalled(t, "AuthenticationSetOTP", mock.Anything, otpPurposeReset, testPhone, mock.Anything, mock.Anything)
+		assert.Len(t, sender.messages, 1)
+	})
+
+	t.Run("AuthenticationForgotPasswordUnknownPhone", func(t *testing.T) {
+		ucAuthentication, _, cacheRepo, sender := newUseCase(false)
+
+		assert.NoError(t, ucAuthentication.AuthenticationForgotPassword(context.Empty(), "99361999999"))
+
+		cacheRepo.AssertCalled(t, "AuthenticationLockOTPResend", mock.Anything, otpPurposeReset, "99361999999", mock.Anything)
+		cacheRepo.AssertNotCalled(t, "AuthenticationSetOTP", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		assert.Empty(t, sender.messages)
+	})
+
+	t.Run("AuthenticationForgotPasswordTooEarly", func(t *testing.T) {
+		ucAuthentication, storageRepo, _, sender := newUseCase(true)
+
+		err := ucAuthentication.AuthenticationForgotPassword(context.Empty(), testPhone)
+		assert.ErrorIs(t, err, usecase.ErrOTPResendTooEarly)
+
+		storageRepo.AssertNotCalled(t, "AuthenticationGetUser", mock.Anything, mock.Anything, mock.Anything)
+		assert.Empty(t, sender.messages)
+	})
+}
@@ -0,0 +1,122 @@
+package authentication
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1" //nolint:gosec
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	totpPeriod        = 30
+	totpDigits        = 6
+	totpSkew          = 1
+	totpModulo        = 1000000
+	totpSecretLength  = 20
+	backupCodeLength  = 10
+	backupCodeSymbols = "abcdefghjkmnpqrstuvwxyz23456789"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// generateTOTPSecret generates new base32 encoded TOTP secret.
+func generateTOTPSecret() (string, error) {
+	secret := make([]byte, totpSecretLength)
+
+	if _, err := rand.Read(secret); err != nil {
+		return "", errors.Wrap(err, "can not generate totp secret")
+	}
+
+	return totpEncoding.EncodeToString(secret), nil
+}
+
+// totpCode returns RFC 6238 code of the secret for the time step.
+func totpCode(secret []byte, step int64) string {
+	message := make([]byte, 8) //nolint:gomnd
+	binary.BigEndian.PutUint64(message, uint64(step))
+
+	mac := hmac.New(sha1.New, secret)
+	mac.Write(message)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff //nolint:gomnd
+
+	return fmt.Sprintf("%0*d", totpDigits, value%totpModulo)
+}
+
+// validateTOTP checks the code against current and adjacent time steps and returns the matched step.
+func validateTOTP(secret string, code string, now time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(secret))
+	if err != nil || len(code) != totpDigits {
+		return 0, false
+	}
+
+	current := now.Unix() / totpPeriod
+
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+
+	return 0, false
+}
+
+// provisioningURI returns otpauth:// URI for authenticator application QR code.
+func provisioningURI(issuer string, account string, secret string) string {
+	values := url.Values{}
+	values.Set("secret", secret)
+	values.Set("issuer", issuer)
+	values.Set("algorithm", "SHA1")
+	values.Set("digits", fmt.Sprint(totpDigits))
+	values.Set("period", fmt.Sprint(totpPeriod))
+
+	label := url.PathEscape(issuer + ":" + account)
+
+	return "otpauth://totp/" + label + "?" + values.Encode()
+}
+
+// generateBackupCodes generates backup codes and their hashes.
+func generateBackupCodes(count int) ([]string, []string, error) {
+	codes := make([]string, 0, count)
+	hashes := make([]string, 0, count)
+
+	for i := 0; i < count; i++ {
+		var builder strings.Builder
+
+		for j := 0; j < backupCodeLength; j++ {
+			index, err := rand.Int(rand.Reader, big.NewInt(int64(len(backupCodeSymbols))))
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "can not generate backup code")
+			}
+
+			if j == backupCodeLength/2 {
+				builder.WriteByte('-')
+			}
+
+			builder.WriteByte(backupCodeSymbols[index.Int64()])
+		}
+
+		codes = append(codes, builder.String())
+		hashes = append(hashes, hashBackupCode(builder.String()))
+	}
+
+	return codes, hashes, nil
+}
+
+// hashBackupCode returns hash of the normalized backup code.
+func hashBackupCode(code string) string {
+	normalized := strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+
+	return hashToken(normalized)
+}
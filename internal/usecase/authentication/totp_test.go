@@ -0,0 +1,58 @@
+package authentication
+
+import (
+	"encoding/base32"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTOTP(t *testing.T) {
+	t.Parallel()
+
+	assertion := assert.New(t)
+
+	// RFC 6238 SHA1 test vectors truncated to six digits
+	secret := base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+	vectors := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1111111111: "050471",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+
+	t.Run("validateTOTP", func(t *testing.T) {
+		for unix, code := range vectors {
+			step, ok := validateTOTP(secret, code, time.Unix(unix, 0))
+			assertion.True(ok)
+			assertion.Equal(unix/totpPeriod, step)
+		}
+	})
+
+	t.Run("validateTOTPWithSkew", func(t *testing.T) {
+		_, ok := validateTOTP(secret, "287082", time.Unix(59+totpPeriod, 0))
+		assertion.True(ok)
+
+		_, ok = validateTOTP(secret, "287082", time.Unix(59+3*totpPeriod, 0))
+		assertion.False(ok)
+	})
+
+	t.Run("validateTOTPWithWrongCode", func(t *testing.T) {
+		_, ok := validateTOTP(secret, "000000", time.Unix(59, 0))
+		assertion.False(ok)
+
+		_, ok = validateTOTP(secret, "28708", time.Unix(59, 0))
+		assertion.False(ok)
+	})
+
+	t.Run("hashBackupCode", func(t *testing.T) {
+		codes, hashes, err := generateBackupCodes(2)
+		assertion.NoError(err)
+		assertion.Len(codes, 2)
+		assertion.Equal(hashes[0], hashBackupCode(" "+codes[0]+" "))
+		assertion.NotEqual(hashes[0], hashes[1])
+	})
+}
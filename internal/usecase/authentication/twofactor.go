@@ -0,0 +1,337 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"database/sql"
+	"encoding/base64"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	otpPurposeTwoFactor  = "twofactor"
+	challengeTokenLength = 32
+	defaultBackupCodes   = 10
+)
+
+// AuthenticationEnrollTwoFactor generates new not confirmed TOTP secret of the user.
+func (s *UseCase) AuthenticationEnrollTwoFactor(ctx context.Context, userID string) (user.TwoFactorEnrollment, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationEnrollTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var enrollment user.TwoFactorEnrollment
+
+	twoFactor, err := s.adapterStorage.AuthenticationGetTwoFactor(ctx, userID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return enrollment, errors.Wrap(err, "can not get two-factor settings")
+	}
+
+	if twoFactor.Enabled {
+		return enrollment, usecase.ErrTwoFactorAlreadyEnabled
+	}
+
+	usr, err := s.adapterStorage.AuthenticationGetUser(ctx, userID, "")
+	if err != nil {
+		return enrollment, errors.Wrap(err, "can not get user")
+	}
+
+	secret, err := generateTOTPSecret()
+	if err != nil {
+		return enrollment, err
+	}
+
+	if err = s.adapterStorage.AuthenticationSetTwoFactorSecret(ctx, userID, secret); err != nil {
+		return enrollment, errors.Wrap(err, "can not store two-factor secret")
+	}
+
+	enrollment.Secret = secret
+	enrollment.ProvisioningURI = provisioningURI(viper.GetString("two_factor.issuer"), usr.Phone, secret)
+
+	return enrollment, nil
+}
+
+// AuthenticationEnrollTwoFactorByChallenge generates TOTP secret for the user who must enrol before signing in.
+func (s *UseCase) AuthenticationEnrollTwoFactorByChallenge(ctx context.Context, challenge string) (user.TwoFactorEnrollment, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationEnrollTwoFactorByChallenge")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	userID, err := s.adapterCache.AuthenticationGetChallenge(ctx, hashToken(challenge))
+	if err != nil {
+		return user.TwoFactorEnrollment{}, usecase.ErrInvalidChallenge
+	}
+
+	return s.AuthenticationEnrollTwoFactor(ctx, userID)
+}
+
+// AuthenticationConfirmTwoFactor checks the first TOTP code, enables two-factor and returns backup codes.
+func (s *UseCase) AuthenticationConfirmTwoFactor(ctx context.Context, userID string, code string) ([]string, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationConfirmTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	twoFactor, err := s.getTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if twoFactor.Enabled {
+		return nil, usecase.ErrTwoFactorAlreadyEnabled
+	}
+
+	return s.enableTwoFactor(ctx, twoFactor, code)
+}
+
+// AuthenticationVerifyTwoFactor checks the second sign in step and issues tokens.
+// Users who had to enrol get their backup codes here.
+func (s *UseCase) AuthenticationVerifyTwoFactor(ctx context.Context, challenge string, code string) (user.User, token.Tokens, []string, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationVerifyTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var backupCodes []string
+
+	userID, err := s.adapterCache.AuthenticationGetChallenge(ctx, hashToken(challenge))
+	if err != nil {
+		return user.User{}, token.Tokens{}, nil, usecase.ErrInvalidChallenge
+	}
+
+	twoFactor, err := s.getTwoFactor(ctx, userID)
+	if err != nil {
+		return user.User{}, token.Tokens{}, nil, err
+	}
+
+	if twoFactor.Enabled {
+		err = s.checkSecondFactor(ctx, twoFactor, code, true)
+	} else {
+		backupCodes, err = s.enableTwoFactor(ctx, twoFactor, code)
+	}
+
+	if err != nil {
+		return user.User{}, token.Tokens{}, nil, err
+	}
+
+	if err = s.adapterCache.AuthenticationDeleteChallenge(ctx, hashToken(challenge)); err != nil {
+		logger.Logger.Error("unable to delete challenge from cache", zap.String("error", err.Error()))
+	}
+
+	usr, tokens, err := s.AuthenticationGenerateToken(ctx, userID, "", "")
+
+	return usr, tokens, backupCodes, err
+}
+
+// AuthenticationRegenerateBackupCodes checks TOTP code and replaces backup codes of the user.
+func (s *UseCase) AuthenticationRegenerateBackupCodes(ctx context.Context, userID string, code string) ([]string, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationRegenerateBackupCodes")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	twoFactor, err := s.getTwoFactor(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !twoFactor.Enabled {
+		return nil, usecase.ErrTwoFactorNotEnrolled
+	}
+
+	if err = s.checkSecondFactor(ctx, twoFactor, code, false); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateBackupCodes(backupCodesCount())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.adapterStorage.AuthenticationReplaceBackupCodes(ctx, userID, hashes); err != nil {
+		return nil, errors.Wrap(err, "can not store backup codes")
+	}
+
+	return codes, nil
+}
+
+// AuthenticationDisableTwoFactor checks TOTP or backup code and disables two-factor of the user.
+func (s *UseCase) AuthenticationDisableTwoFactor(ctx context.Context, userID string, code string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthenticationDisableTwoFactor")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	usr, err := s.adapterStorage.AuthenticationGetUser(ctx, userID, "")
+	if err != nil {
+		return errors.Wrap(err, "can not get user")
+	}
+
+	if twoFactorRequired(usr.RoleName) {
+		return usecase.ErrTwoFactorRequired
+	}
+
+	twoFactor, err := s.getTwoFactor(ctx, userID)
+	if err != nil {
+		return err
+	}
+
+	if twoFactor.Enabled {
+		if err = s.checkSecondFactor(ctx, twoFactor, code, true); err != nil {
+			return err
+		}
+	}
+
+	err = s.adapterStorage.AuthenticationDisableTwoFactor(ctx, userID)
+
+	return errors.Wrap(err, "can not disable two-factor")
+}
+
+// twoFactorChallenge returns sign in challenge if the user has two-factor enabled or required by the role.
+func (s *UseCase) twoFactorChallenge(ctx context.Context, usr user.User) (*token.Challenge, error) {
+	twoFactor, err := s.adapterStorage.AuthenticationGetTwoFactor(ctx, usr.ID)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return nil, errors.Wrap(err, "can not get two-factor settings")
+	}
+
+	if !twoFactor.Enabled && !twoFactorRequired(usr.RoleName) {
+		return nil, nil //nolint:nilnil
+	}
+
+	raw := make([]byte, challengeTokenLength)
+
+	if _, err = rand.Read(raw); err != nil {
+		return nil, errors.Wrap(err, "can not generate challenge")
+	}
+
+	ttl := time.Duration(viper.GetInt("two_factor.challenge_ttl")) * time.Minute
+	challenge := base64.RawURLEncoding.EncodeToString(raw)
+
+	if err = s.adapterCache.AuthenticationSetChallenge(ctx, hashToken(challenge), usr.ID, ttl); err != nil {
+		return nil, errors.Wrap(err, "can not store challenge")
+	}
+
+	return &token.Challenge{
+		Token:              challenge,
+		EnrollmentRequired: !twoFactor.Enabled,
+		ExpiresAt:          time.Now().Add(ttl).Unix(),
+	}, nil
+}
+
+// getTwoFactor returns two-factor settings of the user who has started enrolment.
+func (s *UseCase) getTwoFactor(ctx context.Context, userID string) (user.TwoFactor, error) {
+	twoFactor, err := s.adapterStorage.AuthenticationGetTwoFactor(ctx, userID)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return twoFactor, usecase.ErrTwoFactorNotEnrolled
+		}
+
+		return twoFactor, errors.Wrap(err, "can not get two-factor settings")
+	}
+
+	return twoFactor, nil
+}
+
+// enableTwoFactor checks the first TOTP code, enables two-factor and returns new backup codes.
+func (s *UseCase) enableTwoFactor(ctx context.Context, twoFactor user.TwoFactor, code string) ([]string, error) {
+	if err := s.checkSecondFactor(ctx, twoFactor, code, false); err != nil {
+		return nil, err
+	}
+
+	codes, hashes, err := generateBackupCodes(backupCodesCount())
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.adapterStorage.AuthenticationEnableTwoFactor(ctx, twoFactor.UserID, hashes); err != nil {
+		return nil, errors.Wrap(err, "can not enable two-factor")
+	}
+
+	return codes, nil
+}
+
+// checkSecondFactor checks TOTP code, or backup code if allowed, limiting the number of attempts.
+func (s *UseCase) checkSecondFactor(ctx context.Context, twoFactor user.TwoFactor, code string, allowBackup bool) error {
+	ttl := time.Duration(viper.GetInt("otp.ttl")) * time.Minute
+
+	attempts, err := s.adapterCache.AuthenticationIncrOTPAttempts(ctx, otpPurposeTwoFactor, twoFactor.UserID, ttl)
+	if err != nil {
+		return errors.Wrap(err, "can not count two-factor attempts")
+	}
+
+	if attempts > int64(viper.GetInt("otp.max_attempts")) {
+		return usecase.ErrOTPAttemptsExceeded
+	}
+
+	valid := false
+
+	if step, ok := validateTOTP(twoFactor.Secret, code, time.Now()); ok {
+		// every code is accepted only once within its validity window
+		valid, err = s.adapterCache.AuthenticationUseTOTPStep(ctx, twoFactor.UserID, step, 2*(totpSkew+1)*totpPeriod*time.Second)
+		if err != nil {
+			return errors.Wrap(err, "can not mark totp code as used")
+		}
+	} else if allowBackup {
+		valid, err = s.adapterStorage.AuthenticationUseBackupCode(ctx, twoFactor.UserID, hashBackupCode(code))
+		if err != nil {
+			return errors.Wrap(err, "can not use backup code")
+		}
+
+		if valid {
+			logger.Logger.Info("backup code used", zap.String("user_id", twoFactor.UserID))
+		}
+	}
+
+	if !valid {
+		return usecase.ErrInvalidOTP
+	}
+
+	if err = s.adapterCache.AuthenticationDeleteOTP(ctx, otpPurposeTwoFactor, twoFactor.UserID, true); err != nil {
+		logger.Logger.Error("unable to reset two-factor attempts", zap.String("error", err.Error()))
+	}
+
+	return nil
+}
+
+// twoFactorRequired checks if two-factor is required for the role by policy.
+func twoFactorRequired(role string) bool {
+	for _, required := range viper.GetStringSlice("two_factor.required_roles") {
+		if required == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// backupCodesCount returns configured number of backup codes.
+func backupCodesCount() int {
+	if count := viper.GetInt("two_factor.backup_codes"); count > 0 {
+		return count
+	}
+
+	return defaultBackupCodes
+}
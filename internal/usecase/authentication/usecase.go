@@ -2,18 +2,38 @@ package authentication
 
 import (
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/cache"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/identity"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/sms"
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
 )
 
 // UseCase is an authentication usecase.
 type UseCase struct {
-	adapterStorage storage.Authentication
-	adapterCache   cache.Authentication
-	isTracingOn    bool
-	isCacheOn      bool
+	adapterStorage  storage.Authentication
+	adapterCache    cache.Authentication
+	adapterSMS      sms.SMSSender
+	adapterIdentity identity.IdentityProvider
+	isTracingOn     bool
+	isCacheOn       bool
+	keys            *keySet
 }
 
 // New constructor for UseCase.
-func New(storage storage.Authentication, cache cache.Authentication, isTracingOn bool, isCacheOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn, isCacheOn: isCacheOn}
+func New(
+	storage storage.Authentication,
+	cache cache.Authentication,
+	sms sms.SMSSender,
+	identity identity.IdentityProvider,
+	isTracingOn bool,
+	isCacheOn bool,
+) *UseCase {
+	return &UseCase{
+		adapterStorage:  storage,
+		adapterCache:    cache,
+		adapterSMS:      sms,
+		adapterIdentity: identity,
+		isTracingOn:     isTracingOn,
+		isCacheOn:       isCacheOn,
+		keys:            &keySet{},
+	}
 }
@@ -1,8 +1,12 @@
 package authorization
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
 	"github.com/pkg/errors"
 	"go.uber.org/zap"
@@ -48,3 +52,74 @@ func getUserRoleWithCache(ctx context.Context, s *UseCase, userID string) (strin
 
 	return role, nil
 }
+
+// AuthorizationCheckUserStatus returns error if the user is blocked or suspended.
+func (s *UseCase) AuthorizationCheckUserStatus(ctx context.Context, userID string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthorizationCheckUserStatus")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if s.isCacheOn {
+		status, err := getUserStatusWithCache(ctx, s, userID)
+		if err != nil {
+			return err
+		}
+
+		return usecase.CheckAccountStatus(status)
+	}
+
+	status, err := s.adapterStorage.AuthorizationGetUserStatus(ctx, userID)
+	if err != nil {
+		return errors.Wrap(err, "can not get user status")
+	}
+
+	return usecase.CheckAccountStatus(status)
+}
+
+func getUserStatusWithCache(ctx context.Context, s *UseCase, userID string) (user.AccountStatus, error) {
+	status, err := s.adapterCache.AuthorizationGetUserStatus(ctx, userID)
+	if err == nil {
+		return status, nil
+	}
+
+	status, err = s.adapterStorage.AuthorizationGetUserStatus(ctx, userID)
+	if err != nil {
+		return status, errors.Wrap(err, "user status select failed")
+	}
+
+	if err = s.adapterCache.AuthorizationSetUserStatus(ctx, userID, status); err != nil {
+		logger.Logger.Error("unable to add user status into cache", zap.String("error", err.Error()))
+	}
+
+	return status, nil
+}
+
+// AuthorizationGetPermissions returns actions allowed to the user by object.
+func (s *UseCase) AuthorizationGetPermissions(ctx context.Context, meta query.MetaData) (rule.UserPermissions, error) {
+	if s.isTracingOn {
+		_, span := tracing.Tracer.Start(ctx, "Usecase.AuthorizationGetPermissions")
+		defer span.End()
+	}
+
+	result := rule.UserPermissions{
+		Role:           meta.RoleName,
+		OrganizationID: meta.OrganizationID,
+		Permissions:    make(map[string][]string),
+	}
+
+	policies, err := s.adapterPolicy.GetPermissions(meta.RoleName)
+	if err != nil {
+		return result, errors.Wrap(err, "role permissions select error")
+	}
+
+	for _, permission := range usecase.EffectivePermissions(policies) {
+		if permission.Effect == usecase.EffectAllow {
+			result.Permissions[permission.Object] = append(result.Permissions[permission.Object], permission.Action)
+		}
+	}
+
+	return result, nil
+}
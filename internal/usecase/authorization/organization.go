@@ -0,0 +1,101 @@
+package authorization
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// AuthorizationGetOrganizationRoles returns roles of users in the organization.
+func (s *UseCase) AuthorizationGetOrganizationRoles(ctx context.Context, organizationID string) ([]role.Assignment, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthorizationGetOrganizationRoles")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	assignments, err := s.adapterStorage.AuthorizationGetOrganizationRoles(ctx, organizationID)
+
+	return assignments, errors.Wrap(err, "organization roles select error")
+}
+
+// AuthorizationSetOrganizationRole assigns role of the user in the organization.
+func (s *UseCase) AuthorizationSetOrganizationRole(ctx context.Context, meta query.MetaData, organizationID string, userID string, input role.AssignRoleInput) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthorizationSetOrganizationRole")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if !usecase.IsOrganizationRole(input.Role) {
+		return errors.Wrap(usecase.ErrOrganizationRoleInvalid, input.Role)
+	}
+
+	before, err := s.organizationRole(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.adapterStorage.AuthorizationSetOrganizationRole(ctx, organizationID, userID, input.Role)
+	if err != nil {
+		return errors.Wrap(err, "organization role update error")
+	}
+
+	after := role.Assignment{UserID: userID, OrganizationID: organizationID, RoleName: input.Role}
+
+	if before == nil {
+		usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, organizationID+"/"+userID, nil, after)
+	} else {
+		usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, organizationID+"/"+userID, *before, after)
+	}
+
+	return errors.Wrap(s.adapterPolicy.Reload(ctx), "policy reload failed")
+}
+
+// AuthorizationDeleteOrganizationRole removes role of the user in the organization.
+func (s *UseCase) AuthorizationDeleteOrganizationRole(ctx context.Context, meta query.MetaData, organizationID string, userID string) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.AuthorizationDeleteOrganizationRole")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	before, err := s.organizationRole(ctx, organizationID, userID)
+	if err != nil {
+		return err
+	}
+
+	err = s.adapterStorage.AuthorizationDeleteOrganizationRole(ctx, organizationID, userID)
+	if err != nil {
+		return errors.Wrap(err, "organization role delete error")
+	}
+
+	if before != nil {
+		usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, organizationID+"/"+userID, *before, nil)
+	}
+
+	return errors.Wrap(s.adapterPolicy.Reload(ctx), "policy reload failed")
+}
+
+// organizationRole returns role of the user in the organization or nil if the user has no role there.
+func (s *UseCase) organizationRole(ctx context.Context, organizationID string, userID string) (*role.Assignment, error) {
+	assignments, err := s.adapterStorage.AuthorizationGetOrganizationRoles(ctx, organizationID)
+	if err != nil {
+		return nil, errors.Wrap(err, "organization roles select error")
+	}
+
+	for i := range assignments {
+		if assignments[i].UserID == userID {
+			return &assignments[i], nil
+		}
+	}
+
+	return nil, nil //nolint:nilnil
+}
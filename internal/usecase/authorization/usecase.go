@@ -2,6 +2,7 @@ package authorization
 
 import (
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/cache"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/policy"
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
 )
 
@@ -9,11 +10,23 @@ import (
 type UseCase struct {
 	adapterStorage storage.Authorization
 	adapterCache   cache.Authorization
+	adapterPolicy  policy.PolicyEnforcer
+	adapterAudit   storage.Audit
 	isTracingOn    bool
 	isCacheOn      bool
 }
 
+// auditEntity is a name of the entity in the audit log.
+const auditEntity = "organizationrole"
+
 // New constructor for UseCase.
-func New(storage storage.Authorization, cache cache.Authorization, isTracingOn bool, isCacheOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn, isCacheOn: isCacheOn}
+func New(storage storage.Authorization, cache cache.Authorization, policy policy.PolicyEnforcer, audit storage.Audit, isTracingOn bool, isCacheOn bool) *UseCase { //nolint:lll
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterPolicy:  policy,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
 }
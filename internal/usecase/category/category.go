@@ -1,7 +1,9 @@
 package category
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/category"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -102,17 +104,23 @@ func (s *UseCase) CategoryCreate(ctx context.Context, meta query.MetaData, input
 		ctx = context.New(ctxt)
 	}
 
+	if err := usecase.CheckOrganization(meta, input.OrganizationID); err != nil {
+		return "", err
+	}
+
 	categoryID, err := s.adapterStorage.CategoryCreate(ctx, meta, input)
 	if err != nil {
 		return categoryID, errors.Wrap(err, "category create error")
 	}
 
-	if s.isCacheOn {
-		ctgry, err := s.adapterStorage.CategoryGetOne(ctx, meta, categoryID)
-		if err != nil {
-			return "", errors.Wrap(err, "category select from database failed")
-		}
+	ctgry, err := s.adapterStorage.CategoryGetOne(ctx, meta, categoryID)
+	if err != nil {
+		return "", errors.Wrap(err, "category select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, categoryID, nil, ctgry)
 
+	if s.isCacheOn {
 		err = s.adapterCache.CategoryCreate(ctx, ctgry)
 		if err != nil {
 			return "", errors.Wrap(err, "category create in cache failed")
@@ -140,17 +148,30 @@ func (s *UseCase) CategoryUpdate(ctx context.Context, meta query.MetaData, input
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.CategoryUpdate(ctx, meta, input)
+	if input.OrganizationID != nil {
+		if err := usecase.CheckOrganization(meta, *input.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	before, err := s.adapterStorage.CategoryGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "category select from database failed")
+	}
+
+	err = s.adapterStorage.CategoryUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "category update in database failed")
 	}
 
-	if s.isCacheOn {
-		ctgry, err := s.adapterStorage.CategoryGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "category select from database failed")
-		}
+	ctgry, err := s.adapterStorage.CategoryGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "category select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, ctgry)
+
+	if s.isCacheOn {
 		err = s.adapterCache.CategoryUpdate(ctx, ctgry)
 		if err != nil {
 			return errors.Wrap(err, "category update in cache failed")
@@ -174,11 +195,18 @@ func (s *UseCase) CategoryDelete(ctx context.Context, meta query.MetaData, categ
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.CategoryDelete(ctx, meta, categoryID)
+	before, err := s.adapterStorage.CategoryGetOne(ctx, meta, categoryID)
+	if err != nil {
+		return errors.Wrap(err, "category select from database failed")
+	}
+
+	err = s.adapterStorage.CategoryDelete(ctx, meta, categoryID)
 	if err != nil {
 		return errors.Wrap(err, "category delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, categoryID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.CategoryDelete(ctx, categoryID)
 		if err != nil {
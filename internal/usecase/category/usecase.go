@@ -9,11 +9,21 @@ import (
 type UseCase struct {
 	adapterStorage storage.Category
 	adapterCache   cache.Category
+	adapterAudit   storage.Audit
 	isTracingOn    bool
 	isCacheOn      bool
 }
 
+// auditEntity is a name of the entity in the audit log.
+const auditEntity = "category"
+
 // New is a constructor for UseCase.
-func New(storage storage.Category, cache cache.Category, isTracingOn bool, isCacheOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn, isCacheOn: isCacheOn}
+func New(storage storage.Category, cache cache.Category, audit storage.Audit, isTracingOn bool, isCacheOn bool) *UseCase { //nolint:lll
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
 }
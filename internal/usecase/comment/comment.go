@@ -1,7 +1,9 @@
 package comment
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/comment"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -102,17 +104,27 @@ func (s *UseCase) CommentCreate(ctx context.Context, meta query.MetaData, input
 		ctx = context.New(ctxt)
 	}
 
+	if err := usecase.CheckOrganization(meta, input.OrganizationID); err != nil {
+		return "", err
+	}
+
+	if err := usecase.CheckAuthor(meta, input.UserID); err != nil {
+		return "", err
+	}
+
 	commentID, err := s.adapterStorage.CommentCreate(ctx, meta, input)
 	if err != nil {
 		return commentID, errors.Wrap(err, "comment create error")
 	}
 
-	if s.isCacheOn {
-		cmnt, err := s.adapterStorage.CommentGetOne(ctx, meta, commentID)
-		if err != nil {
-			return "", errors.Wrap(err, "comment select from database failed")
-		}
+	cmnt, err := s.adapterStorage.CommentGetOne(ctx, meta, commentID)
+	if err != nil {
+		return "", errors.Wrap(err, "comment select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, commentID, nil, cmnt)
 
+	if s.isCacheOn {
 		err = s.adapterCache.CommentCreate(ctx, cmnt)
 		if err != nil {
 			return "", errors.Wrap(err, "comment create in cache failed")
@@ -140,17 +152,41 @@ func (s *UseCase) CommentUpdate(ctx context.Context, meta query.MetaData, input
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.CommentUpdate(ctx, meta, input)
+	if input.OrganizationID != nil {
+		if err := usecase.CheckOrganization(meta, *input.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.checkAuthor(ctx, meta, *input.ID); err != nil {
+		return err
+	}
+
+	// the comment can not be given to another user
+	if input.UserID != nil {
+		if err := usecase.CheckAuthor(meta, *input.UserID); err != nil {
+			return err
+		}
+	}
+
+	before, err := s.adapterStorage.CommentGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "comment select from database failed")
+	}
+
+	err = s.adapterStorage.CommentUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "comment update in database failed")
 	}
 
-	if s.isCacheOn {
-		cmnt, err := s.adapterStorage.CommentGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "comment select from database failed")
-		}
+	cmnt, err := s.adapterStorage.CommentGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "comment select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, cmnt)
 
+	if s.isCacheOn {
 		err = s.adapterCache.CommentUpdate(ctx, cmnt)
 		if err != nil {
 			return errors.Wrap(err, "comment update in cache failed")
@@ -174,11 +210,22 @@ func (s *UseCase) CommentDelete(ctx context.Context, meta query.MetaData, commen
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.CommentDelete(ctx, meta, commentID)
+	if err := s.checkAuthor(ctx, meta, commentID); err != nil {
+		return err
+	}
+
+	before, err := s.adapterStorage.CommentGetOne(ctx, meta, commentID)
+	if err != nil {
+		return errors.Wrap(err, "comment select from database failed")
+	}
+
+	err = s.adapterStorage.CommentDelete(ctx, meta, commentID)
 	if err != nil {
 		return errors.Wrap(err, "comment delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, commentID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.CommentDelete(ctx, commentID)
 		if err != nil {
@@ -193,3 +240,13 @@ func (s *UseCase) CommentDelete(ctx context.Context, meta query.MetaData, commen
 
 	return nil
 }
+
+// checkAuthor returns error if the user is not the author of the comment.
+func (s *UseCase) checkAuthor(ctx context.Context, meta query.MetaData, commentID string) error {
+	cmnt, err := s.adapterStorage.CommentGetOne(ctx, meta, commentID)
+	if err != nil {
+		return errors.Wrap(err, "comment select error")
+	}
+
+	return usecase.CheckAuthor(meta, cmnt.UserID)
+}
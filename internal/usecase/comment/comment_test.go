@@ -0,0 +1,75 @@
+package comment
+
+import (
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/comment"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	mockCache "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockredis"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCommentAuthor(t *testing.T) {
+	_ = logger.InitLogger()
+
+	commentID := "0b7c1a4e-6c38-4f7a-9d43-1f0b2a5c9e10"
+	organizationID := "5f3e2d1c-0b9a-4877-a665-544332211000"
+	approved := 2
+
+	storageRepo := new(mockStorage.Comment)
+	auditRepo := new(mockStorage.Audit)
+
+	storageRepo.On("CommentGetOne", mock.Anything, mock.Anything, commentID).
+		Return(comment.Comment{ID: commentID, UserID: "author", OrganizationID: organizationID}, nil)
+	storageRepo.On("CommentUpdate", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	storageRepo.On("CommentDelete", mock.Anything, mock.Anything, commentID).Return(nil)
+	auditRepo.On("AuditCreate", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(nil)
+
+	ucComment := New(storageRepo, new(mockCache.Comment), auditRepo, false, false)
+	assertion := assert.New(t)
+
+	author := query.MetaData{UserID: "author", RoleName: "customer"}
+	customer := query.MetaData{UserID: "customer", RoleName: "customer"}
+	operator := query.MetaData{UserID: "operator", RoleName: "operator", OrganizationID: organizationID}
+
+	t.Run("CommentUpdateByAuthor", func(t *testing.T) {
+		err := ucComment.CommentUpdate(context.Empty(), author, comment.UpdateCommentInput{ID: &commentID, Status: &approved})
+		assertion.NoError(err)
+	})
+
+	t.Run("CommentUpdateByCustomerWithError", func(t *testing.T) {
+		err := ucComment.CommentUpdate(context.Empty(), customer, comment.UpdateCommentInput{ID: &commentID, Status: &approved})
+		assertion.ErrorIs(err, usecase.ErrNotAuthor)
+	})
+
+	t.Run("CommentUpdateByStaff", func(t *testing.T) {
+		err := ucComment.CommentUpdate(context.Empty(), operator, comment.UpdateCommentInput{ID: &commentID, Status: &approved})
+		assertion.NoError(err)
+	})
+
+	t.Run("CommentCreateForOtherUserWithError", func(t *testing.T) {
+		_, err := ucComment.CommentCreate(context.Empty(), customer, comment.CreateCommentInput{
+			UserID:         "author",
+			OrganizationID: organizationID,
+		})
+		assertion.ErrorIs(err, usecase.ErrNotAuthor)
+	})
+
+	t.Run("CommentDeleteByCustomerWithError", func(t *testing.T) {
+		err := ucComment.CommentDelete(context.Empty(), customer, commentID)
+		assertion.ErrorIs(err, usecase.ErrNotAuthor)
+	})
+
+	t.Run("CommentDeleteByStaff", func(t *testing.T) {
+		err := ucComment.CommentDelete(context.Empty(), operator, commentID)
+		assertion.NoError(err)
+	})
+
+	storageRepo.AssertNumberOfCalls(t, "CommentUpdate", 2)
+	storageRepo.AssertNumberOfCalls(t, "CommentDelete", 1)
+}
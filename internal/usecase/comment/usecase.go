@@ -9,11 +9,21 @@ import (
 type UseCase struct {
 	adapterStorage storage.Comment
 	adapterCache   cache.Comment
+	adapterAudit   storage.Audit
 	isTracingOn    bool
 	isCacheOn      bool
 }
 
+// auditEntity is a name of the entity in the audit log.
+const auditEntity = "comment"
+
 // New is a constructor for UseCase.
-func New(storage storage.Comment, cache cache.Comment, isTracingOn bool, isCacheOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn, isCacheOn: isCacheOn}
+func New(storage storage.Comment, cache cache.Comment, audit storage.Audit, isTracingOn bool, isCacheOn bool) *UseCase {
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
 }
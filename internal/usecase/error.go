@@ -3,12 +3,49 @@ package usecase
 import "github.com/pkg/errors"
 
 var (
-	ErrInvalidHash          = errors.New("the encoded hash is not in the correct format")
-	ErrIncompatibleVersion  = errors.New("incompatible version of argon2")
-	ErrInvalidPassword      = errors.New("invalid password")
-	ErrInvalidSigningMethod = errors.New("invalid signing method")
-	ErrInvalidTokenClaims   = errors.New("token claims are not of type *tokenClaims")
-	ErrUserNotFound         = errors.New("user not found")
-	ErrUsersNotFound        = errors.New("users not found")
-	ErrRolesNotFound        = errors.New("roles not found")
+	ErrInvalidHash              = errors.New("the encoded hash is not in the correct format")
+	ErrIncompatibleVersion      = errors.New("incompatible version of argon2")
+	ErrInvalidPassword          = errors.New("invalid password")
+	ErrInvalidSigningMethod     = errors.New("invalid signing method")
+	ErrInvalidTokenClaims       = errors.New("token claims are not of type *tokenClaims")
+	ErrUserNotFound             = errors.New("user not found")
+	ErrUsersNotFound            = errors.New("users not found")
+	ErrRolesNotFound            = errors.New("roles not found")
+	ErrRefreshTokenReused       = errors.New("refresh token has already been used")
+	ErrUnknownSigningKey        = errors.New("unknown signing key")
+	ErrInvalidSigningKey        = errors.New("invalid signing key")
+	ErrInvalidKeyEncryptionKey  = errors.New("signing key encryption key must be 32 bytes encoded in base64")
+	ErrUserNotVerified          = errors.New("phone number is not verified")
+	ErrInvalidOTP               = errors.New("invalid or expired code")
+	ErrOTPAttemptsExceeded      = errors.New("too many code verification attempts")
+	ErrOTPResendTooEarly        = errors.New("code has been sent recently, try again later")
+	ErrLoginLocked              = errors.New("too many failed sign in attempts")
+	ErrInvalidChallenge         = errors.New("invalid or expired sign in challenge")
+	ErrTwoFactorNotEnrolled     = errors.New("two-factor authentication is not enrolled")
+	ErrTwoFactorAlreadyEnabled  = errors.New("two-factor authentication is already enabled")
+	ErrTwoFactorRequired        = errors.New("two-factor authentication is required for the role")
+	ErrInvalidAPIKey            = errors.New("invalid api key")
+	ErrInvalidScope             = errors.New("scope must be in object:action format")
+	ErrNotOrganizationOwner     = errors.New("user is not the organization owner")
+	ErrUnknownIdentityProvider  = errors.New("unknown identity provider")
+	ErrInvalidOIDCState         = errors.New("invalid or expired login state")
+	ErrIdentityNotLinkable      = errors.New("identity has no verified phone number to link an account")
+	ErrIdentityLinkRefused      = errors.New("identity can be linked by phone number only to customer accounts")
+	ErrUserBlocked              = errors.New("user is blocked")
+	ErrUserSuspended            = errors.New("user is suspended")
+	ErrOwnStatusChange          = errors.New("users can not change their own status")
+	ErrInvalidSuspensionEnd     = errors.New("suspension end must be in the future")
+	ErrInvalidInvitation        = errors.New("invitation is invalid, expired, revoked or already accepted")
+	ErrInvitationRoleNotAllowed = errors.New("role can not be given by invitation")
+	ErrSignUpDataRequired       = errors.New("password, first name and last name are required for a new user")
+	ErrOrganizationRoleInvalid  = errors.New("role can not be assigned in an organization")
+	ErrRoleNotFound             = errors.New("role is not found")
+	ErrRoleHierarchyCycle       = errors.New("role can not inherit itself")
+	ErrNotOwner                 = errors.New("access to data of another user is denied")
+	ErrNotAuthor                = errors.New("only the author may change the comment")
+	ErrInvalidRule              = errors.New("rule is invalid")
+	ErrPolicyLockout            = errors.New("policy must allow importing the policy to some role")
+	ErrForeignOrganization      = errors.New("data belongs to another organization than the request")
+	ErrMemberExists             = errors.New("user is already a member of the organization")
+	ErrOwnerMembership          = errors.New("the organization owner can not be removed or suspended")
 )
@@ -1,7 +1,9 @@
 package favorite
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/favorite"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
@@ -17,9 +19,13 @@ func (s *UseCase) FavoriteCreate(ctx context.Context, meta query.MetaData, favor
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.FavoriteCreate(ctx, meta, favorite)
+	if err := s.adapterStorage.FavoriteCreate(ctx, meta, favorite); err != nil {
+		return errors.Wrap(err, "favorite create error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, favorite.ItemID, nil, favorite)
 
-	return errors.Wrap(err, "favorite create error")
+	return nil
 }
 
 // FavoriteDelete deletes favorite by id from the system.
@@ -31,7 +37,11 @@ func (s *UseCase) FavoriteDelete(ctx context.Context, meta query.MetaData, itemI
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.FavoriteDelete(ctx, meta, itemID)
+	if err := s.adapterStorage.FavoriteDelete(ctx, meta, itemID); err != nil {
+		return errors.Wrap(err, "favorite delete error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, itemID, favorite.Favorite{ItemID: itemID}, nil)
 
-	return errors.Wrap(err, "favorite delete error")
+	return nil
 }
@@ -9,10 +9,14 @@ import (
 type UseCase struct {
 	adapterStorage storage.Favorite
 	adapterCache   cache.Favorite
+	adapterAudit   storage.Audit
 	isTracingOn    bool
 }
 
+// auditEntity is a name of the entity in the audit log.
+const auditEntity = "favorite"
+
 // New is a constructor for UseCase.
-func New(storage storage.Favorite, cache cache.Favorite, isTracingOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn}
+func New(storage storage.Favorite, cache cache.Favorite, audit storage.Audit, isTracingOn bool) *UseCase {
+	return &UseCase{adapterStorage: storage, adapterCache: cache, adapterAudit: audit, isTracingOn: isTracingOn}
 }
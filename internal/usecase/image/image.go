@@ -1,7 +1,9 @@
 package image
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/image"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -102,17 +104,23 @@ func (s *UseCase) ImageCreate(ctx context.Context, meta query.MetaData, input im
 		ctx = context.New(ctxt)
 	}
 
+	if err := usecase.CheckOrganization(meta, input.OrganizationID); err != nil {
+		return "", err
+	}
+
 	imageID, err := s.adapterStorage.ImageCreate(ctx, meta, input)
 	if err != nil {
 		return imageID, errors.Wrap(err, "image create error")
 	}
 
-	if s.isCacheOn {
-		img, err := s.adapterStorage.ImageGetOne(ctx, meta, imageID)
-		if err != nil {
-			return "", errors.Wrap(err, "image select from database failed")
-		}
+	img, err := s.adapterStorage.ImageGetOne(ctx, meta, imageID)
+	if err != nil {
+		return "", errors.Wrap(err, "image select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, imageID, nil, img)
+
+	if s.isCacheOn {
 		err = s.adapterCache.ImageCreate(ctx, img)
 		if err != nil {
 			return "", errors.Wrap(err, "image create in cache failed")
@@ -140,17 +148,30 @@ func (s *UseCase) ImageUpdate(ctx context.Context, meta query.MetaData, input im
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.ImageUpdate(ctx, meta, input)
+	if input.OrganizationID != nil {
+		if err := usecase.CheckOrganization(meta, *input.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	before, err := s.adapterStorage.ImageGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "image select from database failed")
+	}
+
+	err = s.adapterStorage.ImageUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "image update in database failed")
 	}
 
-	if s.isCacheOn {
-		img, err := s.adapterStorage.ImageGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "image select from database failed")
-		}
+	img, err := s.adapterStorage.ImageGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "image select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, img)
+
+	if s.isCacheOn {
 		err = s.adapterCache.ImageUpdate(ctx, img)
 		if err != nil {
 			return errors.Wrap(err, "image update in cache failed")
@@ -174,11 +195,18 @@ func (s *UseCase) ImageDelete(ctx context.Context, meta query.MetaData, imageID
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.ImageDelete(ctx, meta, imageID)
+	before, err := s.adapterStorage.ImageGetOne(ctx, meta, imageID)
+	if err != nil {
+		return errors.Wrap(err, "image select from database failed")
+	}
+
+	err = s.adapterStorage.ImageDelete(ctx, meta, imageID)
 	if err != nil {
 		return errors.Wrap(err, "image delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, imageID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.ImageDelete(ctx, imageID)
 		if err != nil {
@@ -9,11 +9,21 @@ import (
 type UseCase struct {
 	adapterStorage storage.Image
 	adapterCache   cache.Image
+	adapterAudit   storage.Audit
 	isTracingOn    bool
 	isCacheOn      bool
 }
 
+// auditEntity is a name of the entity in the audit log.
+const auditEntity = "image"
+
 // New is a constructor for UseCase.
-func New(storage storage.Image, cache cache.Image, isTracingOn bool, isCacheOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn, isCacheOn: isCacheOn}
+func New(storage storage.Image, cache cache.Image, audit storage.Audit, isTracingOn bool, isCacheOn bool) *UseCase {
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
 }
@@ -1,16 +1,22 @@
 package usecase
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/apikey"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/category"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/comment"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/favorite"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/image"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/invitation"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/item"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/member"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/order"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/organization"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/specification"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/storefront"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/table"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
@@ -21,17 +27,41 @@ import (
 
 // Authentication interface.
 type Authentication interface {
+	AuthenticationSignIn(ctx context.Context, phone string, password string, ip string) (user.User, token.Tokens, *token.Challenge, error) //nolint:lll
+	AuthenticationEnrollTwoFactor(ctx context.Context, userID string) (user.TwoFactorEnrollment, error)
+	AuthenticationEnrollTwoFactorByChallenge(ctx context.Context, challenge string) (user.TwoFactorEnrollment, error)
+	AuthenticationConfirmTwoFactor(ctx context.Context, userID string, code string) ([]string, error)
+	AuthenticationVerifyTwoFactor(ctx context.Context, challenge string, code string) (user.User, token.Tokens, []string, error) //nolint:lll
+	AuthenticationRegenerateBackupCodes(ctx context.Context, userID string, code string) ([]string, error)
+	AuthenticationDisableTwoFactor(ctx context.Context, userID string, code string) error
+	AuthenticationUnlock(ctx context.Context, userID string, ip string) error
+	AuthenticationOIDCStart(ctx context.Context, provider string) (string, error)
+	AuthenticationOIDCCallback(ctx context.Context, provider string, code string, state string) (user.User, token.Tokens, error)   //nolint:lll
 	AuthenticationGenerateToken(ctx context.Context, id string, username string, password string) (user.User, token.Tokens, error) //nolint:lll
 	AuthenticationParseToken(ctx context.Context, token string) (string, string, error)
-	AuthenticationCreateUser(ctx context.Context, input user.CreateUserInput) (string, error)
-	AuthenticationCreateTokenHash(ctx context.Context, userID string, hash string) error
+	AuthenticationCreateUser(ctx context.Context, input user.SignUpInput) (string, error)
+	AuthenticationRequestOTP(ctx context.Context, phone string) error
+	AuthenticationVerifyPhone(ctx context.Context, phone string, code string) error
+	AuthenticationForgotPassword(ctx context.Context, phone string) error
+	AuthenticationResetPassword(ctx context.Context, input user.ResetPasswordInput) error
+	AuthenticationChangePassword(ctx context.Context, userID string, ip string, input user.ChangePasswordInput) error
+	AuthenticationCreateTokenHash(ctx context.Context, userID string, hash string, session token.SessionInput) error
 	AuthenticationGetTokenHash(ctx context.Context, userID string, hash string) (string, error)
-	AuthenticationUpdateTokenHash(ctx context.Context, tokenID string, hash string) error
+	AuthenticationUpdateTokenHash(ctx context.Context, userID string, tokenID string, previousHash string, hash string, session token.SessionInput) error //nolint:lll
+	AuthenticationGetSessions(ctx context.Context, userID string) ([]token.Session, error)
+	AuthenticationRevokeSession(ctx context.Context, userID string, sessionID string) error
+	AuthenticationRevokeSessions(ctx context.Context, userID string) error
+	AuthenticationGetJWKS(ctx context.Context) (token.JWKS, error)
 }
 
 // Authorization interface.
 type Authorization interface {
 	AuthorizationGetUserRole(ctx context.Context, id string) (string, error)
+	AuthorizationCheckUserStatus(ctx context.Context, userID string) error
+	AuthorizationGetOrganizationRoles(ctx context.Context, organizationID string) ([]role.Assignment, error)
+	AuthorizationSetOrganizationRole(ctx context.Context, meta query.MetaData, organizationID string, userID string, input role.AssignRoleInput) error //nolint:lll
+	AuthorizationDeleteOrganizationRole(ctx context.Context, meta query.MetaData, organizationID string, userID string) error
+	AuthorizationGetPermissions(ctx context.Context, meta query.MetaData) (rule.UserPermissions, error)
 }
 
 // User interface.
@@ -41,6 +71,9 @@ type User interface {
 	UserCreate(ctx context.Context, meta query.MetaData, input user.CreateUserInput) (string, error)
 	UserUpdate(ctx context.Context, meta query.MetaData, input user.UpdateUserInput) error
 	UserDelete(ctx context.Context, meta query.MetaData, userID string) error
+	UserBlock(ctx context.Context, meta query.MetaData, userID string, input user.ChangeStatusInput) error
+	UserSuspend(ctx context.Context, meta query.MetaData, userID string, input user.ChangeStatusInput) error
+	UserReactivate(ctx context.Context, meta query.MetaData, userID string, input user.ChangeStatusInput) error
 
 	UserGetAllRoles(ctx context.Context, meta query.MetaData, params queryparameter.QueryParameter) ([]role.Role, error)
 }
@@ -130,4 +163,54 @@ type Rule interface {
 	RuleCreate(ctx context.Context, meta query.MetaData, input rule.CreateRuleInput) (string, error)
 	RuleUpdate(ctx context.Context, meta query.MetaData, input rule.UpdateRuleInput) error
 	RuleDelete(ctx context.Context, meta query.MetaData, ruleID string) error
+	RuleGetRoleParents(ctx context.Context, roleName string) ([]string, error)
+	RuleCreateRoleParent(ctx context.Context, meta query.MetaData, roleName string, input rule.RoleParentInput) error
+	RuleDeleteRoleParent(ctx context.Context, meta query.MetaData, roleName string, parent string) error
+	RuleGetRolePermissions(ctx context.Context, roleName string) ([]rule.Permission, error)
+	RuleExport(ctx context.Context) ([]rule.PolicyRule, error)
+	RuleImport(ctx context.Context, meta query.MetaData, rules []rule.PolicyRule, dryRun bool) (rule.PolicyDiff, error)
+	RuleEvaluate(ctx context.Context, input rule.EvaluationInput) (rule.Evaluation, error)
+}
+
+// APIKey interface.
+type APIKey interface {
+	APIKeyGetAll(ctx context.Context, meta query.MetaData, organizationID string) ([]apikey.APIKey, error)
+	APIKeyCreate(ctx context.Context, meta query.MetaData, input apikey.CreateAPIKeyInput) (apikey.CreatedAPIKey, error)
+	APIKeyRevoke(ctx context.Context, meta query.MetaData, keyID string) error
+	APIKeyAuthenticate(ctx context.Context, key string) (apikey.APIKey, error)
+}
+
+// Invitation interface.
+type Invitation interface {
+	InvitationGetAll(ctx context.Context, meta query.MetaData, organizationID string) ([]invitation.Invitation, error)
+	InvitationCreate(ctx context.Context, meta query.MetaData, input invitation.CreateInvitationInput) (invitation.Invitation, error) //nolint:lll
+	InvitationRevoke(ctx context.Context, meta query.MetaData, invitationID string) error
+	InvitationAccept(ctx context.Context, input invitation.AcceptInvitationInput) (string, error)
+}
+
+// Audit interface.
+type Audit interface {
+	AuditGetAll(ctx context.Context, filter audit.Filter, params queryparameter.QueryParameter) ([]audit.Entry, error)
+}
+
+// Member interface.
+type Member interface {
+	MemberGetAll(ctx context.Context, meta query.MetaData, organizationID string) ([]member.Member, error)
+	MemberAdd(ctx context.Context, meta query.MetaData, organizationID string, input member.AddMemberInput) (member.Member, error)     //nolint:lll
+	MemberUpdate(ctx context.Context, meta query.MetaData, organizationID string, userID string, input member.UpdateMemberInput) error //nolint:lll
+	MemberRemove(ctx context.Context, meta query.MetaData, organizationID string, userID string) error
+}
+
+// Setting interface.
+type Setting interface {
+	SettingGetOne(ctx context.Context, organizationID string) (setting.Settings, error)
+	SettingUpdate(ctx context.Context, meta query.MetaData, organizationID string, input setting.UpdateSettingsInput) (setting.Settings, error) //nolint:lll
+}
+
+// Storefront interface.
+type Storefront interface {
+	StorefrontGetOrganization(ctx context.Context, slug string) (storefront.Organization, error)
+	StorefrontGetCategories(ctx context.Context, slug string) ([]storefront.Category, error)
+	StorefrontGetItems(ctx context.Context, slug string, filter storefront.ItemFilter) ([]item.Item, error)
+	StorefrontGetItem(ctx context.Context, slug string, itemID string) (item.Item, error)
 }
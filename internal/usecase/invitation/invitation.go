@@ -0,0 +1,257 @@
+package invitation
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/invitation"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"go.uber.org/zap"
+)
+
+const (
+	adminRole         = "admin"
+	tokenLength       = 32
+	defaultTTL        = 72 * time.Hour
+	invitationMessage = "You are invited to join an organization on Marketplace, your invitation code: %s"
+	auditEntity       = "invitation"
+)
+
+// defaultRoles are roles given by invitation if invitation.roles is not configured.
+var defaultRoles = []string{"operator", "vendor", "analyst"}
+
+// InvitationGetAll returns invitations of the organization.
+func (s *UseCase) InvitationGetAll(ctx context.Context, meta query.MetaData, organizationID string) ([]invitation.Invitation, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.InvitationGetAll")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkOwner(ctx, meta, organizationID); err != nil {
+		return nil, err
+	}
+
+	invitations, err := s.adapterStorage.InvitationGetAll(ctx, organizationID)
+
+	return invitations, errors.Wrap(err, "invitations select error")
+}
+
+// InvitationCreate invites the phone number to the organization, the code is sent by SMS only.
+func (s *UseCase) InvitationCreate(ctx context.Context, meta query.MetaData, input invitation.CreateInvitationInput) (invitation.Invitation, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.InvitationCreate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var invite invitation.Invitation
+
+	if !isInvitableRole(input.Role) {
+		return invite, errors.Wrap(usecase.ErrInvitationRoleNotAllowed, input.Role)
+	}
+
+	if err := s.checkOwner(ctx, meta, input.OrganizationID); err != nil {
+		return invite, err
+	}
+
+	code, err := randomString(tokenLength)
+	if err != nil {
+		return invite, err
+	}
+
+	ttl := time.Duration(viper.GetInt("invitation.ttl")) * time.Hour
+	if ttl <= 0 {
+		ttl = defaultTTL
+	}
+
+	invitationID, err := s.adapterStorage.InvitationCreate(ctx, meta.UserID, input, hashToken(code), time.Now().UTC().Add(ttl))
+	if err != nil {
+		return invite, errors.Wrap(err, "invitation create error")
+	}
+
+	if err = s.adapterSMS.Send(ctx, input.Phone, fmt.Sprintf(invitationMessage, code)); err != nil {
+		logger.Logger.Error("unable to send invitation", zap.String("error", err.Error()))
+	}
+
+	invite, err = s.adapterStorage.InvitationGetOne(ctx, invitationID)
+	if err != nil {
+		return invite, errors.Wrap(err, "invitation select error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, invitationID, nil, invite)
+
+	return invite, nil
+}
+
+// InvitationRevoke revokes pending invitation.
+func (s *UseCase) InvitationRevoke(ctx context.Context, meta query.MetaData, invitationID string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.InvitationRevoke")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	invite, err := s.adapterStorage.InvitationGetOne(ctx, invitationID)
+	if err != nil {
+		return errors.Wrap(err, "invitation select error")
+	}
+
+	if err = s.checkOwner(ctx, meta, invite.OrganizationID); err != nil {
+		return err
+	}
+
+	if invite.Status != invitation.StatusPending {
+		return usecase.ErrInvalidInvitation
+	}
+
+	if err = s.adapterStorage.InvitationRevoke(ctx, invitationID); err != nil {
+		return errors.Wrap(err, "invitation revoke error")
+	}
+
+	s.audit(ctx, meta, invite)
+
+	return nil
+}
+
+// InvitationAccept attaches the invited user to the organization, the user is created if not exists.
+func (s *UseCase) InvitationAccept(ctx context.Context, input invitation.AcceptInvitationInput) (string, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.InvitationAccept")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	invite, err := s.adapterStorage.InvitationGetByHash(ctx, hashToken(input.Token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", usecase.ErrInvalidInvitation
+		}
+
+		return "", errors.Wrap(err, "invitation select error")
+	}
+
+	if invite.Status != invitation.StatusPending {
+		return "", usecase.ErrInvalidInvitation
+	}
+
+	newUser := user.SignUpInput{Phone: invite.Phone}
+
+	userID, err := s.adapterStorage.InvitationGetUser(ctx, invite.Phone)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return "", errors.Wrap(err, "user select error")
+		}
+
+		if input.Password == "" || input.FirstName == "" || input.LastName == "" {
+			return "", usecase.ErrSignUpDataRequired
+		}
+
+		newUser.FirstName = input.FirstName
+		newUser.LastName = input.LastName
+
+		newUser.Password, err = usecase.GeneratePasswordHash(input.Password, usecase.PasswordParams())
+		if err != nil {
+			return "", errors.Wrap(err, "can not generate password hash")
+		}
+	}
+
+	userID, err = s.adapterStorage.InvitationAccept(ctx, invite.ID, userID, newUser)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return "", usecase.ErrInvalidInvitation
+		}
+
+		return "", errors.Wrap(err, "invitation accept error")
+	}
+
+	s.audit(ctx, query.MetaData{UserID: userID, OrganizationID: invite.OrganizationID}, invite)
+
+	// the user gets the invited role in the organization
+	if err = s.adapterPolicy.Reload(ctx); err != nil {
+		return userID, errors.Wrap(err, "policy reload failed")
+	}
+
+	return userID, nil
+}
+
+// audit records the change of the invitation status.
+func (s *UseCase) audit(ctx context.Context, meta query.MetaData, before invitation.Invitation) {
+	after, err := s.adapterStorage.InvitationGetOne(ctx, before.ID)
+	if err != nil {
+		logger.Logger.Error("unable to select audited invitation", zap.String("error", err.Error()))
+
+		return
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, before.ID, before, after)
+}
+
+// checkOwner checks if the user may manage invitations of the organization.
+func (s *UseCase) checkOwner(ctx context.Context, meta query.MetaData, organizationID string) error {
+	if meta.RoleName == adminRole {
+		return nil
+	}
+
+	ownerID, err := s.adapterStorage.InvitationGetOrganizationOwner(ctx, organizationID)
+	if err != nil {
+		return errors.Wrap(err, "organization owner select error")
+	}
+
+	if ownerID != meta.UserID {
+		return usecase.ErrNotOrganizationOwner
+	}
+
+	return nil
+}
+
+// isInvitableRole checks if the role may be given by invitation.
+func isInvitableRole(role string) bool {
+	roles := viper.GetStringSlice("invitation.roles")
+	if len(roles) == 0 {
+		roles = defaultRoles
+	}
+
+	for _, name := range roles {
+		if name == role {
+			return true
+		}
+	}
+
+	return false
+}
+
+// randomString returns url safe random string of n bytes.
+func randomString(n int) (string, error) {
+	bytes := make([]byte, n)
+
+	if _, err := rand.Read(bytes); err != nil {
+		return "", errors.Wrap(err, "can not generate invitation code")
+	}
+
+	return base64.RawURLEncoding.EncodeToString(bytes), nil
+}
+
+// hashToken returns hex encoded sha256 hash of the invitation code.
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,247 @@
+package invitation
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/invitation"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/pkg/errors"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testOwnerID        = "49c9b955-8511-4b53-81ef-82e3d0259fed"
+	testUserID         = "0c3f5a7e-2b4d-4c6e-8f1a-3b5d7f9a1c2e"
+	testOrganizationID = "5f2b4c1e-8d3a-4b6f-9e7c-1a2b3c4d5e6f"
+	testInvitationID   = "7d1c2b3a-4e5f-4a6b-8c7d-9e0f1a2b3c4d"
+	testPhone          = "99361000000"
+	testToken          = "invitation code"
+)
+
+var errStorage = errors.New("connection refused")
+
+// policyEnforcer is a test enforcer counting policy reloads.
+type policyEnforcer struct {
+	reloads int
+	err     error
+}
+
+func (p *policyEnforcer) Enforce(_ context.Context, _ string, _ string, _ string, _ string) (bool, error) {
+	return false, nil
+}
+
+func (p *policyEnforcer) GetDomainRoles(_ string, _ string) []string { return nil }
+
+func (p *policyEnforcer) GetUserDomains(_ string) []string { return nil }
+
+func (p *policyEnforcer) GetRoles(_ string) ([]string, error) { return nil, nil }
+
+func (p *policyEnforcer) GetPermissions(_ string) ([][]string, error) { return nil, nil }
+
+func (p *policyEnforcer) GetPolicies() [][]string { return nil }
+
+func (p *policyEnforcer) Evaluate(_ context.Context, _ []rule.PolicyRule, _ string, _ string, _ string) (rule.Evaluation, error) { //nolint:lll
+	return rule.Evaluation{}, nil
+}
+
+func (p *policyEnforcer) Reload(_ context.Context) error {
+	p.reloads++
+
+	return p.err
+}
+
+// smsSender is a test sender remembering sent messages.
+type smsSender struct {
+	messages []string
+}
+
+func (s *smsSender) Send(_ context.Context, _ string, message string) error {
+	s.messages = append(s.messages, message)
+
+	return nil
+}
+
+func initInvitationTest() {
+	_ = logger.InitLogger()
+
+	viper.Set("password_hash.memory", 1024)
+	viper.Set("password_hash.iterations", 1)
+	viper.Set("password_hash.parallelism", 1)
+}
+
+func newUseCase(status string) (*UseCase, *mockStorage.Invitation, *policyEnforcer, *smsSender) {
+	storageRepo := new(mockStorage.Invitation)
+	auditRepo := new(mockStorage.Audit)
+	enforcer := &policyEnforcer{}
+	sender := &smsSender{}
+
+	invite := invitation.Invitation{
+		ID:             testInvitationID,
+		OrganizationID: testOrganizationID,
+		Phone:          testPhone,
+		RoleName:       "operator",
+		Status:         status,
+	}
+
+	storageRepo.On("InvitationGetByHash", mock.Anything, hashToken(testToken)).Return(invite, nil)
+	storageRepo.On("InvitationGetByHash", mock.Anything, hashToken("failing")).Return(invitation.Invitation{}, errStorage)
+	storageRepo.On("InvitationGetByHash", mock.Anything, mock.Anything).
+		Return(invitation.Invitation{}, errors.Wrap(sql.ErrNoRows, "invitation select query error"))
+	storageRepo.On("InvitationGetOne", mock.Anything, testInvitationID).Return(invite, nil)
+	storageRepo.On("InvitationGetOrganizationOwner", mock.Anything, testOrganizationID).Return(testOwnerID, nil)
+	storageRepo.On("InvitationCreate", mock.Anything, testOwnerID, mock.Anything, mock.Anything, mock.Anything).
+		Return(testInvitationID, nil)
+	auditRepo.On("AuditCreate", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(nil)
+
+	return New(storageRepo, enforcer, sender, auditRepo, false), storageRepo, enforcer, sender
+}
+
+func TestInvitationCreate(t *testing.T) {
+	initInvitationTest()
+
+	owner := query.MetaData{UserID: testOwnerID, RoleName: "vendor"}
+	input := invitation.CreateInvitationInput{OrganizationID: testOrganizationID, Phone: testPhone, Role: "operator"}
+
+	t.Run("InvitationCreate", func(t *testing.T) {
+		ucInvitation, storageRepo, _, sender := newUseCase(invitation.StatusPending)
+
+		invite, err := ucInvitation.InvitationCreate(context.Empty(), owner, input)
+		assert.NoError(t, err)
+		assert.Equal(t, testInvitationID, invite.ID)
+		assert.Len(t, sender.messages, 1)
+
+		storageRepo.AssertCalled(t, "InvitationCreate", mock.Anything, testOwnerID, input, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvitationCreateRoleNotAllowed", func(t *testing.T) {
+		ucInvitation, storageRepo, _, sender := newUseCase(invitation.StatusPending)
+
+		_, err := ucInvitation.InvitationCreate(context.Empty(), owner,
+			invitation.CreateInvitationInput{OrganizationID: testOrganizationID, Phone: testPhone, Role: "admin"})
+		assert.ErrorIs(t, err, usecase.ErrInvitationRoleNotAllowed)
+		assert.Empty(t, sender.messages)
+
+		storageRepo.AssertNotCalled(t, "InvitationCreate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvitationCreateNotOwner", func(t *testing.T) {
+		ucInvitation, storageRepo, _, _ := newUseCase(invitation.StatusPending)
+
+		_, err := ucInvitation.InvitationCreate(context.Empty(), query.MetaData{UserID: testUserID, RoleName: "vendor"}, input)
+		assert.ErrorIs(t, err, usecase.ErrNotOrganizationOwner)
+
+		storageRepo.AssertNotCalled(t, "InvitationCreate", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestInvitationAccept(t *testing.T) {
+	initInvitationTest()
+
+	t.Run("InvitationAcceptExistingUser", func(t *testing.T) {
+		ucInvitation, storageRepo, enforcer, _ := newUseCase(invitation.StatusPending)
+		storageRepo.On("InvitationGetUser", mock.Anything, testPhone).Return(testUserID, nil)
+		storageRepo.On("InvitationAccept", mock.Anything, testInvitationID, testUserID, user.SignUpInput{Phone: testPhone}).
+			Return(testUserID, nil)
+
+		userID, err := ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{Token: testToken})
+		assert.NoError(t, err)
+		assert.Equal(t, testUserID, userID)
+
+		// the role in the organization is granted by the reloaded policy
+		assert.Equal(t, 1, enforcer.reloads)
+	})
+
+	t.Run("InvitationAcceptNewUser", func(t *testing.T) {
+		ucInvitation, storageRepo, enforcer, _ := newUseCase(invitation.StatusPending)
+		storageRepo.On("InvitationGetUser", mock.Anything, testPhone).
+			Return("", errors.Wrap(sql.ErrNoRows, "user select query error"))
+		storageRepo.On("InvitationAccept", mock.Anything, testInvitationID, "", mock.MatchedBy(func(input user.SignUpInput) bool {
+			return input.Phone == testPhone && input.FirstName == "John" && input.Password != "" && input.Password != "secret"
+		})).Return(testUserID, nil)
+
+		userID, err := ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{
+			Token: testToken, Password: "secret", FirstName: "John", LastName: "Doe",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, testUserID, userID)
+		assert.Equal(t, 1, enforcer.reloads)
+	})
+
+	t.Run("InvitationAcceptNewUserWithoutSignUpData", func(t *testing.T) {
+		ucInvitation, storageRepo, enforcer, _ := newUseCase(invitation.StatusPending)
+		storageRepo.On("InvitationGetUser", mock.Anything, testPhone).
+			Return("", errors.Wrap(sql.ErrNoRows, "user select query error"))
+
+		_, err := ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{Token: testToken})
+		assert.ErrorIs(t, err, usecase.ErrSignUpDataRequired)
+		assert.Zero(t, enforcer.reloads)
+
+		storageRepo.AssertNotCalled(t, "InvitationAccept", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("InvitationAcceptUnknownCode", func(t *testing.T) {
+		ucInvitation, _, _, _ := newUseCase(invitation.StatusPending)
+
+		_, err := ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{Token: "unknown"})
+		assert.ErrorIs(t, err, usecase.ErrInvalidInvitation)
+	})
+
+	t.Run("InvitationAcceptNotPending", func(t *testing.T) {
+		for _, status := range []string{invitation.StatusAccepted, invitation.StatusRevoked, invitation.StatusExpired} {
+			ucInvitation, storageRepo, _, _ := newUseCase(status)
+
+			_, err := ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{Token: testToken})
+			assert.ErrorIs(t, err, usecase.ErrInvalidInvitation, status)
+
+			storageRepo.AssertNotCalled(t, "InvitationAccept", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+		}
+	})
+
+	t.Run("InvitationAcceptConcurrent", func(t *testing.T) {
+		ucInvitation, storageRepo, enforcer, _ := newUseCase(invitation.StatusPending)
+		storageRepo.On("InvitationGetUser", mock.Anything, testPhone).Return(testUserID, nil)
+		storageRepo.On("InvitationAccept", mock.Anything, testInvitationID, testUserID, mock.Anything).
+			Return("", errors.Wrap(sql.ErrNoRows, "invitation is not pending"))
+
+		_, err := ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{Token: testToken})
+		assert.ErrorIs(t, err, usecase.ErrInvalidInvitation)
+		assert.Zero(t, enforcer.reloads)
+	})
+
+	t.Run("InvitationAcceptWithError", func(t *testing.T) {
+		ucInvitation, storageRepo, enforcer, _ := newUseCase(invitation.StatusPending)
+		storageRepo.On("InvitationGetUser", mock.Anything, testPhone).Return(testUserID, nil)
+		storageRepo.On("InvitationAccept", mock.Anything, testInvitationID, testUserID, mock.Anything).
+			Return("", errStorage)
+
+		_, err := ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{Token: "failing"})
+		assert.ErrorIs(t, err, errStorage)
+		assert.NotErrorIs(t, err, usecase.ErrInvalidInvitation)
+
+		_, err = ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{Token: testToken})
+		assert.ErrorIs(t, err, errStorage)
+		assert.NotErrorIs(t, err, usecase.ErrInvalidInvitation)
+		assert.Zero(t, enforcer.reloads)
+	})
+
+	t.Run("InvitationAcceptPolicyReloadError", func(t *testing.T) {
+		ucInvitation, storageRepo, enforcer, _ := newUseCase(invitation.StatusPending)
+		enforcer.err = errStorage
+		storageRepo.On("InvitationGetUser", mock.Anything, testPhone).Return(testUserID, nil)
+		storageRepo.On("InvitationAccept", mock.Anything, testInvitationID, testUserID, mock.Anything).
+			Return(testUserID, nil)
+
+		userID, err := ucInvitation.InvitationAccept(context.Empty(), invitation.AcceptInvitationInput{Token: testToken})
+		assert.ErrorIs(t, err, errStorage)
+		assert.Equal(t, testUserID, userID)
+	})
+}
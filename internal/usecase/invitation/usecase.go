@@ -0,0 +1,27 @@
+package invitation
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/policy"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/sms"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
+)
+
+// UseCase is an invitation usecase.
+type UseCase struct {
+	adapterStorage storage.Invitation
+	adapterPolicy  policy.PolicyEnforcer
+	adapterSMS     sms.SMSSender
+	adapterAudit   storage.Audit
+	isTracingOn    bool
+}
+
+// New is a constructor for UseCase.
+func New(storage storage.Invitation, policy policy.PolicyEnforcer, sms sms.SMSSender, audit storage.Audit, isTracingOn bool) *UseCase { //nolint:lll
+	return &UseCase{
+		adapterStorage: storage,
+		adapterPolicy:  policy,
+		adapterSMS:     sms,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+	}
+}
@@ -3,7 +3,9 @@ package item
 import (
 	"reflect"
 
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/item"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -104,17 +106,23 @@ func (s *UseCase) ItemCreate(ctx context.Context, meta query.MetaData, input ite
 		ctx = context.New(ctxt)
 	}
 
+	if err := usecase.CheckOrganization(meta, input.OrganizationID); err != nil {
+		return "", err
+	}
+
 	itemID, err := s.adapterStorage.ItemCreate(ctx, meta, input)
 	if err != nil {
 		return itemID, errors.Wrap(err, "item create error")
 	}
 
-	if s.isCacheOn {
-		itm, err := s.adapterStorage.ItemGetOne(ctx, meta, itemID)
-		if err != nil {
-			return "", errors.Wrap(err, "item select from database failed")
-		}
+	itm, err := s.adapterStorage.ItemGetOne(ctx, meta, itemID)
+	if err != nil {
+		return "", errors.Wrap(err, "item select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, itemID, nil, itm)
+
+	if s.isCacheOn {
 		err = s.adapterCache.ItemCreate(ctx, itm)
 		if err != nil {
 			return "", errors.Wrap(err, "item create in cache failed")
@@ -142,17 +150,30 @@ func (s *UseCase) ItemUpdate(ctx context.Context, meta query.MetaData, input ite
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.ItemUpdate(ctx, meta, input)
+	if input.OrganizationID != nil {
+		if err := usecase.CheckOrganization(meta, *input.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	before, err := s.adapterStorage.ItemGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "item select from database failed")
+	}
+
+	err = s.adapterStorage.ItemUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "item update in database failed")
 	}
 
-	if s.isCacheOn {
-		itm, err := s.adapterStorage.ItemGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "item select from database failed")
-		}
+	itm, err := s.adapterStorage.ItemGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "item select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, itm)
+
+	if s.isCacheOn {
 		err = s.adapterCache.ItemUpdate(ctx, itm)
 		if err != nil {
 			return errors.Wrap(err, "item update in cache failed")
@@ -176,11 +197,18 @@ func (s *UseCase) ItemDelete(ctx context.Context, meta query.MetaData, itemID st
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.ItemDelete(ctx, meta, itemID)
+	before, err := s.adapterStorage.ItemGetOne(ctx, meta, itemID)
+	if err != nil {
+		return errors.Wrap(err, "item select from database failed")
+	}
+
+	err = s.adapterStorage.ItemDelete(ctx, meta, itemID)
 	if err != nil {
 		return errors.Wrap(err, "item delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, itemID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.ItemDelete(ctx, itemID)
 		if err != nil {
@@ -9,11 +9,21 @@ import (
 type UseCase struct {
 	adapterStorage storage.Item
 	adapterCache   cache.Item
+	adapterAudit   storage.Audit
 	isTracingOn    bool
 	isCacheOn      bool
 }
 
+// auditEntity is a name of the entity in the audit log.
+const auditEntity = "item"
+
 // New is a constructor for UseCase.
-func New(storage storage.Item, cache cache.Item, isTracingOn bool, isCacheOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn, isCacheOn: isCacheOn}
+func New(storage storage.Item, cache cache.Item, audit storage.Audit, isTracingOn bool, isCacheOn bool) *UseCase {
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
 }
@@ -0,0 +1,179 @@
+package member
+
+import (
+	"database/sql"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/member"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+const adminRole = "admin"
+
+// MemberGetAll returns staff of the organization.
+func (s *UseCase) MemberGetAll(ctx context.Context, meta query.MetaData, organizationID string) ([]member.Member, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.MemberGetAll")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if _, err := s.checkManager(ctx, meta, organizationID); err != nil {
+		return nil, err
+	}
+
+	members, err := s.adapterStorage.MemberGetAll(ctx, organizationID)
+
+	return members, errors.Wrap(err, "members select error")
+}
+
+// MemberAdd adds the existing user to the staff of the organization.
+func (s *UseCase) MemberAdd(ctx context.Context, meta query.MetaData, organizationID string, input member.AddMemberInput) (member.Member, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.MemberAdd")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	var mbr member.Member
+
+	if !usecase.IsOrganizationRole(input.Role) {
+		return mbr, errors.Wrap(usecase.ErrOrganizationRoleInvalid, input.Role)
+	}
+
+	if _, err := s.checkManager(ctx, meta, organizationID); err != nil {
+		return mbr, err
+	}
+
+	_, err := s.adapterStorage.MemberGetOne(ctx, organizationID, input.UserID)
+	if err == nil {
+		return mbr, usecase.ErrMemberExists
+	}
+
+	if !errors.Is(err, sql.ErrNoRows) {
+		return mbr, errors.Wrap(err, "member select error")
+	}
+
+	if err = s.adapterStorage.MemberCreate(ctx, organizationID, input); err != nil {
+		return mbr, errors.Wrap(err, "member create error")
+	}
+
+	mbr, err = s.adapterStorage.MemberGetOne(ctx, organizationID, input.UserID)
+	if err != nil {
+		return mbr, errors.Wrap(err, "member select error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, organizationID+"/"+input.UserID, nil, mbr)
+
+	return mbr, s.refresh(ctx)
+}
+
+// MemberUpdate changes role or status of the member, the owner can not be suspended.
+func (s *UseCase) MemberUpdate(ctx context.Context, meta query.MetaData, organizationID string, userID string, input member.UpdateMemberInput) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.MemberUpdate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := input.Validate(); err != nil {
+		return errors.Wrap(err, "validation error")
+	}
+
+	if input.Role != nil && !usecase.IsOrganizationRole(*input.Role) {
+		return errors.Wrap(usecase.ErrOrganizationRoleInvalid, *input.Role)
+	}
+
+	ownerID, err := s.checkManager(ctx, meta, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if userID == ownerID && input.Status != nil && *input.Status != member.StatusActive {
+		return usecase.ErrOwnerMembership
+	}
+
+	before, err := s.adapterStorage.MemberGetOne(ctx, organizationID, userID)
+	if err != nil {
+		return errors.Wrap(err, "member select error")
+	}
+
+	if err = s.adapterStorage.MemberUpdate(ctx, organizationID, userID, input); err != nil {
+		return errors.Wrap(err, "member update error")
+	}
+
+	after, err := s.adapterStorage.MemberGetOne(ctx, organizationID, userID)
+	if err != nil {
+		return errors.Wrap(err, "member select error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, organizationID+"/"+userID, before, after)
+
+	return s.refresh(ctx)
+}
+
+// MemberRemove removes the member from the staff of the organization, the owner can not be removed.
+func (s *UseCase) MemberRemove(ctx context.Context, meta query.MetaData, organizationID string, userID string) error {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.MemberRemove")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	ownerID, err := s.checkManager(ctx, meta, organizationID)
+	if err != nil {
+		return err
+	}
+
+	if userID == ownerID {
+		return usecase.ErrOwnerMembership
+	}
+
+	before, err := s.adapterStorage.MemberGetOne(ctx, organizationID, userID)
+	if err != nil {
+		return errors.Wrap(err, "member select error")
+	}
+
+	if err = s.adapterStorage.MemberDelete(ctx, organizationID, userID); err != nil {
+		return errors.Wrap(err, "member delete error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, organizationID+"/"+userID, before, nil)
+
+	return s.refresh(ctx)
+}
+
+// checkManager checks if the user may manage staff of the organization and returns id of the owner.
+func (s *UseCase) checkManager(ctx context.Context, meta query.MetaData, organizationID string) (string, error) {
+	ownerID, err := s.adapterStorage.MemberGetOrganizationOwner(ctx, organizationID)
+	if err != nil {
+		return "", errors.Wrap(err, "organization owner select error")
+	}
+
+	if meta.RoleName != adminRole && ownerID != meta.UserID {
+		return "", usecase.ErrNotOrganizationOwner
+	}
+
+	return ownerID, nil
+}
+
+// refresh applies the changed staff to the policy and to the cached organization lists of the users.
+func (s *UseCase) refresh(ctx context.Context) error {
+	if s.isCacheOn {
+		if err := s.adapterCache.OrganizationInvalidate(ctx); err != nil {
+			logger.Logger.Error("unable to invalidate organizations in cache", zap.String("error", err.Error()))
+		}
+	}
+
+	return errors.Wrap(s.adapterPolicy.Reload(ctx), "policy reload failed")
+}
@@ -0,0 +1,217 @@
+package member
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/member"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testOwnerID        = "49c9b955-8511-4b53-81ef-82e3d0259fed"
+	testUserID         = "0c3f5a7e-2b4d-4c6e-8f1a-3b5d7f9a1c2e"
+	testMemberID       = "2a4c6e8f-1b3d-4f5a-9c7e-5d6f7a8b9c0d"
+	testOrganizationID = "5f2b4c1e-8d3a-4b6f-9e7c-1a2b3c4d5e6f"
+)
+
+var errStorage = errors.New("connection refused")
+
+// policyEnforcer is a test enforcer counting policy reloads.
+type policyEnforcer struct {
+	reloads int
+}
+
+func (p *policyEnforcer) Enforce(_ context.Context, _ string, _ string, _ string, _ string) (bool, error) {
+	return false, nil
+}
+
+func (p *policyEnforcer) GetDomainRoles(_ string, _ string) []string { return nil }
+
+func (p *policyEnforcer) GetUserDomains(_ string) []string { return nil }
+
+func (p *policyEnforcer) GetRoles(_ string) ([]string, error) { return nil, nil }
+
+func (p *policyEnforcer) GetPermissions(_ string) ([][]string, error) { return nil, nil }
+
+func (p *policyEnforcer) GetPolicies() [][]string { return nil }
+
+func (p *policyEnforcer) Evaluate(_ context.Context, _ []rule.PolicyRule, _ string, _ string, _ string) (rule.Evaluation, error) { //nolint:lll
+	return rule.Evaluation{}, nil
+}
+
+func (p *policyEnforcer) Reload(_ context.Context) error {
+	p.reloads++
+
+	return nil
+}
+
+func newUseCase() (*UseCase, *mockStorage.Member, *policyEnforcer) {
+	storageRepo := new(mockStorage.Member)
+	auditRepo := new(mockStorage.Audit)
+	enforcer := &policyEnforcer{}
+
+	storageRepo.On("MemberGetOrganizationOwner", mock.Anything, testOrganizationID).Return(testOwnerID, nil)
+	storageRepo.On("MemberGetOne", mock.Anything, testOrganizationID, testOwnerID).
+		Return(member.Member{UserID: testOwnerID, RoleName: "vendor", Status: member.StatusActive}, nil)
+	storageRepo.On("MemberGetOne", mock.Anything, testOrganizationID, testMemberID).
+		Return(member.Member{UserID: testMemberID, RoleName: "operator", Status: member.StatusActive}, nil)
+	storageRepo.On("MemberUpdate", mock.Anything, testOrganizationID, mock.Anything, mock.Anything).Return(nil)
+	storageRepo.On("MemberDelete", mock.Anything, testOrganizationID, mock.Anything).Return(nil)
+	auditRepo.On("AuditCreate", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(nil)
+
+	return New(storageRepo, nil, enforcer, auditRepo, false, false), storageRepo, enforcer
+}
+
+func TestMemberAdd(t *testing.T) {
+	_ = logger.InitLogger()
+
+	owner := query.MetaData{UserID: testOwnerID, RoleName: "vendor"}
+	input := member.AddMemberInput{UserID: testUserID, Role: "operator"}
+
+	t.Run("MemberAdd", func(t *testing.T) {
+		ucMember, storageRepo, enforcer := newUseCase()
+		storageRepo.On("MemberGetOne", mock.Anything, testOrganizationID, testUserID).
+			Return(member.Member{}, errors.Wrap(sql.ErrNoRows, "member select query error")).Once()
+		storageRepo.On("MemberCreate", mock.Anything, testOrganizationID, input).Return(nil)
+		storageRepo.On("MemberGetOne", mock.Anything, testOrganizationID, testUserID).
+			Return(member.Member{UserID: testUserID, RoleName: "operator", Status: member.StatusActive}, nil)
+
+		mbr, err := ucMember.MemberAdd(context.Empty(), owner, testOrganizationID, input)
+		assert.NoError(t, err)
+		assert.Equal(t, "operator", mbr.RoleName)
+		assert.Equal(t, 1, enforcer.reloads)
+	})
+
+	t.Run("MemberAddInvalidRole", func(t *testing.T) {
+		ucMember, storageRepo, _ := newUseCase()
+
+		for _, role := range []string{"admin", "customer"} {
+			_, err := ucMember.MemberAdd(context.Empty(), owner, testOrganizationID,
+				member.AddMemberInput{UserID: testUserID, Role: role})
+			assert.ErrorIs(t, err, usecase.ErrOrganizationRoleInvalid, role)
+		}
+
+		storageRepo.AssertNotCalled(t, "MemberCreate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("MemberAddNotOwner", func(t *testing.T) {
+		ucMember, storageRepo, _ := newUseCase()
+
+		_, err := ucMember.MemberAdd(context.Empty(), query.MetaData{UserID: testMemberID, RoleName: "vendor"},
+			testOrganizationID, input)
+		assert.ErrorIs(t, err, usecase.ErrNotOrganizationOwner)
+
+		storageRepo.AssertNotCalled(t, "MemberCreate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("MemberAddExisting", func(t *testing.T) {
+		ucMember, storageRepo, enforcer := newUseCase()
+
+		_, err := ucMember.MemberAdd(context.Empty(), owner, testOrganizationID,
+			member.AddMemberInput{UserID: testMemberID, Role: "operator"})
+		assert.ErrorIs(t, err, usecase.ErrMemberExists)
+		assert.Zero(t, enforcer.reloads)
+
+		storageRepo.AssertNotCalled(t, "MemberCreate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("MemberAddWithError", func(t *testing.T) {
+		ucMember, storageRepo, enforcer := newUseCase()
+		storageRepo.On("MemberGetOne", mock.Anything, testOrganizationID, testUserID).Return(member.Member{}, errStorage)
+
+		_, err := ucMember.MemberAdd(context.Empty(), owner, testOrganizationID, input)
+		assert.ErrorIs(t, err, errStorage)
+		assert.Zero(t, enforcer.reloads)
+
+		storageRepo.AssertNotCalled(t, "MemberCreate", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
+
+func TestMemberUpdate(t *testing.T) {
+	_ = logger.InitLogger()
+
+	owner := query.MetaData{UserID: testOwnerID, RoleName: "vendor"}
+	suspended := member.StatusSuspended
+	analyst := "analyst"
+
+	t.Run("MemberUpdate", func(t *testing.T) {
+		ucMember, storageRepo, enforcer := newUseCase()
+		input := member.UpdateMemberInput{Role: &analyst, Status: &suspended}
+
+		assert.NoError(t, ucMember.MemberUpdate(context.Empty(), owner, testOrganizationID, testMemberID, input))
+		assert.Equal(t, 1, enforcer.reloads)
+
+		storageRepo.AssertCalled(t, "MemberUpdate", mock.Anything, testOrganizationID, testMemberID, input)
+	})
+
+	t.Run("MemberUpdateSuspendOwner", func(t *testing.T) {
+		ucMember, storageRepo, _ := newUseCase()
+
+		err := ucMember.MemberUpdate(context.Empty(), query.MetaData{UserID: testUserID, RoleName: "admin"},
+			testOrganizationID, testOwnerID, member.UpdateMemberInput{Status: &suspended})
+		assert.ErrorIs(t, err, usecase.ErrOwnerMembership)
+
+		storageRepo.AssertNotCalled(t, "MemberUpdate", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("MemberUpdateOwnerRole", func(t *testing.T) {
+		ucMember, _, _ := newUseCase()
+
+		err := ucMember.MemberUpdate(context.Empty(), owner, testOrganizationID, testOwnerID,
+			member.UpdateMemberInput{Role: &analyst})
+		assert.NoError(t, err)
+	})
+
+	t.Run("MemberUpdateWithoutValues", func(t *testing.T) {
+		ucMember, storageRepo, _ := newUseCase()
+
+		err := ucMember.MemberUpdate(context.Empty(), owner, testOrganizationID, testMemberID, member.UpdateMemberInput{})
+		assert.ErrorIs(t, err, member.ErrStructHasNoValues)
+
+		storageRepo.AssertNotCalled(t, "MemberGetOrganizationOwner", mock.Anything, mock.Anything)
+	})
+}
+
+func TestMemberRemove(t *testing.T) {
+	_ = logger.InitLogger()
+
+	owner := query.MetaData{UserID: testOwnerID, RoleName: "vendor"}
+
+	t.Run("MemberRemove", func(t *testing.T) {
+		ucMember, storageRepo, enforcer := newUseCase()
+
+		assert.NoError(t, ucMember.MemberRemove(context.Empty(), owner, testOrganizationID, testMemberID))
+		assert.Equal(t, 1, enforcer.reloads)
+
+		storageRepo.AssertCalled(t, "MemberDelete", mock.Anything, testOrganizationID, testMemberID)
+	})
+
+	t.Run("MemberRemoveOwner", func(t *testing.T) {
+		ucMember, storageRepo, enforcer := newUseCase()
+
+		err := ucMember.MemberRemove(context.Empty(), owner, testOrganizationID, testOwnerID)
+		assert.ErrorIs(t, err, usecase.ErrOwnerMembership)
+		assert.Zero(t, enforcer.reloads)
+
+		storageRepo.AssertNotCalled(t, "MemberDelete", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("MemberRemoveNotOwner", func(t *testing.T) {
+		ucMember, storageRepo, _ := newUseCase()
+
+		err := ucMember.MemberRemove(context.Empty(), query.MetaData{UserID: testMemberID, RoleName: "vendor"},
+			testOrganizationID, testUserID)
+		assert.ErrorIs(t, err, usecase.ErrNotOrganizationOwner)
+
+		storageRepo.AssertNotCalled(t, "MemberDelete", mock.Anything, mock.Anything, mock.Anything)
+	})
+}
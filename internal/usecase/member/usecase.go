@@ -0,0 +1,32 @@
+package member
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/cache"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/policy"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
+)
+
+// UseCase is a member usecase.
+type UseCase struct {
+	adapterStorage storage.Member
+	adapterCache   cache.Organization
+	adapterPolicy  policy.PolicyEnforcer
+	adapterAudit   storage.Audit
+	isTracingOn    bool
+	isCacheOn      bool
+}
+
+// auditEntity is a name of the entity in the audit log.
+const auditEntity = "member"
+
+// New is a constructor for UseCase.
+func New(storage storage.Member, cache cache.Organization, policy policy.PolicyEnforcer, audit storage.Audit, isTracingOn bool, isCacheOn bool) *UseCase { //nolint:lll
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterPolicy:  policy,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
+}
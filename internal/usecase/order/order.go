@@ -3,7 +3,9 @@ package order
 import (
 	"reflect"
 
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/order"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -22,7 +24,10 @@ func (s *UseCase) OrderGetAll(ctx context.Context, meta query.MetaData, params q
 		ctx = context.New(ctxt)
 	}
 
-	if s.isCacheOn {
+	meta = usecase.RestrictToOwner(meta)
+
+	// orders of a single user are not cached, the cache is shared by organization
+	if s.isCacheOn && meta.OwnerID == "" {
 		return s.getAllWithCache(ctx, meta, params)
 	}
 
@@ -63,13 +68,27 @@ func (s *UseCase) OrderGetOne(ctx context.Context, meta query.MetaData, orderID
 		ctx = context.New(ctxt)
 	}
 
+	var (
+		ordr order.Order
+		err  error
+	)
+
 	if s.isCacheOn {
-		return s.getOneWithCache(ctx, meta, orderID)
+		ordr, err = s.getOneWithCache(ctx, meta, orderID)
+	} else {
+		ordr, err = s.adapterStorage.OrderGetOne(ctx, meta, orderID)
+		err = errors.Wrap(err, "order select error")
 	}
 
-	ordr, err := s.adapterStorage.OrderGetOne(ctx, meta, orderID)
+	if err != nil {
+		return ordr, err
+	}
 
-	return ordr, errors.Wrap(err, "order select error")
+	if err = usecase.CheckOwner(meta, ordr.UserID); err != nil {
+		return order.Order{}, err
+	}
+
+	return ordr, nil
 }
 
 // getOneWithCache returns order by id from cache if exists.
@@ -104,17 +123,23 @@ func (s *UseCase) OrderCreate(ctx context.Context, meta query.MetaData, input or
 		ctx = context.New(ctxt)
 	}
 
+	if err := usecase.CheckOrganization(meta, input.OrganizationID); err != nil {
+		return "", err
+	}
+
 	orderID, err := s.adapterStorage.OrderCreate(ctx, meta, input)
 	if err != nil {
 		return orderID, errors.Wrap(err, "order create error")
 	}
 
-	if s.isCacheOn {
-		ordr, err := s.adapterStorage.OrderGetOne(ctx, meta, orderID)
-		if err != nil {
-			return "", errors.Wrap(err, "order select from database failed")
-		}
+	ordr, err := s.adapterStorage.OrderGetOne(ctx, meta, orderID)
+	if err != nil {
+		return "", errors.Wrap(err, "order select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, orderID, nil, ordr)
 
+	if s.isCacheOn {
 		err = s.adapterCache.OrderCreate(ctx, ordr)
 		if err != nil {
 			return "", errors.Wrap(err, "order create in cache failed")
@@ -142,17 +167,34 @@ func (s *UseCase) OrderUpdate(ctx context.Context, meta query.MetaData, input or
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.OrderUpdate(ctx, meta, input)
+	if input.OrganizationID != nil {
+		if err := usecase.CheckOrganization(meta, *input.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	if err := s.checkOwner(ctx, meta, *input.ID); err != nil {
+		return err
+	}
+
+	before, err := s.adapterStorage.OrderGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "order select from database failed")
+	}
+
+	err = s.adapterStorage.OrderUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "order update in database failed")
 	}
 
-	if s.isCacheOn {
-		ordr, err := s.adapterStorage.OrderGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "order select from database failed")
-		}
+	ordr, err := s.adapterStorage.OrderGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "order select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, ordr)
 
+	if s.isCacheOn {
 		err = s.adapterCache.OrderUpdate(ctx, ordr)
 		if err != nil {
 			return errors.Wrap(err, "order update in cache failed")
@@ -176,11 +218,22 @@ func (s *UseCase) OrderDelete(ctx context.Context, meta query.MetaData, orderID
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.OrderDelete(ctx, meta, orderID)
+	if err := s.checkOwner(ctx, meta, orderID); err != nil {
+		return err
+	}
+
+	before, err := s.adapterStorage.OrderGetOne(ctx, meta, orderID)
+	if err != nil {
+		return errors.Wrap(err, "order select from database failed")
+	}
+
+	err = s.adapterStorage.OrderDelete(ctx, meta, orderID)
 	if err != nil {
 		return errors.Wrap(err, "order delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, orderID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.OrderDelete(ctx, orderID)
 		if err != nil {
@@ -195,3 +248,17 @@ func (s *UseCase) OrderDelete(ctx context.Context, meta query.MetaData, orderID
 
 	return nil
 }
+
+// checkOwner returns error if the role may change only its own orders and the order belongs to another user.
+func (s *UseCase) checkOwner(ctx context.Context, meta query.MetaData, orderID string) error {
+	if !usecase.IsOwnerRestricted(meta) {
+		return nil
+	}
+
+	ordr, err := s.adapterStorage.OrderGetOne(ctx, meta, orderID)
+	if err != nil {
+		return errors.Wrap(err, "order select error")
+	}
+
+	return usecase.CheckOwner(meta, ordr.UserID)
+}
@@ -1,7 +1,12 @@
 package organization
 
 import (
+	"encoding/hex"
+	"strings"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/organization"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -104,17 +109,19 @@ func (s *UseCase) OrganizationCreate(ctx context.Context, meta query.MetaData, i
 		ctx = context.New(ctxt)
 	}
 
-	organizationID, err := s.adapterStorage.OrganizationCreate(ctx, meta, input)
+	organizationID, err := s.create(ctx, meta, input)
 	if err != nil {
 		return organizationID, errors.Wrap(err, "organization create error")
 	}
 
-	if s.isCacheOn {
-		org, err := s.adapterStorage.OrganizationGetOne(ctx, meta, organizationID)
-		if err != nil {
-			return "", errors.Wrap(err, "organization select from database failed")
-		}
+	org, err := s.adapterStorage.OrganizationGetOne(ctx, meta, organizationID)
+	if err != nil {
+		return "", errors.Wrap(err, "organization select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, organizationID, nil, org)
+
+	if s.isCacheOn {
 		err = s.adapterCache.OrganizationCreate(ctx, org)
 		if err != nil {
 			return "", errors.Wrap(err, "organization create in cache failed")
@@ -126,6 +133,11 @@ func (s *UseCase) OrganizationCreate(ctx context.Context, meta query.MetaData, i
 		}
 	}
 
+	// the owner gets the role in the new organization
+	if err = s.adapterPolicy.Reload(ctx); err != nil {
+		return organizationID, errors.Wrap(err, "policy reload failed")
+	}
+
 	return organizationID, nil
 }
 
@@ -142,17 +154,24 @@ func (s *UseCase) OrganizationUpdate(ctx context.Context, meta query.MetaData, i
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.OrganizationUpdate(ctx, meta, input)
+	before, err := s.adapterStorage.OrganizationGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "organization select from database failed")
+	}
+
+	err = s.adapterStorage.OrganizationUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "organization update in database failed")
 	}
 
-	if s.isCacheOn {
-		org, err := s.adapterStorage.OrganizationGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "organization select from database failed")
-		}
+	org, err := s.adapterStorage.OrganizationGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "organization select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, org)
 
+	if s.isCacheOn {
 		err = s.adapterCache.OrganizationUpdate(ctx, org)
 		if err != nil {
 			return errors.Wrap(err, "organization update in cache failed")
@@ -176,11 +195,18 @@ func (s *UseCase) OrganizationDelete(ctx context.Context, meta query.MetaData, o
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.OrganizationDelete(ctx, meta, organizationID)
+	before, err := s.adapterStorage.OrganizationGetOne(ctx, meta, organizationID)
+	if err != nil {
+		return errors.Wrap(err, "organization select from database failed")
+	}
+
+	err = s.adapterStorage.OrganizationDelete(ctx, meta, organizationID)
 	if err != nil {
 		return errors.Wrap(err, "organization delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, organizationID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.OrganizationDelete(ctx, organizationID)
 		if err != nil {
@@ -195,3 +221,64 @@ func (s *UseCase) OrganizationDelete(ctx context.Context, meta query.MetaData, o
 
 	return nil
 }
+
+// create inserts organization, the slug is generated from the name if it is not given.
+// A random suffix is added to the generated slug if it is already used.
+func (s *UseCase) create(ctx context.Context, meta query.MetaData, input organization.CreateOrganizationInput) (string, error) { //nolint:lll
+	if input.Slug != "" {
+		if err := organization.ValidateSlug(input.Slug); err != nil {
+			return "", err
+		}
+
+		return s.adapterStorage.OrganizationCreate(ctx, meta, input)
+	}
+
+	slug := slugify(input.Name)
+
+	input.Slug = slug
+
+	for attempt := 0; ; attempt++ {
+		organizationID, err := s.adapterStorage.OrganizationCreate(ctx, meta, input)
+		if !errors.Is(err, organization.ErrSlugTaken) || attempt == slugAttempts {
+			return organizationID, err
+		}
+
+		suffix, err := usecase.GenerateRandomBytes(slugSuffixLength)
+		if err != nil {
+			return "", errors.Wrap(err, "can not generate slug suffix")
+		}
+
+		input.Slug = slug + "-" + hex.EncodeToString(suffix)
+	}
+}
+
+// slugify returns lowercase latin letters and digits of the name joined by dashes.
+func slugify(name string) string {
+	var builder strings.Builder
+
+	isDash := true
+
+	for _, char := range strings.ToLower(name) {
+		switch {
+		case char >= 'a' && char <= 'z', char >= '0' && char <= '9':
+			builder.WriteRune(char)
+
+			isDash = false
+		case !isDash:
+			builder.WriteRune('-')
+
+			isDash = true
+		}
+
+		if builder.Len() >= organization.MaxSlugLength-slugSuffixLength*2-1 {
+			break
+		}
+	}
+
+	slug := strings.Trim(builder.String(), "-")
+	if slug == "" {
+		return defaultSlug
+	}
+
+	return slug
+}
@@ -0,0 +1,150 @@
+package organization
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/organization"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	mockCache "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockredis"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testOwnerID        = "49c9b955-8511-4b53-81ef-82e3d0259fed"
+	testOrganizationID = "5f2b4c1e-8d3a-4b6f-9e7c-1a2b3c4d5e6f"
+)
+
+var errStorage = errors.New("connection refused")
+
+// policyEnforcer is a test enforcer counting policy reloads.
+type policyEnforcer struct {
+	reloads int
+	err     error
+}
+
+func (p *policyEnforcer) Enforce(_ context.Context, _ string, _ string, _ string, _ string) (bool, error) {
+	return false, nil
+}
+
+func (p *policyEnforcer) GetDomainRoles(_ string, _ string) []string { return nil }
+
+func (p *policyEnforcer) GetUserDomains(_ string) []string { return nil }
+
+func (p *policyEnforcer) GetRoles(_ string) ([]string, error) { return nil, nil }
+
+func (p *policyEnforcer) GetPermissions(_ string) ([][]string, error) { return nil, nil }
+
+func (p *policyEnforcer) GetPolicies() [][]string { return nil }
+
+func (p *policyEnforcer) Evaluate(_ context.Context, _ []rule.PolicyRule, _ string, _ string, _ string) (rule.Evaluation, error) { //nolint:lll
+	return rule.Evaluation{}, nil
+}
+
+func (p *policyEnforcer) Reload(_ context.Context) error {
+	p.reloads++
+
+	return p.err
+}
+
+func TestOrganizationCreate(t *testing.T) {
+	_ = logger.InitLogger()
+
+	owner := query.MetaData{UserID: testOwnerID, RoleName: "vendor"}
+	input := organization.CreateOrganizationInput{
+		Name: "Green Tea House", UserID: testOwnerID, Address: "Ashgabat", Phone: "99361000000",
+	}
+
+	newUseCase := func(isCacheOn bool) (*UseCase, *mockStorage.Organization, *mockCache.Organization, *policyEnforcer) {
+		storageRepo := new(mockStorage.Organization)
+		cacheRepo := new(mockCache.Organization)
+		auditRepo := new(mockStorage.Audit)
+		enforcer := &policyEnforcer{}
+
+		storageRepo.On("OrganizationGetOne", mock.Anything, owner, testOrganizationID).
+			Return(organization.Organization{ID: testOrganizationID, Name: input.Name}, nil)
+		cacheRepo.On("OrganizationCreate", mock.Anything, mock.Anything).Return(nil)
+		cacheRepo.On("OrganizationInvalidate", mock.Anything).Return(nil)
+		auditRepo.On("AuditCreate", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(nil)
+
+		return New(storageRepo, cacheRepo, enforcer, auditRepo, false, isCacheOn), storageRepo, cacheRepo, enforcer
+	}
+
+	withSlug := func(slug string) interface{} {
+		return mock.MatchedBy(func(input organization.CreateOrganizationInput) bool { return input.Slug == slug })
+	}
+
+	t.Run("OrganizationCreate", func(t *testing.T) {
+		ucOrganization, storageRepo, cacheRepo, enforcer := newUseCase(true)
+		storageRepo.On("OrganizationCreate", mock.Anything, owner, withSlug("green-tea-house")).
+			Return(testOrganizationID, nil)
+
+		organizationID, err := ucOrganization.OrganizationCreate(context.Empty(), owner, input)
+		assert.NoError(t, err)
+		assert.Equal(t, testOrganizationID, organizationID)
+
+		// the owner gets the role in the new organization and sees it in the cached lists
+		assert.Equal(t, 1, enforcer.reloads)
+		cacheRepo.AssertCalled(t, "OrganizationInvalidate", mock.Anything)
+	})
+
+	t.Run("OrganizationCreateSlugTaken", func(t *testing.T) {
+		ucOrganization, storageRepo, _, _ := newUseCase(false)
+		storageRepo.On("OrganizationCreate", mock.Anything, owner, withSlug("green-tea-house")).
+			Return("", organization.ErrSlugTaken)
+		storageRepo.On("OrganizationCreate", mock.Anything, owner, mock.MatchedBy(
+			func(input organization.CreateOrganizationInput) bool {
+				return strings.HasPrefix(input.Slug, "green-tea-house-")
+			})).Return(testOrganizationID, nil).Once()
+
+		organizationID, err := ucOrganization.OrganizationCreate(context.Empty(), owner, input)
+		assert.NoError(t, err)
+		assert.Equal(t, testOrganizationID, organizationID)
+
+		storageRepo.AssertNumberOfCalls(t, "OrganizationCreate", 2)
+	})
+
+	t.Run("OrganizationCreateGivenSlugTaken", func(t *testing.T) {
+		ucOrganization, storageRepo, _, enforcer := newUseCase(false)
+		storageRepo.On("OrganizationCreate", mock.Anything, owner, withSlug("green-tea")).
+			Return("", organization.ErrSlugTaken)
+
+		given := input
+		given.Slug = "green-tea"
+
+		_, err := ucOrganization.OrganizationCreate(context.Empty(), owner, given)
+		assert.ErrorIs(t, err, organization.ErrSlugTaken)
+		assert.Zero(t, enforcer.reloads)
+
+		storageRepo.AssertNumberOfCalls(t, "OrganizationCreate", 1)
+	})
+
+	t.Run("OrganizationCreateWithError", func(t *testing.T) {
+		ucOrganization, storageRepo, cacheRepo, enforcer := newUseCase(true)
+		storageRepo.On("OrganizationCreate", mock.Anything, owner, mock.Anything).Return("", errStorage)
+
+		organizationID, err := ucOrganization.OrganizationCreate(context.Empty(), owner, input)
+		assert.ErrorIs(t, err, errStorage)
+		assert.Empty(t, organizationID)
+		assert.Zero(t, enforcer.reloads)
+
+		storageRepo.AssertNotCalled(t, "OrganizationGetOne", mock.Anything, mock.Anything, mock.Anything)
+		cacheRepo.AssertNotCalled(t, "OrganizationInvalidate", mock.Anything)
+	})
+
+	t.Run("OrganizationCreatePolicyReloadError", func(t *testing.T) {
+		ucOrganization, storageRepo, _, enforcer := newUseCase(false)
+		enforcer.err = errStorage
+		storageRepo.On("OrganizationCreate", mock.Anything, owner, mock.Anything).Return(testOrganizationID, nil)
+
+		organizationID, err := ucOrganization.OrganizationCreate(context.Empty(), owner, input)
+		assert.ErrorIs(t, err, errStorage)
+		assert.Equal(t, testOrganizationID, organizationID)
+	})
+}
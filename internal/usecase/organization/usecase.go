@@ -2,6 +2,7 @@ package organization
 
 import (
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/cache"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/policy"
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
 )
 
@@ -9,11 +10,31 @@ import (
 type UseCase struct {
 	adapterStorage storage.Organization
 	adapterCache   cache.Organization
+	adapterPolicy  policy.PolicyEnforcer
+	adapterAudit   storage.Audit
 	isTracingOn    bool
 	isCacheOn      bool
 }
 
+const (
+	// auditEntity is a name of the entity in the audit log.
+	auditEntity = "organization"
+	// defaultSlug is used if the name has no latin letters or digits.
+	defaultSlug = "organization"
+	// slugAttempts is a number of retries with a random suffix if the generated slug is used.
+	slugAttempts = 3
+	// slugSuffixLength is a number of random bytes in the slug suffix.
+	slugSuffixLength = 3
+)
+
 // New is a constructor for UseCase.
-func New(storage storage.Organization, cache cache.Organization, isTracingOn bool, isCacheOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn, isCacheOn: isCacheOn}
+func New(storage storage.Organization, cache cache.Organization, policy policy.PolicyEnforcer, audit storage.Audit, isTracingOn bool, isCacheOn bool) *UseCase { //nolint:lll
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterPolicy:  policy,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
 }
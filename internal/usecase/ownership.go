@@ -0,0 +1,93 @@
+package usecase
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/spf13/viper"
+)
+
+const adminRole = "admin"
+
+// defaultOwnerRoles are roles restricted to their own data if authorization.owner_roles is not configured.
+var defaultOwnerRoles = []string{"customer"}
+
+// defaultOrganizationRoles are roles assigned in organizations if authorization.organization_roles is not configured.
+var defaultOrganizationRoles = []string{"operator", "vendor", "analyst"}
+
+// IsOwnerRestricted checks if the role may access only its own orders and user record.
+func IsOwnerRestricted(meta query.MetaData) bool {
+	roles := viper.GetStringSlice("authorization.owner_roles")
+	if len(roles) == 0 {
+		roles = defaultOwnerRoles
+	}
+
+	for _, name := range roles {
+		if name == meta.RoleName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsOrganizationRole checks if the role may be assigned in an organization.
+func IsOrganizationRole(name string) bool {
+	roles := viper.GetStringSlice("authorization.organization_roles")
+	if len(roles) == 0 {
+		roles = defaultOrganizationRoles
+	}
+
+	for _, organizationRole := range roles {
+		if organizationRole == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// RestrictToOwner returns metadata filtering rows by the owner if the role may access only its own data.
+func RestrictToOwner(meta query.MetaData) query.MetaData {
+	if IsOwnerRestricted(meta) {
+		meta.OwnerID = meta.UserID
+	}
+
+	return meta
+}
+
+// CheckOwner returns ErrNotOwner if the role may access only its own data and the row belongs to another user.
+func CheckOwner(meta query.MetaData, ownerID string) error {
+	if IsOwnerRestricted(meta) && ownerID != meta.UserID {
+		return ErrNotOwner
+	}
+
+	return nil
+}
+
+// CheckAuthor returns ErrNotAuthor if the role may change only its own rows and the user is not the author of the row,
+// staff moderate rows of their organization.
+func CheckAuthor(meta query.MetaData, authorID string) error {
+	if IsOwnerRestricted(meta) && authorID != meta.UserID {
+		return ErrNotAuthor
+	}
+
+	return nil
+}
+
+// CheckOrganization returns ErrForeignOrganization if the row belongs to another organization than the request.
+// Admins may change rows of any organization, customers belong to no organization and may use any of them if the
+// request is not bound to one, requests of other roles without an organization are denied.
+func CheckOrganization(meta query.MetaData, organizationID string) error {
+	if meta.RoleName == adminRole {
+		return nil
+	}
+
+	if meta.OrganizationID == "" && IsOwnerRestricted(meta) {
+		return nil
+	}
+
+	if organizationID != meta.OrganizationID || meta.OrganizationID == "" {
+		return ErrForeignOrganization
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package usecase
+
+import (
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOwnership(t *testing.T) {
+	t.Parallel()
+
+	customer := query.MetaData{UserID: "customer", RoleName: "customer"}
+	vendor := query.MetaData{UserID: "vendor", RoleName: "vendor"}
+	admin := query.MetaData{UserID: "admin", RoleName: "admin"}
+
+	require.Equal(t, "customer", RestrictToOwner(customer).OwnerID)
+	require.Empty(t, RestrictToOwner(vendor).OwnerID)
+
+	require.NoError(t, CheckOwner(customer, "customer"))
+	require.ErrorIs(t, CheckOwner(customer, "other"), ErrNotOwner)
+	require.NoError(t, CheckOwner(vendor, "other"))
+
+	require.NoError(t, CheckAuthor(customer, "customer"))
+	require.ErrorIs(t, CheckAuthor(customer, "other"), ErrNotAuthor)
+	require.NoError(t, CheckAuthor(vendor, "other"))
+	require.NoError(t, CheckAuthor(admin, "other"))
+
+	tenant := query.MetaData{UserID: "vendor", RoleName: "vendor", OrganizationID: "organization"}
+
+	require.NoError(t, CheckOrganization(tenant, "organization"))
+	require.ErrorIs(t, CheckOrganization(tenant, "other"), ErrForeignOrganization)
+	require.ErrorIs(t, CheckOrganization(vendor, "other"), ErrForeignOrganization)
+	require.ErrorIs(t, CheckOrganization(vendor, ""), ErrForeignOrganization)
+	require.NoError(t, CheckOrganization(customer, "other"))
+	require.ErrorIs(t, CheckOrganization(query.MetaData{RoleName: "customer", OrganizationID: "organization"}, "other"), ErrForeignOrganization) //nolint:lll
+	require.NoError(t, CheckOrganization(admin, "other"))
+	require.NoError(t, CheckOrganization(query.MetaData{RoleName: "admin", OrganizationID: "organization"}, "other"))
+}
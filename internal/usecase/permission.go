@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"sort"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+)
+
+// Effects of policy rules.
+const (
+	EffectAllow = "allow"
+	EffectDeny  = "deny"
+	// policyLength is a length of policy rule: subject, object, action, effect.
+	policyLength = 4
+)
+
+// EffectivePermissions merges policy rules by object and action, deny wins over allow.
+func EffectivePermissions(policies [][]string) []rule.Permission {
+	permissions := make([]rule.Permission, 0, len(policies))
+	indexes := make(map[[2]string]int)
+
+	for _, policy := range policies {
+		if len(policy) < policyLength {
+			continue
+		}
+
+		key := [2]string{policy[1], policy[2]}
+
+		index, exists := indexes[key]
+		if !exists {
+			index = len(permissions)
+			indexes[key] = index
+
+			permissions = append(permissions, rule.Permission{Object: policy[1], Action: policy[2], Effect: EffectAllow})
+		}
+
+		if policy[3] != EffectAllow {
+			permissions[index].Effect = EffectDeny
+		}
+
+		permissions[index].Roles = append(permissions[index].Roles, policy[0])
+	}
+
+	sort.Slice(permissions, func(i, j int) bool {
+		if permissions[i].Object != permissions[j].Object {
+			return permissions[i].Object < permissions[j].Object
+		}
+
+		return permissions[i].Action < permissions[j].Action
+	})
+
+	return permissions
+}
@@ -0,0 +1,141 @@
+package rule
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/queryparameter"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// Reasons of policy decisions.
+const (
+	reasonAllowed = "allowed by a matching allow rule"
+	reasonDenied  = "denied by a matching deny rule, deny wins over allow"
+	reasonDefault = "denied by default, no rule matches"
+)
+
+// RuleEvaluate checks if the role may take the action on the object and explains which rules decided it,
+// proposed changes apply to the current policy only for the evaluation.
+func (s *UseCase) RuleEvaluate(ctx context.Context, input rule.EvaluationInput) (rule.Evaluation, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.RuleEvaluate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkRole(ctx, input.Role); err != nil {
+		return rule.Evaluation{}, err
+	}
+
+	rows, err := s.adapterStorage.RuleGetAll(ctx, query.MetaData{}, queryparameter.QueryParameter{})
+	if err != nil {
+		return rule.Evaluation{}, errors.Wrap(err, "rules select error")
+	}
+
+	removed := make(map[rule.PolicyRule]bool, len(input.Removed))
+	for _, rle := range input.Removed {
+		removed[rle] = true
+	}
+
+	rules := make([]rule.PolicyRule, 0, len(rows)+len(input.Added))
+	ids := make(map[rule.PolicyRule][]string, len(rows))
+
+	for _, row := range rows {
+		rle := rule.PolicyRule{
+			Ptype: row.Ptype,
+			V0:    row.V0,
+			V1:    row.V1,
+			V2:    row.V2,
+			V3:    row.V3,
+			V4:    row.V4,
+			V5:    row.V5,
+		}
+
+		if removed[rle] {
+			continue
+		}
+
+		if len(ids[rle]) == 0 {
+			rules = append(rules, rle)
+		}
+
+		ids[rle] = append(ids[rle], row.ID)
+	}
+
+	if len(input.Added) > 0 {
+		valid, err := s.newValidator(ctx)
+		if err != nil {
+			return rule.Evaluation{}, err
+		}
+
+		for i, rle := range input.Added {
+			if err = valid.validate(rle); err != nil {
+				return rule.Evaluation{}, errors.Wrapf(err, "added rule %d", i+1)
+			}
+
+			if len(ids[rle]) == 0 {
+				rules = append(rules, rle)
+			}
+		}
+
+		if err = checkHierarchy(rules); err != nil {
+			return rule.Evaluation{}, err
+		}
+	}
+
+	evaluation, err := s.adapterPolicy.Evaluate(ctx, rules, input.Role, input.Object, input.Action)
+	if err != nil {
+		return evaluation, errors.Wrap(err, "policy evaluation error")
+	}
+
+	return explain(evaluation, ids), nil
+}
+
+// explain adds IDs of casbin_rule rows to the matched rules and the effect which won, the rules without rows are proposed.
+func explain(evaluation rule.Evaluation, ids map[rule.PolicyRule][]string) rule.Evaluation {
+	matched := make(rule.ListMatchedRule, 0, len(evaluation.Matched))
+
+	var allow, deny bool
+
+	for _, match := range evaluation.Matched {
+		switch match.Rule.V3 {
+		case usecase.EffectAllow:
+			allow = true
+		case usecase.EffectDeny:
+			deny = true
+		}
+
+		if len(ids[match.Rule]) == 0 {
+			matched = append(matched, rule.MatchedRule{Rule: match.Rule, Proposed: true})
+
+			continue
+		}
+
+		for _, ruleID := range ids[match.Rule] {
+			matched = append(matched, rule.MatchedRule{ID: ruleID, Rule: match.Rule})
+		}
+	}
+
+	evaluation.Matched = matched
+
+	switch {
+	case deny:
+		evaluation.Effect = usecase.EffectDeny
+		evaluation.Reason = reasonDenied
+	case allow:
+		evaluation.Effect = usecase.EffectAllow
+		evaluation.Reason = reasonAllowed
+	default:
+		evaluation.Reason = reasonDefault
+	}
+
+	if evaluation.Roles == nil {
+		evaluation.Roles = []string{}
+	}
+
+	return evaluation
+}
@@ -0,0 +1,181 @@
+package rule
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// RuleGetRoleParents returns roles the role inherits directly.
+func (s *UseCase) RuleGetRoleParents(ctx context.Context, roleName string) ([]string, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.RuleGetRoleParents")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkRole(ctx, roleName); err != nil {
+		return nil, err
+	}
+
+	parents, err := s.adapterStorage.RuleGetRoleParents(ctx, roleName)
+
+	return parents, errors.Wrap(err, "role parents select error")
+}
+
+// RuleCreateRoleParent makes the role inherit permissions of the parent role.
+func (s *UseCase) RuleCreateRoleParent(ctx context.Context, meta query.MetaData, roleName string, input rule.RoleParentInput) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.RuleCreateRoleParent")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkRole(ctx, roleName); err != nil {
+		return err
+	}
+
+	if err := s.checkRole(ctx, input.Parent); err != nil {
+		return err
+	}
+
+	if roleName == input.Parent {
+		return usecase.ErrRoleHierarchyCycle
+	}
+
+	// the parent can not inherit the role, directly or through other roles
+	ancestors, err := s.adapterPolicy.GetRoles(input.Parent)
+	if err != nil {
+		return errors.Wrap(err, "inherited roles select error")
+	}
+
+	for _, ancestor := range ancestors {
+		if ancestor == roleName {
+			return usecase.ErrRoleHierarchyCycle
+		}
+	}
+
+	parents, err := s.adapterStorage.RuleGetRoleParents(ctx, roleName)
+	if err != nil {
+		return errors.Wrap(err, "role parents select error")
+	}
+
+	for _, parent := range parents {
+		if parent == input.Parent {
+			return nil
+		}
+	}
+
+	if err = s.adapterStorage.RuleCreateRoleParent(ctx, roleName, input.Parent); err != nil {
+		return errors.Wrap(err, "role parent create error")
+	}
+
+	usecase.RecordChange(
+		ctx,
+		s.adapterAudit,
+		meta,
+		audit.ActionUpdate,
+		roleEntity,
+		roleName,
+		roleParents{Parents: parents},
+		roleParents{Parents: append(parents, input.Parent)},
+	)
+
+	return s.afterPolicyChange(ctx)
+}
+
+// RuleDeleteRoleParent stops the role inheriting permissions of the parent role.
+func (s *UseCase) RuleDeleteRoleParent(ctx context.Context, meta query.MetaData, roleName string, parent string) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.RuleDeleteRoleParent")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	parents, err := s.adapterStorage.RuleGetRoleParents(ctx, roleName)
+	if err != nil {
+		return errors.Wrap(err, "role parents select error")
+	}
+
+	if err = s.adapterStorage.RuleDeleteRoleParent(ctx, roleName, parent); err != nil {
+		return errors.Wrap(err, "role parent delete error")
+	}
+
+	remaining := make([]string, 0, len(parents))
+
+	for _, current := range parents {
+		if current != parent {
+			remaining = append(remaining, current)
+		}
+	}
+
+	usecase.RecordChange(
+		ctx,
+		s.adapterAudit,
+		meta,
+		audit.ActionUpdate,
+		roleEntity,
+		roleName,
+		roleParents{Parents: parents},
+		roleParents{Parents: remaining},
+	)
+
+	return s.afterPolicyChange(ctx)
+}
+
+// RuleGetRolePermissions returns effective permissions of the role including inherited ones.
+func (s *UseCase) RuleGetRolePermissions(ctx context.Context, roleName string) ([]rule.Permission, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.RuleGetRolePermissions")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := s.checkRole(ctx, roleName); err != nil {
+		return nil, err
+	}
+
+	policies, err := s.adapterPolicy.GetPermissions(roleName)
+	if err != nil {
+		return nil, errors.Wrap(err, "role permissions select error")
+	}
+
+	return usecase.EffectivePermissions(policies), nil
+}
+
+// checkRole returns error if the role does not exist.
+func (s *UseCase) checkRole(ctx context.Context, roleName string) error {
+	exists, err := s.adapterStorage.RuleRoleExists(ctx, roleName)
+	if err != nil {
+		return errors.Wrap(err, "role select error")
+	}
+
+	if !exists {
+		return errors.Wrap(usecase.ErrRoleNotFound, roleName)
+	}
+
+	return nil
+}
+
+// afterPolicyChange reloads the policy and invalidates cached rules.
+func (s *UseCase) afterPolicyChange(ctx context.Context) error {
+	if err := s.adapterPolicy.Reload(ctx); err != nil {
+		return errors.Wrap(err, "policy reload failed")
+	}
+
+	if s.isCacheOn {
+		if err := s.adapterCache.RuleInvalidate(ctx); err != nil {
+			return errors.Wrap(err, "rule invalidate in cache failed")
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package rule
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// RuleExport returns the full policy set.
+func (s *UseCase) RuleExport(ctx context.Context) ([]rule.PolicyRule, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.RuleExport")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	rules, err := s.adapterStorage.RuleGetPolicy(ctx)
+
+	return rules, errors.Wrap(err, "policy select error")
+}
+
+// RuleImport replaces the policy set with the imported one and returns the difference, nothing changes on dry run.
+func (s *UseCase) RuleImport(ctx context.Context, meta query.MetaData, rules []rule.PolicyRule, dryRun bool) (rule.PolicyDiff, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.RuleImport")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	valid, err := s.newValidator(ctx)
+	if err != nil {
+		return rule.PolicyDiff{}, err
+	}
+
+	for i, rle := range rules {
+		if err = valid.validate(rle); err != nil {
+			return rule.PolicyDiff{}, errors.Wrapf(err, "rule %d", i+1)
+		}
+	}
+
+	if err = checkHierarchy(rules); err != nil {
+		return rule.PolicyDiff{}, err
+	}
+
+	if !allowsImport(rules) {
+		return rule.PolicyDiff{}, usecase.ErrPolicyLockout
+	}
+
+	current, err := s.adapterStorage.RuleGetPolicy(ctx)
+	if err != nil {
+		return rule.PolicyDiff{}, errors.Wrap(err, "policy select error")
+	}
+
+	diff := diffPolicy(current, rules)
+
+	if dryRun {
+		return diff, nil
+	}
+
+	if len(diff.Added) > 0 || len(diff.Removed) > 0 {
+		if err = s.adapterStorage.RulePatchPolicy(ctx, diff.Added, diff.Removed); err != nil {
+			return rule.PolicyDiff{}, errors.Wrap(err, "policy update error")
+		}
+
+		usecase.RecordChange(
+			ctx,
+			s.adapterAudit,
+			meta,
+			audit.ActionUpdate,
+			policyEntity,
+			"",
+			policyRules{Rules: diff.Removed},
+			policyRules{Rules: diff.Added},
+		)
+
+		if err = s.afterPolicyChange(ctx); err != nil {
+			return rule.PolicyDiff{}, err
+		}
+	}
+
+	diff.Applied = true
+
+	return diff, nil
+}
+
+// allowsImport checks if some role is allowed to import the policy, otherwise nobody could change it any more.
+func allowsImport(rules []rule.PolicyRule) bool {
+	for _, rle := range rules {
+		if rle.Ptype == policyType && rle.V1 == policyObject && rle.V2 == importAction && rle.V3 == usecase.EffectAllow {
+			return true
+		}
+	}
+
+	return false
+}
+
+// diffPolicy returns rules to add and to remove for the current policy set to become the imported one, duplicates are ignored.
+func diffPolicy(current []rule.PolicyRule, imported []rule.PolicyRule) rule.PolicyDiff {
+	diff := rule.PolicyDiff{Added: rule.ListPolicyRule{}, Removed: rule.ListPolicyRule{}}
+
+	currentSet := make(map[rule.PolicyRule]bool, len(current))
+	for _, rle := range current {
+		currentSet[rle] = true
+	}
+
+	importedSet := make(map[rule.PolicyRule]bool, len(imported))
+
+	for _, rle := range imported {
+		if importedSet[rle] {
+			continue
+		}
+
+		importedSet[rle] = true
+
+		if currentSet[rle] {
+			diff.Unchanged++
+		} else {
+			diff.Added = append(diff.Added, rle)
+		}
+	}
+
+	for _, rle := range current {
+		if !importedSet[rle] {
+			diff.Removed = append(diff.Removed, rle)
+			// removing deletes every copy of the rule
+			importedSet[rle] = true
+		}
+	}
+
+	return diff
+}
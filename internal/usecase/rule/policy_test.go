@@ -0,0 +1,92 @@
+package rule
+
+import (
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidator(t *testing.T) {
+	t.Parallel()
+
+	valid := newValidator(
+		[]string{"admin", "vendor"},
+		[]rule.Resource{{Object: "item", Action: "delete"}, {Object: "items", Action: "get"}},
+	)
+
+	require.NoError(t, valid.validate(rule.PolicyRule{Ptype: "p", V0: "vendor", V1: "item", V2: "delete", V3: "allow"}))
+	require.NoError(t, valid.validate(rule.PolicyRule{Ptype: "g", V0: "admin", V1: "vendor", V2: "*"}))
+
+	for _, rle := range []rule.PolicyRule{
+		{Ptype: "p", V0: "vendr", V1: "item", V2: "delete", V3: "allow"},
+		{Ptype: "p", V0: "vendor", V1: "itm", V2: "delete", V3: "allow"},
+		{Ptype: "p", V0: "vendor", V1: "item", V2: "delet", V3: "allow"},
+		{Ptype: "p", V0: "vendor", V1: "items", V2: "delete", V3: "allow"},
+		{Ptype: "p", V0: "vendor", V1: "item", V2: "delete", V3: "alow"},
+		{Ptype: "g", V0: "admin", V1: "vendor", V2: "organization"},
+		{Ptype: "x", V0: "admin"},
+	} {
+		require.ErrorIs(t, valid.validate(rle), usecase.ErrInvalidRule, rle)
+	}
+
+	require.ErrorIs(t,
+		valid.validate(rule.PolicyRule{Ptype: "g", V0: "admin", V1: "admin", V2: "*"}),
+		usecase.ErrRoleHierarchyCycle,
+	)
+}
+
+func TestCheckHierarchy(t *testing.T) {
+	t.Parallel()
+
+	rules := []rule.PolicyRule{
+		{Ptype: "g", V0: "admin", V1: "vendor", V2: "*"},
+		{Ptype: "g", V0: "vendor", V1: "operator", V2: "*"},
+	}
+
+	require.NoError(t, checkHierarchy(rules))
+
+	rules = append(rules, rule.PolicyRule{Ptype: "g", V0: "operator", V1: "admin", V2: "*"})
+
+	require.ErrorIs(t, checkHierarchy(rules), usecase.ErrRoleHierarchyCycle)
+}
+
+func TestDiffPolicy(t *testing.T) {
+	t.Parallel()
+
+	kept := rule.PolicyRule{Ptype: "p", V0: "admin", V1: "policy", V2: "post", V3: "allow"}
+	removed := rule.PolicyRule{Ptype: "p", V0: "vendor", V1: "item", V2: "delete", V3: "allow"}
+	added := rule.PolicyRule{Ptype: "p", V0: "vendor", V1: "items", V2: "get", V3: "allow"}
+
+	diff := diffPolicy(
+		[]rule.PolicyRule{kept, removed, removed},
+		[]rule.PolicyRule{kept, added, added},
+	)
+
+	require.Equal(t, rule.ListPolicyRule{added}, diff.Added)
+	require.Equal(t, rule.ListPolicyRule{removed}, diff.Removed)
+	require.Equal(t, 1, diff.Unchanged)
+	require.True(t, allowsImport([]rule.PolicyRule{kept}))
+	require.False(t, allowsImport([]rule.PolicyRule{added}))
+}
+
+func TestExplain(t *testing.T) {
+	t.Parallel()
+
+	allow := rule.PolicyRule{Ptype: "p", V0: "operator", V1: "orders", V2: "get", V3: "allow"}
+	deny := rule.PolicyRule{Ptype: "p", V0: "vendor", V1: "orders", V2: "get", V3: "deny"}
+	ids := map[rule.PolicyRule][]string{allow: {"1"}}
+
+	evaluation := explain(rule.Evaluation{Allowed: true, Matched: rule.ListMatchedRule{{Rule: allow}}}, ids)
+	require.Equal(t, usecase.EffectAllow, evaluation.Effect)
+	require.Equal(t, rule.ListMatchedRule{{ID: "1", Rule: allow}}, evaluation.Matched)
+
+	evaluation = explain(rule.Evaluation{Matched: rule.ListMatchedRule{{Rule: allow}, {Rule: deny}}}, ids)
+	require.Equal(t, usecase.EffectDeny, evaluation.Effect)
+	require.Equal(t, rule.ListMatchedRule{{ID: "1", Rule: allow}, {Rule: deny, Proposed: true}}, evaluation.Matched)
+
+	evaluation = explain(rule.Evaluation{}, ids)
+	require.Empty(t, evaluation.Effect)
+	require.Equal(t, reasonDefault, evaluation.Reason)
+}
@@ -1,7 +1,9 @@
 package rule
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -104,18 +106,38 @@ func (s *UseCase) RuleCreate(ctx context.Context, meta query.MetaData, input rul
 		ctx = context.New(ctxt)
 	}
 
+	rle := rule.PolicyRule{
+		Ptype: input.Ptype,
+		V0:    input.V0,
+		V1:    input.V1,
+		V2:    input.V2,
+		V3:    input.V3,
+		V4:    input.V4,
+		V5:    input.V5,
+	}
+
+	if err := s.validateChange(ctx, rle, nil); err != nil {
+		return "", err
+	}
+
 	ruleID, err := s.adapterStorage.RuleCreate(ctx, meta, input)
 	if err != nil {
 		return ruleID, errors.Wrap(err, "rule create error")
 	}
 
-	if s.isCacheOn {
-		rle, err := s.adapterStorage.RuleGetOne(ctx, meta, ruleID)
-		if err != nil {
-			return "", errors.Wrap(err, "rule select from database failed")
-		}
+	if err = s.adapterPolicy.Reload(ctx); err != nil {
+		return "", errors.Wrap(err, "policy reload failed")
+	}
 
-		err = s.adapterCache.RuleCreate(ctx, rle)
+	created, err := s.adapterStorage.RuleGetOne(ctx, meta, ruleID)
+	if err != nil {
+		return "", errors.Wrap(err, "rule select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, ruleID, nil, created)
+
+	if s.isCacheOn {
+		err = s.adapterCache.RuleCreate(ctx, created)
 		if err != nil {
 			return "", errors.Wrap(err, "rule create in cache failed")
 		}
@@ -142,18 +164,47 @@ func (s *UseCase) RuleUpdate(ctx context.Context, meta query.MetaData, input rul
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.RuleUpdate(ctx, meta, input)
+	if input.ID == nil {
+		return errors.Wrap(rule.ErrStructHasNoValues, "rule id is required")
+	}
+
+	current, err := s.adapterStorage.RuleGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "rule select from database failed")
+	}
+
+	previous := rule.PolicyRule{
+		Ptype: current.Ptype,
+		V0:    current.V0,
+		V1:    current.V1,
+		V2:    current.V2,
+		V3:    current.V3,
+		V4:    current.V4,
+		V5:    current.V5,
+	}
+
+	if err = s.validateChange(ctx, input.Apply(previous), &previous); err != nil {
+		return err
+	}
+
+	err = s.adapterStorage.RuleUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "rule update in database failed")
 	}
 
-	if s.isCacheOn {
-		rle, err := s.adapterStorage.RuleGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "rule select from database failed")
-		}
+	if err = s.adapterPolicy.Reload(ctx); err != nil {
+		return errors.Wrap(err, "policy reload failed")
+	}
+
+	updated, err := s.adapterStorage.RuleGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "rule select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, current, updated)
 
-		err = s.adapterCache.RuleUpdate(ctx, rle)
+	if s.isCacheOn {
+		err = s.adapterCache.RuleUpdate(ctx, updated)
 		if err != nil {
 			return errors.Wrap(err, "rule update in cache failed")
 		}
@@ -176,11 +227,22 @@ func (s *UseCase) RuleDelete(ctx context.Context, meta query.MetaData, ruleID st
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.RuleDelete(ctx, meta, ruleID)
+	before, err := s.adapterStorage.RuleGetOne(ctx, meta, ruleID)
+	if err != nil {
+		return errors.Wrap(err, "rule select from database failed")
+	}
+
+	err = s.adapterStorage.RuleDelete(ctx, meta, ruleID)
 	if err != nil {
 		return errors.Wrap(err, "rule delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, ruleID, before, nil)
+
+	if err = s.adapterPolicy.Reload(ctx); err != nil {
+		return errors.Wrap(err, "policy reload failed")
+	}
+
 	if s.isCacheOn {
 		err = s.adapterCache.RuleDelete(ctx, ruleID)
 		if err != nil {
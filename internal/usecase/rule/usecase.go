@@ -1,7 +1,9 @@
 package rule
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/cache"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/policy"
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
 )
 
@@ -9,11 +11,39 @@ import (
 type UseCase struct {
 	adapterStorage storage.Rule
 	adapterCache   cache.Rule
+	adapterPolicy  policy.PolicyEnforcer
+	adapterAudit   storage.Audit
+	resources      []rule.Resource
 	isTracingOn    bool
 	isCacheOn      bool
 }
 
+// Names of the entities in the audit log.
+const (
+	auditEntity  = "rule"
+	roleEntity   = "role"
+	policyEntity = "policy"
+)
+
+// roleParents is an audited state of the role hierarchy.
+type roleParents struct {
+	Parents []string `json:"parents"`
+}
+
+// policyRules is an audited state of the imported policy.
+type policyRules struct {
+	Rules []rule.PolicyRule `json:"rules"`
+}
+
 // New is a constructor for UseCase.
-func New(storage storage.Rule, cache cache.Rule, isTracingOn bool, isCacheOn bool) *UseCase {
-	return &UseCase{adapterStorage: storage, adapterCache: cache, isTracingOn: isTracingOn, isCacheOn: isCacheOn}
+func New(storage storage.Rule, cache cache.Rule, policy policy.PolicyEnforcer, audit storage.Audit, resources []rule.Resource, isTracingOn bool, isCacheOn bool) *UseCase { //nolint:lll
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterPolicy:  policy,
+		adapterAudit:   audit,
+		resources:      resources,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
 }
@@ -0,0 +1,208 @@
+package rule
+
+import (
+	"sort"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/rule"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/pkg/errors"
+)
+
+const (
+	// policyType is a casbin rule type of permission.
+	policyType = "p"
+	// groupingType is a casbin rule type of role inheritance.
+	groupingType = "g"
+	// allDomains is a domain of groupings applied in all organizations.
+	allDomains = "*"
+	// policyObject and importAction are the resource of the policy import endpoint.
+	policyObject = "policy"
+	importAction = "post"
+)
+
+// validator checks rules against known roles and resources.
+type validator struct {
+	roles     map[string]bool
+	verbs     map[string]bool
+	resources map[string]map[string]bool
+}
+
+// newValidator creates validator of rules, roles are loaded from storage.
+func (s *UseCase) newValidator(ctx context.Context) (validator, error) {
+	roles, err := s.adapterStorage.RuleGetRoleNames(ctx)
+	if err != nil {
+		return validator{}, errors.Wrap(err, "role names select error")
+	}
+
+	return newValidator(roles, s.resources), nil
+}
+
+// newValidator creates validator of rules.
+func newValidator(roles []string, resources []rule.Resource) validator {
+	valid := validator{
+		roles:     make(map[string]bool, len(roles)),
+		verbs:     make(map[string]bool),
+		resources: make(map[string]map[string]bool),
+	}
+
+	for _, role := range roles {
+		valid.roles[role] = true
+	}
+
+	for _, resource := range resources {
+		if valid.resources[resource.Object] == nil {
+			valid.resources[resource.Object] = make(map[string]bool)
+		}
+
+		valid.resources[resource.Object][resource.Action] = true
+		valid.verbs[resource.Action] = true
+	}
+
+	return valid
+}
+
+// validate checks if the rule gives a known role access to an endpoint or makes a known role inherit another one.
+func (v validator) validate(rle rule.PolicyRule) error {
+	switch rle.Ptype {
+	case policyType:
+		if !v.roles[rle.V0] {
+			return errors.Wrapf(usecase.ErrInvalidRule, "unknown role %q", rle.V0)
+		}
+
+		actions, ok := v.resources[rle.V1]
+		if !ok {
+			return errors.Wrapf(usecase.ErrInvalidRule, "unknown resource %q", rle.V1)
+		}
+
+		if !v.verbs[rle.V2] {
+			return errors.Wrapf(usecase.ErrInvalidRule, "unknown verb %q", rle.V2)
+		}
+
+		if !actions[rle.V2] {
+			return errors.Wrapf(usecase.ErrInvalidRule, "resource %q has no verb %q", rle.V1, rle.V2)
+		}
+
+		if rle.V3 != usecase.EffectAllow && rle.V3 != usecase.EffectDeny {
+			return errors.Wrapf(usecase.ErrInvalidRule, "unknown effect %q", rle.V3)
+		}
+
+		if rle.V4 != "" || rle.V5 != "" {
+			return errors.Wrap(usecase.ErrInvalidRule, "permission has extra values")
+		}
+	case groupingType:
+		if !v.roles[rle.V0] {
+			return errors.Wrapf(usecase.ErrInvalidRule, "unknown role %q", rle.V0)
+		}
+
+		if !v.roles[rle.V1] {
+			return errors.Wrapf(usecase.ErrInvalidRule, "unknown parent role %q", rle.V1)
+		}
+
+		if rle.V0 == rle.V1 {
+			return errors.Wrap(usecase.ErrRoleHierarchyCycle, rle.V0)
+		}
+
+		// roles in organizations are assigned to users by the organization roles endpoints
+		if rle.V2 != allDomains {
+			return errors.Wrapf(usecase.ErrInvalidRule, "role inheritance must apply in all organizations %q", allDomains)
+		}
+
+		if rle.V3 != "" || rle.V4 != "" || rle.V5 != "" {
+			return errors.Wrap(usecase.ErrInvalidRule, "role inheritance has extra values")
+		}
+	default:
+		return errors.Wrapf(usecase.ErrInvalidRule, "unknown type %q", rle.Ptype)
+	}
+
+	return nil
+}
+
+// checkHierarchy returns error if roles of the policy inherit themselves through other roles.
+func checkHierarchy(rules []rule.PolicyRule) error {
+	parents := make(map[string][]string)
+
+	for _, rle := range rules {
+		if rle.Ptype == groupingType {
+			parents[rle.V0] = append(parents[rle.V0], rle.V1)
+		}
+	}
+
+	roles := make([]string, 0, len(parents))
+	for role := range parents {
+		roles = append(roles, role)
+	}
+
+	sort.Strings(roles)
+
+	const (
+		visiting = 1
+		visited  = 2
+	)
+
+	states := make(map[string]int)
+
+	var visit func(role string) bool
+
+	visit = func(role string) bool {
+		switch states[role] {
+		case visiting:
+			return false
+		case visited:
+			return true
+		}
+
+		states[role] = visiting
+
+		for _, parent := range parents[role] {
+			if !visit(parent) {
+				return false
+			}
+		}
+
+		states[role] = visited
+
+		return true
+	}
+
+	for _, role := range roles {
+		if !visit(role) {
+			return errors.Wrap(usecase.ErrRoleHierarchyCycle, role)
+		}
+	}
+
+	return nil
+}
+
+// validateChange checks the rule and the role hierarchy of the policy with the rule replacing the previous one.
+func (s *UseCase) validateChange(ctx context.Context, rle rule.PolicyRule, previous *rule.PolicyRule) error {
+	valid, err := s.newValidator(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = valid.validate(rle); err != nil {
+		return err
+	}
+
+	if rle.Ptype != groupingType {
+		return nil
+	}
+
+	rules, err := s.adapterStorage.RuleGetPolicy(ctx)
+	if err != nil {
+		return errors.Wrap(err, "policy select error")
+	}
+
+	if previous != nil {
+		for i := range rules {
+			if rules[i] == *previous {
+				rules = append(rules[:i], rules[i+1:]...)
+
+				break
+			}
+		}
+	}
+
+	return checkHierarchy(append(rules, rle))
+}
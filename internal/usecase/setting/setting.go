@@ -0,0 +1,107 @@
+package setting
+
+import (
+	"database/sql"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// SettingGetOne returns settings of the organization, defaults are returned if the organization has not changed them.
+func (s *UseCase) SettingGetOne(ctx context.Context, organizationID string) (setting.Settings, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.SettingGetOne")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if s.isCacheOn {
+		return s.getOneWithCache(ctx, organizationID)
+	}
+
+	return s.getOne(ctx, organizationID)
+}
+
+// getOneWithCache returns settings of the organization from cache if exists.
+func (s *UseCase) getOneWithCache(ctx context.Context, organizationID string) (setting.Settings, error) {
+	settings, err := s.adapterCache.SettingGetOne(ctx, organizationID)
+	if err != nil {
+		logger.Logger.Error("unable to get settings from cache", zap.String("error", err.Error()))
+	}
+
+	if settings.OrganizationID != "" {
+		return settings, nil
+	}
+
+	settings, err = s.getOne(ctx, organizationID)
+	if err != nil {
+		return settings, err
+	}
+
+	if err = s.adapterCache.SettingSet(ctx, settings); err != nil {
+		logger.Logger.Error("unable to add settings into cache", zap.String("error", err.Error()))
+	}
+
+	return settings, nil
+}
+
+// getOne returns settings of the organization from database or defaults.
+func (s *UseCase) getOne(ctx context.Context, organizationID string) (setting.Settings, error) {
+	settings, err := s.adapterStorage.SettingGetOne(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return setting.Default(organizationID), nil
+	}
+
+	return settings, errors.Wrap(err, "settings select error")
+}
+
+// SettingUpdate changes settings of the organization and returns the result.
+func (s *UseCase) SettingUpdate(ctx context.Context, meta query.MetaData, organizationID string, input setting.UpdateSettingsInput) (setting.Settings, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.SettingUpdate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if err := input.Validate(); err != nil {
+		return setting.Settings{}, errors.Wrap(err, "validation error")
+	}
+
+	if err := usecase.CheckOrganization(meta, organizationID); err != nil {
+		return setting.Settings{}, err
+	}
+
+	before, err := s.getOne(ctx, organizationID)
+	if err != nil {
+		return before, err
+	}
+
+	after := input.Apply(before)
+
+	if err = after.Validate(); err != nil {
+		return before, err
+	}
+
+	if err = s.adapterStorage.SettingUpdate(ctx, meta, after); err != nil {
+		return before, errors.Wrap(err, "settings update error")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, organizationID, before, after)
+
+	if s.isCacheOn {
+		if err = s.adapterCache.SettingSet(ctx, after); err != nil {
+			return after, errors.Wrap(err, "settings update in cache failed")
+		}
+	}
+
+	return after, nil
+}
@@ -0,0 +1,174 @@
+package setting
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
+	mockStorage "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockpostgres"
+	mockCache "github.com/evgeniy-dammer/marketplace-api/internal/repository/storage/mockredis"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+const (
+	testOrganizationID = "5f2b4c1e-8d3a-4b6f-9e7c-1a2b3c4d5e6f"
+	testUnsetID        = "9b8a7c6d-5e4f-4a3b-8c2d-1e0f9a8b7c6d"
+	testFailingID      = "1a2b3c4d-5e6f-4a7b-9c8d-0e1f2a3b4c5d"
+)
+
+var errStorage = errors.New("connection refused")
+
+func newUseCase(isCacheOn bool) (*UseCase, *mockStorage.Setting, *mockCache.Setting) {
+	storageRepo := new(mockStorage.Setting)
+	cacheRepo := new(mockCache.Setting)
+	auditRepo := new(mockStorage.Audit)
+
+	stored := setting.Default(testOrganizationID)
+	stored.Currency = "TMT"
+
+	storageRepo.On("SettingGetOne", mock.Anything, testOrganizationID).Return(stored, nil)
+	storageRepo.On("SettingGetOne", mock.Anything, testUnsetID).
+		Return(setting.Settings{}, errors.Wrap(sql.ErrNoRows, "settings select query error"))
+	storageRepo.On("SettingGetOne", mock.Anything, testFailingID).Return(setting.Settings{}, errStorage)
+	storageRepo.On("SettingUpdate", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+	cacheRepo.On("SettingGetOne", mock.Anything, mock.Anything).Return(setting.Settings{}, errors.New("redis: nil"))
+	cacheRepo.On("SettingSet", mock.Anything, mock.Anything).Return(nil)
+	auditRepo.On("AuditCreate", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(nil)
+
+	return New(storageRepo, cacheRepo, auditRepo, false, isCacheOn), storageRepo, cacheRepo
+}
+
+func TestSettingGetOne(t *testing.T) {
+	_ = logger.InitLogger()
+
+	t.Run("SettingGetOne", func(t *testing.T) {
+		ucSetting, _, _ := newUseCase(false)
+
+		settings, err := ucSetting.SettingGetOne(context.Empty(), testOrganizationID)
+		assert.NoError(t, err)
+		assert.Equal(t, "TMT", settings.Currency)
+	})
+
+	t.Run("SettingGetOneDefaults", func(t *testing.T) {
+		ucSetting, _, _ := newUseCase(false)
+
+		settings, err := ucSetting.SettingGetOne(context.Empty(), testUnsetID)
+		assert.NoError(t, err)
+		assert.Equal(t, setting.Default(testUnsetID), settings)
+	})
+
+	t.Run("SettingGetOneWithError", func(t *testing.T) {
+		ucSetting, _, cacheRepo := newUseCase(true)
+
+		_, err := ucSetting.SettingGetOne(context.Empty(), testFailingID)
+		assert.ErrorIs(t, err, errStorage)
+
+		cacheRepo.AssertNotCalled(t, "SettingSet", mock.Anything, mock.Anything)
+	})
+
+	t.Run("SettingGetOneWithCache", func(t *testing.T) {
+		ucSetting, storageRepo, cacheRepo := newUseCase(true)
+
+		settings, err := ucSetting.SettingGetOne(context.Empty(), testUnsetID)
+		assert.NoError(t, err)
+		assert.Equal(t, setting.Default(testUnsetID), settings)
+
+		storageRepo.AssertCalled(t, "SettingGetOne", mock.Anything, testUnsetID)
+		cacheRepo.AssertCalled(t, "SettingSet", mock.Anything, settings)
+	})
+
+	t.Run("SettingGetOneFromCache", func(t *testing.T) {
+		storageRepo := new(mockStorage.Setting)
+		cacheRepo := new(mockCache.Setting)
+		cached := setting.Default(testOrganizationID)
+
+		cacheRepo.On("SettingGetOne", mock.Anything, testOrganizationID).Return(cached, nil)
+
+		ucSetting := New(storageRepo, cacheRepo, nil, false, true)
+
+		settings, err := ucSetting.SettingGetOne(context.Empty(), testOrganizationID)
+		assert.NoError(t, err)
+		assert.Equal(t, cached, settings)
+
+		storageRepo.AssertNotCalled(t, "SettingGetOne", mock.Anything, mock.Anything)
+	})
+}
+
+func TestSettingUpdate(t *testing.T) {
+	_ = logger.InitLogger()
+
+	vendor := query.MetaData{UserID: "49c9b955-8511-4b53-81ef-82e3d0259fed", RoleName: "vendor"}
+	currency := "EUR"
+	invalid := "euro"
+
+	t.Run("SettingUpdate", func(t *testing.T) {
+		ucSetting, storageRepo, cacheRepo := newUseCase(true)
+		meta := vendor
+		meta.OrganizationID = testUnsetID
+
+		settings, err := ucSetting.SettingUpdate(context.Empty(), meta, testUnsetID,
+			setting.UpdateSettingsInput{Currency: &currency})
+		assert.NoError(t, err)
+		assert.Equal(t, currency, settings.Currency)
+		assert.Equal(t, setting.DefaultTimezone, settings.Timezone, "unchanged fields keep defaults")
+
+		storageRepo.AssertCalled(t, "SettingUpdate", mock.Anything, meta, settings)
+		cacheRepo.AssertCalled(t, "SettingSet", mock.Anything, settings)
+	})
+
+	t.Run("SettingUpdateWithoutValues", func(t *testing.T) {
+		ucSetting, storageRepo, _ := newUseCase(false)
+		meta := vendor
+		meta.OrganizationID = testOrganizationID
+
+		_, err := ucSetting.SettingUpdate(context.Empty(), meta, testOrganizationID, setting.UpdateSettingsInput{})
+		assert.ErrorIs(t, err, setting.ErrStructHasNoValues)
+
+		storageRepo.AssertNotCalled(t, "SettingUpdate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("SettingUpdateInvalid", func(t *testing.T) {
+		ucSetting, storageRepo, _ := newUseCase(false)
+		meta := vendor
+		meta.OrganizationID = testOrganizationID
+
+		settings, err := ucSetting.SettingUpdate(context.Empty(), meta, testOrganizationID,
+			setting.UpdateSettingsInput{Currency: &invalid})
+		assert.ErrorIs(t, err, setting.ErrInvalidSettings)
+		assert.Equal(t, "TMT", settings.Currency)
+
+		storageRepo.AssertNotCalled(t, "SettingUpdate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("SettingUpdateForeignOrganization", func(t *testing.T) {
+		ucSetting, storageRepo, _ := newUseCase(false)
+		meta := vendor
+		meta.OrganizationID = testUnsetID
+
+		_, err := ucSetting.SettingUpdate(context.Empty(), meta, testOrganizationID,
+			setting.UpdateSettingsInput{Currency: &currency})
+		assert.ErrorIs(t, err, usecase.ErrForeignOrganization)
+
+		storageRepo.AssertNotCalled(t, "SettingGetOne", mock.Anything, mock.Anything)
+		storageRepo.AssertNotCalled(t, "SettingUpdate", mock.Anything, mock.Anything, mock.Anything)
+	})
+
+	t.Run("SettingUpdateWithError", func(t *testing.T) {
+		ucSetting, storageRepo, cacheRepo := newUseCase(true)
+		meta := vendor
+		meta.OrganizationID = testFailingID
+
+		_, err := ucSetting.SettingUpdate(context.Empty(), meta, testFailingID,
+			setting.UpdateSettingsInput{Currency: &currency})
+		assert.ErrorIs(t, err, errStorage)
+
+		storageRepo.AssertNotCalled(t, "SettingUpdate", mock.Anything, mock.Anything, mock.Anything)
+		cacheRepo.AssertNotCalled(t, "SettingSet", mock.Anything, mock.Anything)
+	})
+}
@@ -0,0 +1,29 @@
+package setting
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/cache"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
+)
+
+// UseCase is a setting usecase.
+type UseCase struct {
+	adapterStorage storage.Setting
+	adapterCache   cache.Setting
+	adapterAudit   storage.Audit
+	isTracingOn    bool
+	isCacheOn      bool
+}
+
+// auditEntity is a name of the entity in the audit log.
+const auditEntity = "settings"
+
+// New is a constructor for UseCase.
+func New(storage storage.Setting, cache cache.Setting, audit storage.Audit, isTracingOn bool, isCacheOn bool) *UseCase {
+	return &UseCase{
+		adapterStorage: storage,
+		adapterCache:   cache,
+		adapterAudit:   audit,
+		isTracingOn:    isTracingOn,
+		isCacheOn:      isCacheOn,
+	}
+}
@@ -1,7 +1,9 @@
 package specification
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/specification"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -102,17 +104,23 @@ func (s *UseCase) SpecificationCreate(ctx context.Context, meta query.MetaData,
 		ctx = context.New(ctxt)
 	}
 
+	if err := usecase.CheckOrganization(meta, input.OrganizationID); err != nil {
+		return "", err
+	}
+
 	specificationID, err := s.adapterStorage.SpecificationCreate(ctx, meta, input)
 	if err != nil {
 		return specificationID, errors.Wrap(err, "specification create error")
 	}
 
-	if s.isCacheOn {
-		spec, err := s.adapterStorage.SpecificationGetOne(ctx, meta, specificationID)
-		if err != nil {
-			return "", errors.Wrap(err, "specification select from database failed")
-		}
+	spec, err := s.adapterStorage.SpecificationGetOne(ctx, meta, specificationID)
+	if err != nil {
+		return "", errors.Wrap(err, "specification select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, specificationID, nil, spec)
 
+	if s.isCacheOn {
 		err = s.adapterCache.SpecificationCreate(ctx, spec)
 		if err != nil {
 			return "", errors.Wrap(err, "specification create in cache failed")
@@ -140,17 +148,30 @@ func (s *UseCase) SpecificationUpdate(ctx context.Context, meta query.MetaData,
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.SpecificationUpdate(ctx, meta, input)
+	if input.OrganizationID != nil {
+		if err := usecase.CheckOrganization(meta, *input.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	before, err := s.adapterStorage.SpecificationGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "specification select from database failed")
+	}
+
+	err = s.adapterStorage.SpecificationUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "specification update in database failed")
 	}
 
-	if s.isCacheOn {
-		spec, err := s.adapterStorage.SpecificationGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "specification select from database failed")
-		}
+	spec, err := s.adapterStorage.SpecificationGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "specification select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, spec)
+
+	if s.isCacheOn {
 		err = s.adapterCache.SpecificationUpdate(ctx, spec)
 		if err != nil {
 			return errors.Wrap(err, "specification update in cache failed")
@@ -174,11 +195,18 @@ func (s *UseCase) SpecificationDelete(ctx context.Context, meta query.MetaData,
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.SpecificationDelete(ctx, meta, specificationID)
+	before, err := s.adapterStorage.SpecificationGetOne(ctx, meta, specificationID)
+	if err != nil {
+		return errors.Wrap(err, "specification select from database failed")
+	}
+
+	err = s.adapterStorage.SpecificationDelete(ctx, meta, specificationID)
 	if err != nil {
 		return errors.Wrap(err, "specification delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, specificationID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.SpecificationDelete(ctx, specificationID)
 		if err != nil {
@@ -0,0 +1,160 @@
+package storefront
+
+import (
+	"database/sql"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/item"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/setting"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/storefront"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+	"go.uber.org/zap"
+)
+
+// StorefrontGetOrganization returns public information and settings of the organization by slug.
+func (s *UseCase) StorefrontGetOrganization(ctx context.Context, slug string) (storefront.Organization, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.StorefrontGetOrganization")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	org, err := s.adapterStorage.StorefrontGetOrganization(ctx, slug)
+	if err != nil {
+		return org, errors.Wrap(err, "organization select error")
+	}
+
+	org.Settings, err = s.settings(ctx, org.ID)
+
+	return org, err
+}
+
+// StorefrontGetCategories returns category tree of the organization by slug.
+func (s *UseCase) StorefrontGetCategories(ctx context.Context, slug string) ([]storefront.Category, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.StorefrontGetCategories")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	org, err := s.adapterStorage.StorefrontGetOrganization(ctx, slug)
+	if err != nil {
+		return nil, errors.Wrap(err, "organization select error")
+	}
+
+	categories, err := s.adapterStorage.StorefrontGetCategories(ctx, org.ID)
+	if err != nil {
+		return nil, errors.Wrap(err, "categories select error")
+	}
+
+	return buildTree(categories), nil
+}
+
+// StorefrontGetItems returns items of the organization by slug with their main images.
+func (s *UseCase) StorefrontGetItems(ctx context.Context, slug string, filter storefront.ItemFilter) ([]item.Item, error) { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.StorefrontGetItems")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	org, err := s.adapterStorage.StorefrontGetOrganization(ctx, slug)
+	if err != nil {
+		return nil, errors.Wrap(err, "organization select error")
+	}
+
+	items, err := s.adapterStorage.StorefrontGetItems(ctx, org.ID, filter)
+
+	return items, errors.Wrap(err, "items select error")
+}
+
+// StorefrontGetItem returns item of the organization by slug with its images, specifications and approved comments.
+func (s *UseCase) StorefrontGetItem(ctx context.Context, slug string, itemID string) (item.Item, error) {
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.StorefrontGetItem")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	org, err := s.adapterStorage.StorefrontGetOrganization(ctx, slug)
+	if err != nil {
+		return item.Item{}, errors.Wrap(err, "organization select error")
+	}
+
+	itm, err := s.adapterStorage.StorefrontGetItem(ctx, org.ID, itemID)
+
+	return itm, errors.Wrap(err, "item select error")
+}
+
+// settings returns settings of the organization from cache if exists, defaults are returned if there are no settings.
+func (s *UseCase) settings(ctx context.Context, organizationID string) (setting.Settings, error) {
+	if s.isCacheOn {
+		settings, err := s.adapterSettingCache.SettingGetOne(ctx, organizationID)
+		if err != nil {
+			logger.Logger.Error("unable to get settings from cache", zap.String("error", err.Error()))
+		}
+
+		if settings.OrganizationID != "" {
+			return settings, nil
+		}
+	}
+
+	settings, err := s.adapterSettingStorage.SettingGetOne(ctx, organizationID)
+	if errors.Is(err, sql.ErrNoRows) {
+		settings, err = setting.Default(organizationID), nil
+	}
+
+	if err != nil {
+		return settings, errors.Wrap(err, "settings select error")
+	}
+
+	if s.isCacheOn {
+		if err = s.adapterSettingCache.SettingSet(ctx, settings); err != nil {
+			logger.Logger.Error("unable to add settings into cache", zap.String("error", err.Error()))
+		}
+	}
+
+	return settings, nil
+}
+
+// buildTree nests categories into their parents, categories with unknown parents become roots.
+func buildTree(categories []storefront.Category) []storefront.Category {
+	children := make(map[string][]storefront.Category, len(categories))
+	known := make(map[string]bool, len(categories))
+
+	for _, category := range categories {
+		known[category.ID] = true
+	}
+
+	var roots []storefront.Category
+
+	for _, category := range categories {
+		if category.Parent == "" || !known[category.Parent] {
+			roots = append(roots, category)
+
+			continue
+		}
+
+		children[category.Parent] = append(children[category.Parent], category)
+	}
+
+	return attachChildren(roots, children)
+}
+
+// attachChildren sets children of the categories recursively.
+func attachChildren(categories []storefront.Category, children map[string][]storefront.Category) []storefront.Category {
+	nodes := make([]storefront.Category, 0, len(categories))
+
+	for _, category := range categories {
+		category.Children = attachChildren(children[category.ID], children)
+		nodes = append(nodes, category)
+	}
+
+	return nodes
+}
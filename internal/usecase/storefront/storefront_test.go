@@ -0,0 +1,30 @@
+package storefront
+
+import (
+	"testing"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/storefront"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildTree(t *testing.T) {
+	t.Parallel()
+
+	tree := buildTree([]storefront.Category{
+		{ID: "drinks"},
+		{ID: "food"},
+		{ID: "hot", Parent: "drinks", Level: 1},
+		{ID: "tea", Parent: "hot", Level: 2},
+		{ID: "orphan", Parent: "deleted", Level: 1},
+	})
+
+	require.Len(t, tree, 3)
+	require.Equal(t, "drinks", tree[0].ID)
+	require.Len(t, tree[0].Children, 1)
+	require.Equal(t, "hot", tree[0].Children[0].ID)
+	require.Equal(t, "tea", tree[0].Children[0].Children[0].ID)
+	require.Empty(t, tree[1].Children)
+	require.Equal(t, "orphan", tree[2].ID)
+
+	require.Empty(t, buildTree(nil))
+}
@@ -0,0 +1,26 @@
+package storefront
+
+import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/cache"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase/adapters/storage"
+)
+
+// UseCase is a storefront usecase.
+type UseCase struct {
+	adapterStorage        storage.Storefront
+	adapterSettingStorage storage.Setting
+	adapterSettingCache   cache.Setting
+	isTracingOn           bool
+	isCacheOn             bool
+}
+
+// New is a constructor for UseCase.
+func New(storage storage.Storefront, settingStorage storage.Setting, settingCache cache.Setting, isTracingOn bool, isCacheOn bool) *UseCase { //nolint:lll
+	return &UseCase{
+		adapterStorage:        storage,
+		adapterSettingStorage: settingStorage,
+		adapterSettingCache:   settingCache,
+		isTracingOn:           isTracingOn,
+		isCacheOn:             isCacheOn,
+	}
+}
@@ -1,7 +1,9 @@
 package table
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/table"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/logger"
 	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
@@ -102,17 +104,23 @@ func (s *UseCase) TableCreate(ctx context.Context, meta query.MetaData, input ta
 		ctx = context.New(ctxt)
 	}
 
+	if err := usecase.CheckOrganization(meta, input.OrganizationID); err != nil {
+		return "", err
+	}
+
 	tableID, err := s.adapterStorage.TableCreate(ctx, meta, input)
 	if err != nil {
 		return tableID, errors.Wrap(err, "table create error")
 	}
 
-	if s.isCacheOn {
-		tble, err := s.adapterStorage.TableGetOne(ctx, meta, tableID)
-		if err != nil {
-			return "", errors.Wrap(err, "table select from database failed")
-		}
+	tble, err := s.adapterStorage.TableGetOne(ctx, meta, tableID)
+	if err != nil {
+		return "", errors.Wrap(err, "table select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, tableID, nil, tble)
+
+	if s.isCacheOn {
 		err = s.adapterCache.TableCreate(ctx, tble)
 		if err != nil {
 			return "", errors.Wrap(err, "table create in cache failed")
@@ -140,17 +148,30 @@ func (s *UseCase) TableUpdate(ctx context.Context, meta query.MetaData, input ta
 		return errors.Wrap(err, "validation error")
 	}
 
-	err := s.adapterStorage.TableUpdate(ctx, meta, input)
+	if input.OrganizationID != nil {
+		if err := usecase.CheckOrganization(meta, *input.OrganizationID); err != nil {
+			return err
+		}
+	}
+
+	before, err := s.adapterStorage.TableGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "table select from database failed")
+	}
+
+	err = s.adapterStorage.TableUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "table update in database failed")
 	}
 
-	if s.isCacheOn {
-		tble, err := s.adapterStorage.TableGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "table select from database failed")
-		}
+	tble, err := s.adapterStorage.TableGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "table select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, tble)
+
+	if s.isCacheOn {
 		err = s.adapterCache.TableUpdate(ctx, tble)
 		if err != nil {
 			return errors.Wrap(err, "table update in cache failed")
@@ -174,11 +195,18 @@ func (s *UseCase) TableDelete(ctx context.Context, meta query.MetaData, tableID
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.TableDelete(ctx, meta, tableID)
+	before, err := s.adapterStorage.TableGetOne(ctx, meta, tableID)
+	if err != nil {
+		return errors.Wrap(err, "table select from database failed")
+	}
+
+	err = s.adapterStorage.TableDelete(ctx, meta, tableID)
 	if err != nil {
 		return errors.Wrap(err, "table delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, tableID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.TableDelete(ctx, tableID)
 		if err != nil {
@@ -0,0 +1,96 @@
+package user
+
+import (
+	"time"
+
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
+	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/context"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/query"
+	"github.com/evgeniy-dammer/marketplace-api/pkg/tracing"
+	"github.com/pkg/errors"
+)
+
+// UserBlock blocks user until reactivation and revokes all sessions of the user.
+func (s *UseCase) UserBlock(ctx context.Context, meta query.MetaData, userID string, input user.ChangeStatusInput) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.UserBlock")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	input.Until = nil
+
+	return s.setStatus(ctx, meta, userID, user.StatusBlocked, input)
+}
+
+// UserSuspend blocks user until the given date and revokes all sessions of the user.
+func (s *UseCase) UserSuspend(ctx context.Context, meta query.MetaData, userID string, input user.ChangeStatusInput) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.UserSuspend")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	if input.Until == nil || !input.Until.After(time.Now()) {
+		return usecase.ErrInvalidSuspensionEnd
+	}
+
+	return s.setStatus(ctx, meta, userID, user.StatusSuspended, input)
+}
+
+// UserReactivate sets active status to the blocked or suspended user.
+func (s *UseCase) UserReactivate(ctx context.Context, meta query.MetaData, userID string, input user.ChangeStatusInput) error { //nolint:lll
+	if s.isTracingOn {
+		ctxt, span := tracing.Tracer.Start(ctx, "Usecase.UserReactivate")
+		defer span.End()
+
+		ctx = context.New(ctxt)
+	}
+
+	input.Until = nil
+
+	return s.setStatus(ctx, meta, userID, user.StatusActive, input)
+}
+
+// setStatus changes status of the user and refreshes cached user and status.
+func (s *UseCase) setStatus(ctx context.Context, meta query.MetaData, userID string, status string, input user.ChangeStatusInput) error { //nolint:lll
+	if userID == meta.UserID {
+		return usecase.ErrOwnStatusChange
+	}
+
+	before, err := s.adapterStorage.UserGetOne(ctx, meta, userID)
+	if err != nil {
+		return errors.Wrap(err, "user select from database failed")
+	}
+
+	if err = s.adapterStorage.UserSetStatus(ctx, meta, userID, status, input); err != nil {
+		return errors.Wrap(err, "user status update in database failed")
+	}
+
+	usr, err := s.adapterStorage.UserGetOne(ctx, meta, userID)
+	if err != nil {
+		return errors.Wrap(err, "user select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, userID, before, usr)
+
+	if s.isCacheOn {
+		if err = s.adapterCache.UserDeleteStatus(ctx, userID); err != nil {
+			return errors.Wrap(err, "user status delete from cache failed")
+		}
+
+		if err = s.adapterCache.UserUpdate(ctx, usr); err != nil {
+			return errors.Wrap(err, "user update in cache failed")
+		}
+
+		if err = s.adapterCache.UserInvalidate(ctx); err != nil {
+			return errors.Wrap(err, "user invalidate users in cache failed")
+		}
+	}
+
+	return nil
+}
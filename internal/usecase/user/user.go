@@ -1,6 +1,7 @@
 package user
 
 import (
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/audit"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
 	"github.com/evgeniy-dammer/marketplace-api/internal/usecase"
@@ -22,7 +23,10 @@ func (s *UseCase) UserGetAll(ctx context.Context, meta query.MetaData, params qu
 		ctx = context.New(ctxt)
 	}
 
-	if s.isCacheOn {
+	meta = usecase.RestrictToOwner(meta)
+
+	// the own user record is not cached in the shared list
+	if s.isCacheOn && meta.OwnerID == "" {
 		return s.getAllWithCache(ctx, meta, params)
 	}
 
@@ -110,6 +114,10 @@ func (s *UseCase) UserGetOne(ctx context.Context, meta query.MetaData, userID st
 		ctx = context.New(ctxt)
 	}
 
+	if err := usecase.CheckOwner(meta, userID); err != nil {
+		return user.User{}, err
+	}
+
 	if s.isCacheOn {
 		return s.getOneWithCache(ctx, meta, userID)
 	}
@@ -154,7 +162,7 @@ func (s *UseCase) UserCreate(ctx context.Context, meta query.MetaData, input use
 		ctx = context.New(ctxt)
 	}
 
-	pass, err := usecase.GeneratePasswordHash(input.Password, usecase.Params)
+	pass, err := usecase.GeneratePasswordHash(input.Password, usecase.PasswordParams())
 	if err != nil {
 		return "", err
 	}
@@ -166,12 +174,14 @@ func (s *UseCase) UserCreate(ctx context.Context, meta query.MetaData, input use
 		return "", errors.Wrap(err, "user create in database failed")
 	}
 
-	if s.isCacheOn {
-		usr, err := s.adapterStorage.UserGetOne(ctx, meta, ID)
-		if err != nil {
-			return "", errors.Wrap(err, "user select from database failed")
-		}
+	usr, err := s.adapterStorage.UserGetOne(ctx, meta, ID)
+	if err != nil {
+		return "", errors.Wrap(err, "user select from database failed")
+	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionCreate, auditEntity, ID, nil, usr)
+
+	if s.isCacheOn {
 		err = s.adapterCache.UserCreate(ctx, usr)
 		if err != nil {
 			return "", errors.Wrap(err, "user create in cache failed")
@@ -199,26 +209,28 @@ func (s *UseCase) UserUpdate(ctx context.Context, meta query.MetaData, input use
 		return errors.Wrap(err, "validation error")
 	}
 
-	if input.Password != nil {
-		pass, err := usecase.GeneratePasswordHash(*input.Password, usecase.Params)
-		if err != nil {
-			return err
-		}
+	if err := usecase.CheckOwner(meta, *input.ID); err != nil {
+		return err
+	}
 
-		input.Password = &pass
+	before, err := s.adapterStorage.UserGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "user select from database failed")
 	}
 
-	err := s.adapterStorage.UserUpdate(ctx, meta, input)
+	err = s.adapterStorage.UserUpdate(ctx, meta, input)
 	if err != nil {
 		return errors.Wrap(err, "user update in database failed")
 	}
 
-	if s.isCacheOn {
-		usr, err := s.adapterStorage.UserGetOne(ctx, meta, *input.ID)
-		if err != nil {
-			return errors.Wrap(err, "user select from database failed")
-		}
+	usr, err := s.adapterStorage.UserGetOne(ctx, meta, *input.ID)
+	if err != nil {
+		return errors.Wrap(err, "user select from database failed")
+	}
+
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionUpdate, auditEntity, *input.ID, before, usr)
 
+	if s.isCacheOn {
 		err = s.adapterCache.UserUpdate(ctx, usr)
 		if err != nil {
 			return errors.Wrap(err, "user update in cache failed")
@@ -242,11 +254,22 @@ func (s *UseCase) UserDelete(ctx context.Context, meta query.MetaData, dUserID s
 		ctx = context.New(ctxt)
 	}
 
-	err := s.adapterStorage.UserDelete(ctx, meta, dUserID)
+	if err := usecase.CheckOwner(meta, dUserID); err != nil {
+		return err
+	}
+
+	before, err := s.adapterStorage.UserGetOne(ctx, meta, dUserID)
+	if err != nil {
+		return errors.Wrap(err, "user select from database failed")
+	}
+
+	err = s.adapterStorage.UserDelete(ctx, meta, dUserID)
 	if err != nil {
 		return errors.Wrap(err, "user delete failed")
 	}
 
+	usecase.RecordChange(ctx, s.adapterAudit, meta, audit.ActionDelete, auditEntity, dUserID, before, nil)
+
 	if s.isCacheOn {
 		err = s.adapterCache.UserDelete(ctx, dUserID)
 		if err != nil {
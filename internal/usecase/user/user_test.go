@@ -3,6 +3,7 @@ package user
 import (
 	"os"
 	"testing"
+	"time"
 
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/role"
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
@@ -24,6 +25,7 @@ const errorString = "error"
 var (
 	storageRepo       = new(mockStorage.User)
 	cacheRepo         = new(mockCache.User)
+	auditRepo         = new(mockStorage.Audit)
 	isTracingOn       = true
 	isCacheOn         = false
 	ucDialog          *UseCase
@@ -42,7 +44,6 @@ func TestMain(m *testing.M) {
 	idWrong := "49c9b955-8511-4b53-81ef-82e32d059fed"
 	firstName := "FirstName1"
 	lastName := "LastName1"
-	password := "password1"
 
 	createUser := user.CreateUserInput{
 		Phone:     "123456789",
@@ -64,14 +65,12 @@ func TestMain(m *testing.M) {
 		ID:        &userID,
 		FirstName: &firstName,
 		LastName:  &lastName,
-		Password:  &password,
 	}
 
 	updateUserWrong = user.UpdateUserInput{
 		ID:        &idWrong,
 		FirstName: &firstName,
 		LastName:  &lastName,
-		Password:  &password,
 	}
 
 	usr = user.User{
@@ -182,6 +181,8 @@ func initTestUseCaseUser(t *testing.T) {
 			return nil
 		})
 
+	auditRepo.On("AuditCreate", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(nil)
+
 	storageRepo.On("UserGetOne",
 		mock.Anything,
 		mock.Anything,
@@ -301,7 +302,6 @@ func initTestUseCaseUser(t *testing.T) {
 				if *input.ID == v.ID {
 					v.FirstName = *input.FirstName
 					v.LastName = *input.LastName
-					v.Password = *input.Password
 
 					return nil
 				}
@@ -422,15 +422,15 @@ func initTestUseCaseUser(t *testing.T) {
 func TestUser(t *testing.T) {
 	initTestUseCaseUser(t)
 
-	ucDialog = New(storageRepo, cacheRepo, isTracingOn, isCacheOn)
-	ucDialogWithCache = New(storageRepo, cacheRepo, isTracingOn, true)
+	ucDialog = New(storageRepo, cacheRepo, auditRepo, isTracingOn, isCacheOn)
+	ucDialogWithCache = New(storageRepo, cacheRepo, auditRepo, isTracingOn, true)
 	assertion := assert.New(t)
 
 	meta := query.MetaData{}
 	params := queryparameter.QueryParameter{}
 
 	t.Run("New", func(t *testing.T) {
-		result := New(storageRepo, cacheRepo, isTracingOn, isCacheOn)
+		result := New(storageRepo, cacheRepo, auditRepo, isTracingOn, isCacheOn)
 		assertion.Equal(result, ucDialog)
 	})
 
@@ -693,3 +693,90 @@ func TestUser(t *testing.T) {
 		assertion.Error(err)
 	})
 }
+
+func TestUserStatus(t *testing.T) {
+	statusStorage := new(mockStorage.User)
+	statusCache := new(mockCache.User)
+	statusAudit := new(mockStorage.Audit)
+
+	statusAudit.On("AuditCreate", mock.Anything, mock.AnythingOfType("audit.Entry")).Return(nil)
+
+	statusStorage.On("UserGetOne",
+		mock.Anything,
+		mock.Anything,
+		mock.AnythingOfType("string")).
+		Return(func(ctx context.Context, meta query.MetaData, userID string) user.User {
+			return user.User{ID: userID, Status: user.StatusActive}
+		}, func(ctx context.Context, meta query.MetaData, userID string) error {
+			if userID == errorString {
+				return usecase.ErrUserNotFound
+			}
+
+			return nil
+		})
+
+	statusStorage.On("UserSetStatus",
+		mock.Anything,
+		mock.Anything,
+		mock.AnythingOfType("string"),
+		mock.AnythingOfType("string"),
+		mock.Anything).
+		Return(func(ctx context.Context, meta query.MetaData, userID string, status string, input user.ChangeStatusInput) error {
+			if userID == errorString {
+				return usecase.ErrUserNotFound
+			}
+
+			return nil
+		})
+
+	ucStatus := New(statusStorage, statusCache, statusAudit, isTracingOn, false)
+	assertion := assert.New(t)
+
+	meta := query.MetaData{UserID: "e0a1c9b2-40a4-4dcb-a4f5-3b8f0e2a1f1d"}
+	userID := "49c9b955-8511-4b53-81ef-82e3d0259fed"
+	until := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	t.Run("UserBlock", func(t *testing.T) {
+		err := ucStatus.UserBlock(context.Empty(), meta, userID, user.ChangeStatusInput{Reason: "fraud", Until: &until})
+		assertion.NoError(err)
+	})
+
+	t.Run("UserBlockWithError", func(t *testing.T) {
+		err := ucStatus.UserBlock(context.Empty(), meta, errorString, user.ChangeStatusInput{Reason: "fraud"})
+		assertion.ErrorIs(err, usecase.ErrUserNotFound)
+	})
+
+	t.Run("UserBlockOwnWithError", func(t *testing.T) {
+		err := ucStatus.UserBlock(context.Empty(), meta, meta.UserID, user.ChangeStatusInput{Reason: "fraud"})
+		assertion.ErrorIs(err, usecase.ErrOwnStatusChange)
+	})
+
+	t.Run("UserSuspend", func(t *testing.T) {
+		err := ucStatus.UserSuspend(context.Empty(), meta, userID, user.ChangeStatusInput{Reason: "spam", Until: &until})
+		assertion.NoError(err)
+	})
+
+	t.Run("UserSuspendWithError", func(t *testing.T) {
+		err := ucStatus.UserSuspend(context.Empty(), meta, userID, user.ChangeStatusInput{Reason: "spam", Until: &past})
+		assertion.ErrorIs(err, usecase.ErrInvalidSuspensionEnd)
+
+		err = ucStatus.UserSuspend(context.Empty(), meta, userID, user.ChangeStatusInput{Reason: "spam"})
+		assertion.ErrorIs(err, usecase.ErrInvalidSuspensionEnd)
+	})
+
+	t.Run("UserReactivate", func(t *testing.T) {
+		err := ucStatus.UserReactivate(context.Empty(), meta, userID, user.ChangeStatusInput{Reason: "appeal"})
+		assertion.NoError(err)
+	})
+
+	t.Run("CheckAccountStatus", func(t *testing.T) {
+		assertion.NoError(usecase.CheckAccountStatus(user.AccountStatus{Status: user.StatusActive}))
+		assertion.NoError(usecase.CheckAccountStatus(user.AccountStatus{Status: user.StatusSuspended, SuspendedUntil: &past}))
+		assertion.ErrorIs(usecase.CheckAccountStatus(user.AccountStatus{Status: user.StatusBlocked}), usecase.ErrUserBlocked)
+		assertion.ErrorIs(
+			usecase.CheckAccountStatus(user.AccountStatus{Status: user.StatusSuspended, SuspendedUntil: &until}),
+			usecase.ErrUserSuspended,
+		)
+	})
+}
@@ -5,11 +5,15 @@ import (
 	"crypto/subtle"
 	"encoding/base64"
 	"fmt"
+	"math"
 	"strings"
+	"time"
 
 	"github.com/evgeniy-dammer/marketplace-api/internal/domain/token"
+	"github.com/evgeniy-dammer/marketplace-api/internal/domain/user"
 	"github.com/golang-jwt/jwt"
 	"github.com/pkg/errors"
+	"github.com/spf13/viper"
 	"golang.org/x/crypto/argon2"
 )
 
@@ -26,16 +30,45 @@ type HashParams struct {
 	Parallelism uint8
 }
 
-var Params = &HashParams{Memory: 4096, Iterations: 3, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+// DefaultParams are used for parameters missing in the configuration.
+var DefaultParams = HashParams{Memory: 19456, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+// PasswordParams returns configured password hash params. A new value is returned on every call,
+// so callers can not affect each other.
+func PasswordParams() HashParams {
+	params := DefaultParams
+
+	if memory := viper.GetUint32("password_hash.memory"); memory > 0 {
+		params.Memory = memory
+	}
+
+	if iterations := viper.GetUint32("password_hash.iterations"); iterations > 0 {
+		params.Iterations = iterations
+	}
+
+	if parallelism := viper.GetUint("password_hash.parallelism"); parallelism > 0 && parallelism <= math.MaxUint8 {
+		params.Parallelism = uint8(parallelism)
+	}
+
+	if saltLength := viper.GetUint32("password_hash.salt_length"); saltLength > 0 {
+		params.SaltLength = saltLength
+	}
+
+	if keyLength := viper.GetUint32("password_hash.key_length"); keyLength > 0 {
+		params.KeyLength = keyLength
+	}
+
+	return params
+}
 
 // GeneratePasswordHash hashes the password.
-func GeneratePasswordHash(password string, Params *HashParams) (string, error) {
-	salt, err := GenerateRandomBytes(Params.SaltLength)
+func GeneratePasswordHash(password string, params HashParams) (string, error) {
+	salt, err := GenerateRandomBytes(params.SaltLength)
 	if err != nil {
 		return "", err
 	}
 
-	hash := argon2.IDKey([]byte(password), salt, Params.Iterations, Params.Memory, Params.Parallelism, Params.KeyLength)
+	hash := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
 
 	b64Salt := base64.RawStdEncoding.EncodeToString(salt)
 	b64Hash := base64.RawStdEncoding.EncodeToString(hash)
@@ -43,9 +76,9 @@ func GeneratePasswordHash(password string, Params *HashParams) (string, error) {
 	encodedHash := fmt.Sprintf(
 		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
 		argon2.Version,
-		Params.Memory,
-		Params.Iterations,
-		Params.Parallelism,
+		params.Memory,
+		params.Iterations,
+		params.Parallelism,
 		b64Salt,
 		b64Hash,
 	)
@@ -78,57 +111,81 @@ func ComparePasswordAndHash(password, encodedHash string) (bool, error) {
 	return false, nil
 }
 
-func DecodeHash(encodedHash string) (*HashParams, []byte, []byte, error) {
+// DecodeHash returns params, salt and hash of the encoded hash.
+func DecodeHash(encodedHash string) (HashParams, []byte, []byte, error) {
+	var params HashParams
+
 	values := strings.Split(encodedHash, "$")
 
 	if len(values) != ValuesNum {
-		return nil, nil, nil, ErrInvalidHash
+		return params, nil, nil, ErrInvalidHash
 	}
 
 	var version int
 
 	_, err := fmt.Sscanf(values[2], "v=%d", &version)
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, "can not scan value")
+		return params, nil, nil, errors.Wrap(err, "can not scan value")
 	}
 
 	if version != argon2.Version {
-		return nil, nil, nil, ErrIncompatibleVersion
+		return params, nil, nil, ErrIncompatibleVersion
 	}
 
-	Params = &HashParams{}
-
-	_, err = fmt.Sscanf(values[3], "m=%d,t=%d,p=%d", &Params.Memory, &Params.Iterations, &Params.Parallelism)
+	_, err = fmt.Sscanf(values[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism)
 
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, "can not scan value")
+		return params, nil, nil, errors.Wrap(err, "can not scan value")
 	}
 
 	salt, err := base64.RawStdEncoding.Strict().DecodeString(values[4])
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, "can not decode string")
+		return params, nil, nil, errors.Wrap(err, "can not decode string")
 	}
 
-	Params.SaltLength = uint32(len(salt))
+	params.SaltLength = uint32(len(salt))
 
 	hash, err := base64.RawStdEncoding.Strict().DecodeString(values[5])
 	if err != nil {
-		return nil, nil, nil, errors.Wrap(err, "can not decode string")
+		return params, nil, nil, errors.Wrap(err, "can not decode string")
 	}
 
-	Params.KeyLength = uint32(len(hash))
+	params.KeyLength = uint32(len(hash))
 
-	return Params, salt, hash, nil
+	return params, salt, hash, nil
 }
 
-// CreateNewToken creates new token with claims.
-func CreateNewToken(userID string, expiresAt int64, issuedAt int64, hash string) *jwt.Token {
-	return jwt.NewWithClaims(jwt.SigningMethodHS256, &token.Claims{
+// NeedsRehash checks if the encoded hash was generated with params other than given.
+func NeedsRehash(encodedHash string, params HashParams) bool {
+	current, _, _, err := DecodeHash(encodedHash)
+	if err != nil {
+		return true
+	}
+
+	return current != params
+}
+
+// CheckAccountStatus returns error if the user is blocked or suspended.
+func CheckAccountStatus(status user.AccountStatus) error {
+	if status.IsBlocked() {
+		return ErrUserBlocked
+	}
+
+	if status.IsSuspended(time.Now()) {
+		return errors.Wrapf(ErrUserSuspended, "until %s", status.SuspendedUntil.UTC().Format(time.RFC3339))
+	}
+
+	return nil
+}
+
+// CreateNewClaims creates new token claims.
+func CreateNewClaims(userID string, expiresAt int64, issuedAt int64, hash string) *token.Claims {
+	return &token.Claims{
 		StandardClaims: jwt.StandardClaims{
 			ExpiresAt: expiresAt,
 			IssuedAt:  issuedAt,
 		},
 		UserID: userID,
 		Hash:   hash,
-	})
+	}
 }
@@ -0,0 +1,131 @@
+package usecase
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testPassword = "correct horse battery staple"
+
+func TestPasswordHash(t *testing.T) {
+	t.Parallel()
+
+	params := HashParams{Memory: 1024, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+	encoded, err := GeneratePasswordHash(testPassword, params)
+	require.NoError(t, err)
+
+	t.Run("compare", func(t *testing.T) {
+		match, err := ComparePasswordAndHash(testPassword, encoded)
+		require.NoError(t, err)
+		assert.True(t, match)
+
+		match, err = ComparePasswordAndHash("wrong", encoded)
+		require.NoError(t, err)
+		assert.False(t, match)
+	})
+
+	t.Run("decode", func(t *testing.T) {
+		decoded, _, _, err := DecodeHash(encoded)
+		require.NoError(t, err)
+		assert.Equal(t, params, decoded)
+
+		_, _, _, err = DecodeHash("invalid")
+		assert.ErrorIs(t, err, ErrInvalidHash)
+	})
+
+	t.Run("needsRehash", func(t *testing.T) {
+		assert.False(t, NeedsRehash(encoded, params))
+
+		stronger := params
+		stronger.Iterations++
+		assert.True(t, NeedsRehash(encoded, stronger))
+		assert.True(t, NeedsRehash("invalid", params))
+	})
+
+	t.Run("concurrent", func(t *testing.T) {
+		other := HashParams{Memory: 2048, Iterations: 2, Parallelism: 2, SaltLength: 8, KeyLength: 16}
+
+		var wait sync.WaitGroup
+
+		for i := 0; i < 8; i++ {
+			wait.Add(1)
+
+			go func() {
+				defer wait.Done()
+
+				otherEncoded, err := GeneratePasswordHash(testPassword, other)
+				assert.NoError(t, err)
+
+				match, err := ComparePasswordAndHash(testPassword, encoded)
+				assert.NoError(t, err)
+				assert.True(t, match)
+
+				decoded, _, _, err := DecodeHash(otherEncoded)
+				assert.NoError(t, err)
+				assert.Equal(t, other, decoded)
+			}()
+		}
+
+		wait.Wait()
+	})
+}
+
+func BenchmarkGeneratePasswordHash(b *testing.B) {
+	for _, params := range benchmarkParams() {
+		params := params
+
+		b.Run(benchmarkName(params), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := GeneratePasswordHash(testPassword, params); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkComparePasswordAndHash(b *testing.B) {
+	for _, params := range benchmarkParams() {
+		encoded, err := GeneratePasswordHash(testPassword, params)
+		if err != nil {
+			b.Fatal(err)
+		}
+
+		b.Run(benchmarkName(params), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := ComparePasswordAndHash(testPassword, encoded); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+// benchmarkParams returns the configured params and common alternatives to compare with.
+func benchmarkParams() []HashParams {
+	params := []HashParams{PasswordParams()}
+
+	for _, memory := range []uint32{4096, 47104, 65536} {
+		for _, iterations := range []uint32{1, 3} {
+			params = append(params, HashParams{
+				Memory:      memory,
+				Iterations:  iterations,
+				Parallelism: DefaultParams.Parallelism,
+				SaltLength:  DefaultParams.SaltLength,
+				KeyLength:   DefaultParams.KeyLength,
+			})
+		}
+	}
+
+	return params
+}
+
+// benchmarkName returns the sub-benchmark name of the params.
+func benchmarkName(params HashParams) string {
+	return fmt.Sprintf("m=%d,t=%d,p=%d", params.Memory, params.Iterations, params.Parallelism)
+}
@@ -0,0 +1,275 @@
+// Package oidcmock is a local OpenID Connect provider for development and tests.
+// It approves every authorization request as the configured user.
+package oidcmock
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/pkg/errors"
+)
+
+const (
+	keyID     = "oidcmock"
+	keySize   = 2048
+	codeTTL   = time.Minute
+	tokenTTL  = 5 * time.Minute
+	codeBytes = 16
+)
+
+// Provider is a mock OpenID Connect provider.
+type Provider struct {
+	// Issuer URL, must match the URL the provider is served on
+	Issuer string
+	// Client ID accepted by the provider
+	ClientID string
+	// Subject of the signed in user
+	Subject string
+	// Additional ID token claims, e.g. phone_number or groups
+	Claims map[string]interface{}
+
+	key   *rsa.PrivateKey
+	mutex sync.Mutex
+	codes map[string]authorization
+}
+
+// authorization is an issued authorization code.
+type authorization struct {
+	redirectURI   string
+	nonce         string
+	codeChallenge string
+	expiresAt     time.Time
+}
+
+// New constructor for Provider.
+func New(issuer string, clientID string, subject string, claims map[string]interface{}) (*Provider, error) {
+	key, err := rsa.GenerateKey(rand.Reader, keySize)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not generate signing key")
+	}
+
+	return &Provider{
+		Issuer:   issuer,
+		ClientID: clientID,
+		Subject:  subject,
+		Claims:   claims,
+		key:      key,
+		codes:    make(map[string]authorization),
+	}, nil
+}
+
+// ServeHTTP serves discovery, authorization, token and jwks endpoints.
+func (p *Provider) ServeHTTP(writer http.ResponseWriter, req *http.Request) {
+	switch req.URL.Path {
+	case "/.well-known/openid-configuration":
+		p.discovery(writer)
+	case "/authorize":
+		p.authorize(writer, req)
+	case "/token":
+		p.token(writer, req)
+	case "/jwks":
+		p.jwks(writer)
+	default:
+		http.NotFound(writer, req)
+	}
+}
+
+// discovery writes the discovery document.
+func (p *Provider) discovery(writer http.ResponseWriter) {
+	writeJSON(writer, http.StatusOK, map[string]interface{}{
+		"issuer":                                p.Issuer,
+		"authorization_endpoint":                p.Issuer + "/authorize",
+		"token_endpoint":                        p.Issuer + "/token",
+		"jwks_uri":                              p.Issuer + "/jwks",
+		"response_types_supported":              []string{"code"},
+		"subject_types_supported":               []string{"public"},
+		"id_token_signing_alg_values_supported": []string{"RS256"},
+		"code_challenge_methods_supported":      []string{"S256"},
+	})
+}
+
+// authorize issues authorization code and redirects back to the client.
+func (p *Provider) authorize(writer http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	redirectURI, err := url.Parse(query.Get("redirect_uri"))
+	if err != nil || redirectURI.Scheme == "" {
+		http.Error(writer, "invalid redirect_uri", http.StatusBadRequest)
+
+		return
+	}
+
+	if query.Get("response_type") != "code" || query.Get("client_id") != p.ClientID {
+		http.Error(writer, "invalid authorization request", http.StatusBadRequest)
+
+		return
+	}
+
+	if query.Get("code_challenge") == "" || query.Get("code_challenge_method") != "S256" {
+		http.Error(writer, "pkce with S256 is required", http.StatusBadRequest)
+
+		return
+	}
+
+	code, err := randomString()
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	p.mutex.Lock()
+	p.codes[code] = authorization{
+		redirectURI:   redirectURI.String(),
+		nonce:         query.Get("nonce"),
+		codeChallenge: query.Get("code_challenge"),
+		expiresAt:     time.Now().Add(codeTTL),
+	}
+	p.mutex.Unlock()
+
+	values := redirectURI.Query()
+	values.Set("code", code)
+	values.Set("state", query.Get("state"))
+	redirectURI.RawQuery = values.Encode()
+
+	http.Redirect(writer, req, redirectURI.String(), http.StatusFound)
+}
+
+// token redeems authorization code and returns signed ID token.
+func (p *Provider) token(writer http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodPost {
+		http.Error(writer, "method not allowed", http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	if err := req.ParseForm(); err != nil {
+		tokenError(writer, "invalid_request", err.Error())
+
+		return
+	}
+
+	if req.PostForm.Get("grant_type") != "authorization_code" {
+		tokenError(writer, "unsupported_grant_type", "only authorization_code is supported")
+
+		return
+	}
+
+	code := req.PostForm.Get("code")
+
+	p.mutex.Lock()
+	auth, ok := p.codes[code]
+	delete(p.codes, code)
+	p.mutex.Unlock()
+
+	if !ok || time.Now().After(auth.expiresAt) {
+		tokenError(writer, "invalid_grant", "unknown or expired code")
+
+		return
+	}
+
+	if req.PostForm.Get("client_id") != p.ClientID || req.PostForm.Get("redirect_uri") != auth.redirectURI {
+		tokenError(writer, "invalid_grant", "client or redirect_uri mismatch")
+
+		return
+	}
+
+	challenge := sha256.Sum256([]byte(req.PostForm.Get("code_verifier")))
+	if base64.RawURLEncoding.EncodeToString(challenge[:]) != auth.codeChallenge {
+		tokenError(writer, "invalid_grant", "code_verifier mismatch")
+
+		return
+	}
+
+	now := time.Now()
+	claims := jwt.MapClaims{}
+
+	for name, value := range p.Claims {
+		claims[name] = value
+	}
+
+	claims["iss"] = p.Issuer
+	claims["aud"] = p.ClientID
+	claims["sub"] = p.Subject
+	claims["iat"] = now.Unix()
+	claims["exp"] = now.Add(tokenTTL).Unix()
+
+	if auth.nonce != "" {
+		claims["nonce"] = auth.nonce
+	}
+
+	tkn := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	tkn.Header["kid"] = keyID
+
+	idToken, err := tkn.SignedString(p.key)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	accessToken, err := randomString()
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, map[string]interface{}{
+		"access_token": accessToken,
+		"token_type":   "Bearer",
+		"expires_in":   int(tokenTTL.Seconds()),
+		"id_token":     idToken,
+	})
+}
+
+// jwks writes the public signing key.
+func (p *Provider) jwks(writer http.ResponseWriter) {
+	publicKey := p.key.PublicKey
+
+	writeJSON(writer, http.StatusOK, map[string]interface{}{
+		"keys": []map[string]string{{
+			"kty": "RSA",
+			"kid": keyID,
+			"use": "sig",
+			"alg": "RS256",
+			"n":   base64.RawURLEncoding.EncodeToString(publicKey.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(publicKey.E)).Bytes()),
+		}},
+	})
+}
+
+// tokenError writes OAuth 2.0 error response.
+func tokenError(writer http.ResponseWriter, code string, description string) {
+	writeJSON(writer, http.StatusBadRequest, map[string]string{"error": code, "error_description": description})
+}
+
+// writeJSON writes JSON response.
+func writeJSON(writer http.ResponseWriter, status int, body interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.Header().Set("Cache-Control", "no-store")
+	writer.WriteHeader(status)
+
+	_ = json.NewEncoder(writer).Encode(body)
+}
+
+// randomString returns random hex string.
+func randomString() (string, error) {
+	bytes := make([]byte, codeBytes)
+
+	if _, err := rand.Read(bytes); err != nil {
+		return "", errors.Wrap(err, "can not generate random string")
+	}
+
+	return hex.EncodeToString(bytes), nil
+}
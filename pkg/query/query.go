@@ -8,4 +8,10 @@ type MetaData struct {
 	OrganizationID string
 	// Users role name
 	RoleName string
+	// Owner User ID, rows of other users are filtered out if set
+	OwnerID string
+	// Request ID
+	RequestID string
+	// Client IP address
+	IP string
 }
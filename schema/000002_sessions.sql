@@ -0,0 +1,57 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE token_whitelist
+    ADD COLUMN IF NOT EXISTS device_name CHARACTER VARYING (255) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS ip CHARACTER VARYING (45) NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    ADD COLUMN IF NOT EXISTS last_used_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    ADD COLUMN IF NOT EXISTS expires_at TIMESTAMPTZ;
+
+CREATE INDEX IF NOT EXISTS token_whitelist_user_id_idx ON token_whitelist (user_id);
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('customer', 'sessions', 'get', 'allow'),
+   ('customer', 'session', 'delete', 'allow'),
+   ('customer', 'usersessions', 'get', 'deny'),
+   ('customer', 'usersessions', 'delete', 'deny'),
+
+   ('operator', 'sessions', 'get', 'allow'),
+   ('operator', 'session', 'delete', 'allow'),
+   ('operator', 'usersessions', 'get', 'deny'),
+   ('operator', 'usersessions', 'delete', 'deny'),
+
+   ('vendor', 'sessions', 'get', 'allow'),
+   ('vendor', 'session', 'delete', 'allow'),
+   ('vendor', 'usersessions', 'get', 'deny'),
+   ('vendor', 'usersessions', 'delete', 'deny'),
+
+   ('analyst', 'sessions', 'get', 'allow'),
+   ('analyst', 'session', 'delete', 'allow'),
+   ('analyst', 'usersessions', 'get', 'deny'),
+   ('analyst', 'usersessions', 'delete', 'deny'),
+
+   ('admin', 'sessions', 'get', 'allow'),
+   ('admin', 'session', 'delete', 'allow'),
+   ('admin', 'usersessions', 'get', 'allow'),
+   ('admin', 'usersessions', 'delete', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 IN ('sessions', 'session', 'usersessions');
+
+DROP INDEX IF EXISTS token_whitelist_user_id_idx;
+
+ALTER TABLE token_whitelist
+    DROP COLUMN IF EXISTS device_name,
+    DROP COLUMN IF EXISTS ip,
+    DROP COLUMN IF EXISTS created_at,
+    DROP COLUMN IF EXISTS last_used_at,
+    DROP COLUMN IF EXISTS expires_at;
+
+-- +goose StatementEnd
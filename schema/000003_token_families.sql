@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS token_rotated_hashes
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    token_id UUID REFERENCES token_whitelist(id) ON DELETE CASCADE NOT NULL,
+    hash UUID NOT NULL,
+    rotated_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt')
+);
+
+CREATE INDEX IF NOT EXISTS token_rotated_hashes_hash_idx ON token_rotated_hashes (hash);
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS token_rotated_hashes;
+
+-- +goose StatementEnd
@@ -0,0 +1,23 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS signing_keys
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    algorithm CHARACTER VARYING (10) NOT NULL,
+    -- private key encrypted with SIGNING_KEY_ENCRYPTION_KEY, plaintext keys are refused
+    private_key TEXT NOT NULL CHECK (private_key LIKE 'aes-gcm:%'),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    expires_at TIMESTAMPTZ NOT NULL,
+    retired_at TIMESTAMPTZ NOT NULL
+);
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS signing_keys;
+
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+
+INSERT INTO users_statuses (name) VALUES ('pending') ON CONFLICT (name) DO NOTHING;
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+UPDATE users SET status_id = (SELECT id FROM users_statuses WHERE name = 'active')
+WHERE status_id = (SELECT id FROM users_statuses WHERE name = 'pending');
+
+DELETE FROM users_statuses WHERE name = 'pending';
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('customer', 'password', 'update', 'allow'),
+   ('operator', 'password', 'update', 'allow'),
+   ('vendor', 'password', 'update', 'allow'),
+   ('analyst', 'password', 'update', 'allow'),
+   ('admin', 'password', 'update', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 = 'password';
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('customer', 'userlock', 'delete', 'deny'),
+   ('operator', 'userlock', 'delete', 'deny'),
+   ('vendor', 'userlock', 'delete', 'deny'),
+   ('analyst', 'userlock', 'delete', 'deny'),
+   ('admin', 'userlock', 'delete', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 = 'userlock';
+
+-- +goose StatementEnd
@@ -0,0 +1,51 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS users_two_factor
+(
+    user_id UUID PRIMARY KEY REFERENCES users(id) ON DELETE CASCADE,
+    secret CHARACTER VARYING (64) NOT NULL,
+    enabled BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    enabled_at TIMESTAMPTZ
+);
+
+CREATE TABLE IF NOT EXISTS users_backup_codes
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE NOT NULL,
+    code_hash CHARACTER VARYING (64) NOT NULL,
+    used_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS users_backup_codes_user_id_idx ON users_backup_codes (user_id);
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('customer', 'twofactor', 'post', 'allow'),
+   ('customer', 'twofactor', 'delete', 'allow'),
+
+   ('operator', 'twofactor', 'post', 'allow'),
+   ('operator', 'twofactor', 'delete', 'allow'),
+
+   ('vendor', 'twofactor', 'post', 'allow'),
+   ('vendor', 'twofactor', 'delete', 'allow'),
+
+   ('analyst', 'twofactor', 'post', 'allow'),
+   ('analyst', 'twofactor', 'delete', 'allow'),
+
+   ('admin', 'twofactor', 'post', 'allow'),
+   ('admin', 'twofactor', 'delete', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 = 'twofactor';
+
+DROP TABLE IF EXISTS users_backup_codes;
+DROP TABLE IF EXISTS users_two_factor;
+
+-- +goose StatementEnd
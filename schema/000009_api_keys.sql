@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS api_keys
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID REFERENCES organizations(id) NOT NULL,
+    user_id UUID REFERENCES users(id) NOT NULL,
+    name CHARACTER VARYING (255) NOT NULL,
+    prefix CHARACTER VARYING (16) NOT NULL,
+    key_hash CHARACTER VARYING (64) NOT NULL UNIQUE,
+    scopes TEXT[] NOT NULL DEFAULT '{}',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    last_used_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS api_keys_organization_id_idx ON api_keys (organization_id);
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('customer', 'apikeys', 'get', 'deny'),
+   ('customer', 'apikey', 'post', 'deny'),
+   ('customer', 'apikey', 'delete', 'deny'),
+
+   ('operator', 'apikeys', 'get', 'deny'),
+   ('operator', 'apikey', 'post', 'deny'),
+   ('operator', 'apikey', 'delete', 'deny'),
+
+   ('vendor', 'apikeys', 'get', 'allow'),
+   ('vendor', 'apikey', 'post', 'allow'),
+   ('vendor', 'apikey', 'delete', 'allow'),
+
+   ('analyst', 'apikeys', 'get', 'deny'),
+   ('analyst', 'apikey', 'post', 'deny'),
+   ('analyst', 'apikey', 'delete', 'deny'),
+
+   ('admin', 'apikeys', 'get', 'allow'),
+   ('admin', 'apikey', 'post', 'allow'),
+   ('admin', 'apikey', 'delete', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 IN ('apikeys', 'apikey');
+
+DROP TABLE IF EXISTS api_keys;
+
+-- +goose StatementEnd
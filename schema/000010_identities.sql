@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS users_identities
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE NOT NULL,
+    provider CHARACTER VARYING (50) NOT NULL,
+    subject CHARACTER VARYING (255) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    last_login_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    UNIQUE (provider, subject)
+);
+
+CREATE INDEX IF NOT EXISTS users_identities_user_id_idx ON users_identities (user_id);
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP TABLE IF EXISTS users_identities;
+
+-- +goose StatementEnd
@@ -0,0 +1,50 @@
+-- +goose Up
+-- +goose StatementBegin
+
+INSERT INTO users_statuses (name) VALUES ('suspended') ON CONFLICT (name) DO NOTHING;
+
+ALTER TABLE users
+    ADD COLUMN IF NOT EXISTS status_reason TEXT NOT NULL DEFAULT '',
+    ADD COLUMN IF NOT EXISTS suspended_until TIMESTAMPTZ;
+
+CREATE TABLE IF NOT EXISTS users_status_history
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE NOT NULL,
+    status_id SMALLINT REFERENCES users_statuses(id) NOT NULL,
+    reason TEXT NOT NULL,
+    suspended_until TIMESTAMPTZ,
+    user_created UUID REFERENCES users(id),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt')
+);
+
+CREATE INDEX IF NOT EXISTS users_status_history_user_id_idx ON users_status_history (user_id);
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('customer', 'userstatus', 'post', 'deny'),
+   ('operator', 'userstatus', 'post', 'deny'),
+   ('vendor', 'userstatus', 'post', 'deny'),
+   ('analyst', 'userstatus', 'post', 'deny'),
+   ('admin', 'userstatus', 'post', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 = 'userstatus';
+
+DROP TABLE IF EXISTS users_status_history;
+
+UPDATE users SET status_id = (SELECT id FROM users_statuses WHERE name = 'blocked')
+WHERE status_id = (SELECT id FROM users_statuses WHERE name = 'suspended');
+
+ALTER TABLE users
+    DROP COLUMN IF EXISTS status_reason,
+    DROP COLUMN IF EXISTS suspended_until;
+
+DELETE FROM users_statuses WHERE name = 'suspended';
+
+-- +goose StatementEnd
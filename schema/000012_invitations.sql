@@ -0,0 +1,66 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS organizations_members
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE NOT NULL,
+    user_id UUID REFERENCES users(id) ON DELETE CASCADE NOT NULL,
+    role_id SMALLINT REFERENCES roles(id) NOT NULL,
+    joined_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    UNIQUE (organization_id, user_id)
+);
+
+CREATE INDEX IF NOT EXISTS organizations_members_user_id_idx ON organizations_members (user_id);
+
+CREATE TABLE IF NOT EXISTS invitations
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    organization_id UUID REFERENCES organizations(id) ON DELETE CASCADE NOT NULL,
+    phone CHARACTER VARYING (255) NOT NULL,
+    role_id SMALLINT REFERENCES roles(id) NOT NULL,
+    token_hash CHARACTER VARYING (64) NOT NULL UNIQUE,
+    user_created UUID REFERENCES users(id) NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt'),
+    expires_at TIMESTAMPTZ NOT NULL,
+    accepted_by UUID REFERENCES users(id),
+    accepted_at TIMESTAMPTZ,
+    revoked_at TIMESTAMPTZ
+);
+
+CREATE INDEX IF NOT EXISTS invitations_organization_id_idx ON invitations (organization_id);
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('customer', 'invitations', 'get', 'deny'),
+   ('customer', 'invitation', 'post', 'deny'),
+   ('customer', 'invitation', 'delete', 'deny'),
+
+   ('operator', 'invitations', 'get', 'deny'),
+   ('operator', 'invitation', 'post', 'deny'),
+   ('operator', 'invitation', 'delete', 'deny'),
+
+   ('vendor', 'invitations', 'get', 'allow'),
+   ('vendor', 'invitation', 'post', 'allow'),
+   ('vendor', 'invitation', 'delete', 'allow'),
+
+   ('analyst', 'invitations', 'get', 'deny'),
+   ('analyst', 'invitation', 'post', 'deny'),
+   ('analyst', 'invitation', 'delete', 'deny'),
+
+   ('admin', 'invitations', 'get', 'allow'),
+   ('admin', 'invitation', 'post', 'allow'),
+   ('admin', 'invitation', 'delete', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 IN ('invitations', 'invitation');
+
+DROP TABLE IF EXISTS invitations;
+DROP TABLE IF EXISTS organizations_members;
+
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- staff roles of organization owners become roles in their organizations
+INSERT INTO organizations_members (organization_id, user_id, role_id)
+SELECT o.id, o.user_id, ur.role_id
+FROM organizations o
+    INNER JOIN users_roles ur ON ur.user_id = o.user_id
+    INNER JOIN roles ro ON ro.id = ur.role_id
+WHERE o.is_deleted = FALSE AND ro.name IN ('operator', 'vendor', 'analyst')
+ON CONFLICT (organization_id, user_id) DO NOTHING;
+
+-- staff with organization roles are customers outside of their organizations,
+-- staff without any organization keep the global role until an admin assigns one
+UPDATE users_roles
+SET role_id = (SELECT id FROM roles WHERE name = 'customer')
+WHERE role_id IN (SELECT id FROM roles WHERE name IN ('operator', 'vendor', 'analyst'))
+    AND user_id IN (SELECT user_id FROM organizations_members);
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('customer', 'organizationroles', 'get', 'deny'),
+   ('customer', 'organizationrole', 'post', 'deny'),
+   ('customer', 'organizationrole', 'delete', 'deny'),
+
+   ('operator', 'organizationroles', 'get', 'deny'),
+   ('operator', 'organizationrole', 'post', 'deny'),
+   ('operator', 'organizationrole', 'delete', 'deny'),
+
+   ('vendor', 'organizationroles', 'get', 'deny'),
+   ('vendor', 'organizationrole', 'post', 'deny'),
+   ('vendor', 'organizationrole', 'delete', 'deny'),
+
+   ('analyst', 'organizationroles', 'get', 'deny'),
+   ('analyst', 'organizationrole', 'post', 'deny'),
+   ('analyst', 'organizationrole', 'delete', 'deny'),
+
+   ('admin', 'organizationroles', 'get', 'allow'),
+   ('admin', 'organizationrole', 'post', 'allow'),
+   ('admin', 'organizationrole', 'delete', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 IN ('organizationroles', 'organizationrole');
+
+UPDATE users_roles ur
+SET role_id = om.role_id
+FROM (
+    SELECT DISTINCT ON (user_id) user_id, role_id FROM organizations_members ORDER BY user_id, joined_at
+) om
+WHERE om.user_id = ur.user_id
+    AND ur.role_id = (SELECT id FROM roles WHERE name = 'customer');
+
+-- +goose StatementEnd
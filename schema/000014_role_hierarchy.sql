@@ -0,0 +1,78 @@
+-- +goose Up
+-- +goose StatementBegin
+
+-- rules are denied unless allowed, explicit deny rows would be inherited by parent roles
+DELETE FROM casbin_rule d
+WHERE d.ptype = 'p' AND d.v3 = 'deny'
+    AND NOT EXISTS (
+        SELECT 1 FROM casbin_rule a
+        WHERE a.ptype = 'p' AND a.v3 = 'allow' AND a.v0 = d.v0 AND a.v1 = d.v1 AND a.v2 = d.v2
+    );
+
+-- admin inherits vendor and analyst, vendor inherits operator in all organizations. Operator does not inherit
+-- customer: customer rules are limited to own rows (user record, orders) by ownership checks of customers only,
+-- inherited by operator they would expose every user and the organizations operators are denied
+INSERT INTO casbin_rule (ptype, v0, v1, v2)
+VALUES
+   ('g', 'admin', 'vendor', '*'),
+   ('g', 'admin', 'analyst', '*'),
+   ('g', 'vendor', 'operator', '*');
+
+-- rules of parent roles are inherited, parents are deduplicated before their own parents
+DELETE FROM casbin_rule c
+WHERE c.ptype = 'p' AND c.v0 = 'admin'
+    AND EXISTS (
+        SELECT 1 FROM casbin_rule p
+        WHERE p.ptype = 'p' AND p.v0 IN ('vendor', 'analyst') AND p.v1 = c.v1 AND p.v2 = c.v2 AND p.v3 = c.v3
+    );
+
+DELETE FROM casbin_rule c
+WHERE c.ptype = 'p' AND c.v0 = 'vendor'
+    AND EXISTS (
+        SELECT 1 FROM casbin_rule p
+        WHERE p.ptype = 'p' AND p.v0 = 'operator' AND p.v1 = c.v1 AND p.v2 = c.v2 AND p.v3 = c.v3
+    );
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('admin', 'roleparents', 'get', 'allow'),
+   ('admin', 'roleparent', 'post', 'allow'),
+   ('admin', 'roleparent', 'delete', 'allow'),
+   ('admin', 'rolepermissions', 'get', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 IN ('roleparents', 'roleparent', 'rolepermissions');
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+SELECT DISTINCT 'vendor', p.v1, p.v2, p.v3
+FROM casbin_rule p
+WHERE p.ptype = 'p' AND p.v0 = 'operator'
+    AND NOT EXISTS (
+        SELECT 1 FROM casbin_rule c WHERE c.ptype = 'p' AND c.v0 = 'vendor' AND c.v1 = p.v1 AND c.v2 = p.v2
+    );
+
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+SELECT DISTINCT 'admin', p.v1, p.v2, p.v3
+FROM casbin_rule p
+WHERE p.ptype = 'p' AND p.v0 IN ('vendor', 'analyst')
+    AND NOT EXISTS (
+        SELECT 1 FROM casbin_rule c WHERE c.ptype = 'p' AND c.v0 = 'admin' AND c.v1 = p.v1 AND c.v2 = p.v2
+    );
+
+DELETE FROM casbin_rule WHERE ptype = 'g' AND v2 = '*';
+
+-- every role gets explicit deny rows for rules it is not allowed
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+SELECT ro.name, r.v1, r.v2, 'deny'
+FROM roles ro
+    CROSS JOIN (SELECT DISTINCT v1, v2 FROM casbin_rule WHERE ptype = 'p') r
+WHERE NOT EXISTS (
+    SELECT 1 FROM casbin_rule c WHERE c.ptype = 'p' AND c.v0 = ro.name AND c.v1 = r.v1 AND c.v2 = r.v2
+);
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+
+INSERT INTO casbin_rule (v0, v1, v2, v3) VALUES ('admin', 'policy', 'get', 'allow');
+INSERT INTO casbin_rule (v0, v1, v2, v3) VALUES ('admin', 'policy', 'post', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 = 'policy';
+
+-- +goose StatementEnd
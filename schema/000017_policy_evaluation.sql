@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+
+INSERT INTO casbin_rule (v0, v1, v2, v3) VALUES ('admin', 'policyevaluation', 'post', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 = 'policyevaluation';
+
+-- +goose StatementEnd
@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS audit_log
+(
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    actor_id CHARACTER VARYING (36) NOT NULL DEFAULT '',
+    role CHARACTER VARYING (50) NOT NULL DEFAULT '',
+    organization_id CHARACTER VARYING (255) NOT NULL DEFAULT '',
+    entity CHARACTER VARYING (50) NOT NULL,
+    entity_id CHARACTER VARYING (255) NOT NULL,
+    action CHARACTER VARYING (10) NOT NULL,
+    changes JSONB NOT NULL DEFAULT '[]',
+    request_id CHARACTER VARYING (64) NOT NULL DEFAULT '',
+    ip CHARACTER VARYING (45) NOT NULL DEFAULT '',
+    created_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt')
+);
+
+CREATE INDEX IF NOT EXISTS audit_log_created_at_idx ON audit_log (created_at);
+CREATE INDEX IF NOT EXISTS audit_log_entity_idx ON audit_log (entity, entity_id);
+CREATE INDEX IF NOT EXISTS audit_log_actor_id_idx ON audit_log (actor_id);
+CREATE INDEX IF NOT EXISTS audit_log_organization_id_idx ON audit_log (organization_id);
+
+INSERT INTO casbin_rule (v0, v1, v2, v3) VALUES ('admin', 'audit', 'get', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 = 'audit';
+
+DROP TABLE IF EXISTS audit_log;
+
+-- +goose StatementEnd
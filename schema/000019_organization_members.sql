@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE organizations_members ADD COLUMN IF NOT EXISTS status CHARACTER VARYING (20) NOT NULL DEFAULT 'active';
+
+-- owners manage the staff of their organizations, so they become vendors there
+INSERT INTO organizations_members (organization_id, user_id, role_id)
+SELECT o.id, o.user_id, (SELECT id FROM roles WHERE name = 'vendor')
+FROM organizations o
+    INNER JOIN users_roles ur ON ur.user_id = o.user_id
+    INNER JOIN roles ro ON ro.id = ur.role_id
+WHERE o.is_deleted = FALSE AND ro.name <> 'admin'
+ON CONFLICT (organization_id, user_id) DO NOTHING;
+
+-- admin inherits the rules of vendor
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('vendor', 'members', 'get', 'allow'),
+   ('vendor', 'member', 'post', 'allow'),
+   ('vendor', 'member', 'patch', 'allow'),
+   ('vendor', 'member', 'delete', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 IN ('members', 'member');
+
+ALTER TABLE organizations_members DROP COLUMN IF EXISTS status;
+
+-- +goose StatementEnd
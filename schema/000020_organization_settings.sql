@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+
+CREATE TABLE IF NOT EXISTS organizations_settings
+(
+    organization_id UUID PRIMARY KEY REFERENCES organizations(id) ON DELETE CASCADE,
+    currency CHARACTER VARYING (3) NOT NULL DEFAULT 'USD',
+    timezone CHARACTER VARYING (64) NOT NULL DEFAULT 'UTC',
+    locales JSONB NOT NULL DEFAULT '["en"]',
+    default_locale CHARACTER VARYING (20) NOT NULL DEFAULT 'en',
+    working_hours JSONB NOT NULL DEFAULT '[]',
+    exceptions JSONB NOT NULL DEFAULT '[]',
+    tax JSONB NOT NULL DEFAULT '{"name": "", "rate": 0, "included": false}',
+    order_prefix CHARACTER VARYING (10) NOT NULL DEFAULT '',
+    user_updated UUID REFERENCES users(id),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT (now() AT TIME ZONE 'gmt')
+);
+
+-- vendor inherits the rules of operator, admin inherits the rules of vendor and analyst
+INSERT INTO casbin_rule (v0, v1, v2, v3)
+VALUES
+   ('operator', 'settings', 'get', 'allow'),
+   ('analyst', 'settings', 'get', 'allow'),
+   ('vendor', 'settings', 'patch', 'allow');
+
+-- +goose StatementEnd
+
+
+-- +goose Down
+-- +goose StatementBegin
+
+DELETE FROM casbin_rule WHERE v1 = 'settings';
+
+DROP TABLE IF EXISTS organizations_settings;
+
+-- +goose StatementEnd
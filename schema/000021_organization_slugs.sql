@@ -0,0 +1,30 @@
+-- +goose Up
+-- +goose StatementBegin
+
+ALTER TABLE organizations ADD COLUMN IF NOT EXISTS slug CHARACTER VARYING (64);
+
+UPDATE organizations
+SET slug = left(trim(both '-' from lower(regexp_replace(name, '[^a-zA-Z0-9]+', '-', 'g'))), 55);
+
+UPDATE organizations
+SET slug = left(id::text, 8)
+WHERE slug IS NULL OR slug = '';
+
+UPDATE organizations o
+SET slug = o.slug || '-' || left(o.id::text, 8)
+WHERE EXISTS (SELECT 1 FROM organizations d WHERE d.slug = o.slug AND d.id < o.id);
+
+ALTER TABLE organizations ALTER COLUMN slug SET NOT NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS organizations_slug_idx ON organizations (slug);
+
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+
+DROP INDEX IF EXISTS organizations_slug_idx;
+
+ALTER TABLE organizations DROP COLUMN IF EXISTS slug;
+
+-- +goose StatementEnd